@@ -0,0 +1,24 @@
+package agent
+
+import (
+	"github.com/spf13/cobra"
+
+	listCmd "github.com/zkhvan/tfc/cmd/tfc/agent/list"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+func NewCmdAgent(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "agents",
+		Aliases: []string{"agent"},
+		Short:   "Inspect self-hosted agents",
+		Long: text.Heredoc(`
+			Inspect the self-hosted agents of an agent pool.
+		`),
+	}
+
+	cmd.AddCommand(listCmd.NewCmdList(f))
+
+	return cmd
+}
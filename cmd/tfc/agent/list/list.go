@@ -0,0 +1,151 @@
+package list
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+const (
+	ColumnID       string = "ID"
+	ColumnName     string = "NAME"
+	ColumnStatus   string = "STATUS"
+	ColumnIP       string = "IP"
+	ColumnLastPing string = "LAST_PING"
+)
+
+var (
+	ColumnsDefault = []string{
+		ColumnName,
+		ColumnStatus,
+		ColumnIP,
+		ColumnLastPing,
+	}
+	ColumnsAll = []string{
+		ColumnID,
+		ColumnName,
+		ColumnStatus,
+		ColumnIP,
+		ColumnLastPing,
+	}
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+	Clock           *cmdutil.Clock
+
+	Org     string
+	Pool    string
+	Limit   int
+	Columns []string
+}
+
+func NewCmdList(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+		Clock:           f.Clock,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list --pool <POOL>",
+		Short:   "List the agents of an agent pool",
+		Aliases: []string{"ls"},
+		Long: text.Heredoc(`
+			List the agents of an agent pool, with their status and when
+			they last reached HCP Terraform.
+
+			An agent is idle when waiting for work, busy while running a
+			job, unknown when it stopped pinging, errored when it failed and
+			exited when it shut down.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# List the agents of a pool
+			$ tfc agents list --pool on-prem --org myorg
+
+			# List the agents of a pool by ID, with their IDs
+			$ tfc agents list --pool apool-abc123 -c ID,NAME,STATUS
+		`),
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+	cmd.Flags().StringVarP(&opts.Pool, "pool", "p", "", "Agent pool, by name or ID")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "l", 100, "Limit the number of results.")
+	_ = cmdutil.FlagStringEnumSliceP(cmd, &opts.Columns, "columns", "c", ColumnsDefault, "Columns to show.", ColumnsAll)
+
+	_ = cmd.MarkFlagRequired("pool")
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, _ []string) {
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	pool, err := client.AgentPools.ReadByNameOrID(ctx, opts.Org, opts.Pool)
+	if err != nil {
+		return fmt.Errorf("failed to read agent pool %s: %w", opts.Pool, err)
+	}
+
+	agents, pagination, err := client.Agents.List(ctx, pool.ID, &tfc.AgentListOptions{
+		ListOptions: tfc.ListOptions{Limit: opts.Limit},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list agents for agent pool %s: %w", pool.Name, err)
+	}
+
+	if pagination.ReachedLimit {
+		fmt.Fprintf(opts.IO.Out, "Showing top %d results\n\n", opts.Limit)
+	}
+
+	p := cmdutil.FieldPrinter(opts.IO, opts.Columns...)
+	for _, a := range agents {
+		p.Write(opts.extractFields(a))
+	}
+	p.Flush()
+
+	return nil
+}
+
+func (opts *Options) extractFields(a *tfc.Agent) map[string]string {
+	// The last ping is a plain string in the API client.
+	lastPing := a.LastPingAt
+	if at, err := time.Parse(time.RFC3339, a.LastPingAt); err == nil {
+		lastPing = text.RelativeTimeAgo(opts.Clock.Now(), at)
+	}
+
+	return map[string]string{
+		ColumnID:       a.ID,
+		ColumnName:     a.Name,
+		ColumnStatus:   a.Status,
+		ColumnIP:       a.IP,
+		ColumnLastPing: lastPing,
+	}
+}
@@ -0,0 +1,116 @@
+package list_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/zkhvan/tfc/cmd/tfc/agent/list"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/clock"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+var referenceTime = time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+func TestList(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/agent-pools/apool-1",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "apool-1", "type": "agent-pools", "attributes": {"name": "on-prem"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/agent-pools/apool-1/agents",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "agent-1",
+							"type": "agents",
+							"attributes": {
+								"name": "runner-a",
+								"status": "idle",
+								"ip-address": "10.0.0.1",
+								"last-ping-at": "2000-01-01T11:59:30Z"
+							}
+						},
+						{
+							"id": "agent-2",
+							"type": "agents",
+							"attributes": {
+								"name": "runner-b",
+								"status": "unknown",
+								"ip-address": "10.0.0.2",
+								"last-ping-at": "2000-01-01T09:00:00Z"
+							}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	result := runCommand(t, client, "--pool", "apool-1")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		NAME      STATUS   IP        LAST_PING
+		runner-a  idle     10.0.0.1  less than a minute ago
+		runner-b  unknown  10.0.0.2  about 3 hours ago
+	`))
+}
+
+func TestList_pool_required(t *testing.T) {
+	client, _, teardown := tfetest.Setup()
+	defer teardown()
+
+	result := runCommand(t, client)
+
+	test.Buffer(t, result.ErrBuf, "required flag(s) \"pool\" not set\n")
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+		Clock:           cmdutil.NewClock(clock.FrozenClock(referenceTime)),
+	}
+
+	cmd := list.NewCmdList(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
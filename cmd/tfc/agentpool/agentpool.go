@@ -0,0 +1,33 @@
+package agentpool
+
+import (
+	"github.com/spf13/cobra"
+
+	createCmd "github.com/zkhvan/tfc/cmd/tfc/agentpool/create"
+	deleteCmd "github.com/zkhvan/tfc/cmd/tfc/agentpool/delete"
+	listCmd "github.com/zkhvan/tfc/cmd/tfc/agentpool/list"
+	viewCmd "github.com/zkhvan/tfc/cmd/tfc/agentpool/view"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+func NewCmdAgentPool(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "agent-pools",
+		Aliases: []string{"agent-pool", "pools"},
+		Short:   "Manage agent pools",
+		Long: text.Heredoc(`
+			Manage the agent pools of an organization.
+
+			An agent pool groups the self-hosted agents running the
+			workspaces in agent execution mode.
+		`),
+	}
+
+	cmd.AddCommand(listCmd.NewCmdList(f))
+	cmd.AddCommand(viewCmd.NewCmdView(f))
+	cmd.AddCommand(createCmd.NewCmdCreate(f))
+	cmd.AddCommand(deleteCmd.NewCmdDelete(f))
+
+	return cmd
+}
@@ -0,0 +1,109 @@
+package create
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/ptr"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	Org               string
+	Name              string
+	AllowedWorkspaces []string
+}
+
+func NewCmdCreate(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create <NAME>",
+		Short: "Create an agent pool",
+		Long: text.Heredoc(`
+			Create an agent pool.
+
+			Every workspace of the organization can use the pool, unless
+			--allowed-workspaces restricts it to some workspaces.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Create an agent pool
+			$ tfc agent-pools create on-prem --org myorg
+
+			# Create an agent pool only some workspaces can use
+			$ tfc agent-pools create vault --org myorg --allowed-workspaces vault-config,vault-pki
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+
+	cmd.Flags().StringSliceVar(&opts.AllowedWorkspaces, "allowed-workspaces", nil,
+		"Only allow these workspaces to use the pool",
+	)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, args []string) {
+	opts.Name = args[0]
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if opts.Org == "" {
+		return cmdutil.ErrOrgRequired
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	o := tfe.AgentPoolCreateOptions{
+		Name:               ptr.String(opts.Name),
+		OrganizationScoped: ptr.Bool(len(opts.AllowedWorkspaces) == 0),
+	}
+
+	for _, name := range opts.AllowedWorkspaces {
+		ws, err := client.Workspaces.Read(ctx, opts.Org, name)
+		if err != nil {
+			return fmt.Errorf("failed to read workspace %s: %w", name, err)
+		}
+		o.AllowedWorkspaces = append(o.AllowedWorkspaces, &tfe.Workspace{ID: ws.ID})
+	}
+
+	pool, err := client.AgentPools.Create(ctx, opts.Org, o)
+	if err != nil {
+		return fmt.Errorf("failed to create agent pool %s: %w", opts.Name, err)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Created agent pool %s (%s)\n", pool.Name, pool.ID)
+
+	return nil
+}
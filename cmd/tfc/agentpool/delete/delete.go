@@ -0,0 +1,110 @@
+package delete
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+	Prompter        func() *cmdutil.Prompter
+
+	Org        string
+	Identifier string // Agent pool name or ID
+	Yes        bool
+}
+
+func NewCmdDelete(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+		Prompter:        f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete <NAME|ID>",
+		Short: "Delete an agent pool",
+		Long: text.Heredoc(`
+			Delete an agent pool.
+
+			A pool still used by workspaces can't be deleted, move the
+			workspaces to another pool or execution mode first.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Delete an agent pool by name
+			$ tfc agent-pools delete on-prem --org myorg
+
+			# Delete an agent pool by ID without confirmation
+			$ tfc agent-pools delete apool-abc123 --yes
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+
+	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Delete the agent pool without asking for confirmation")
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, args []string) {
+	opts.Identifier = args[0]
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	pool, err := client.AgentPools.ReadByNameOrID(ctx, opts.Org, opts.Identifier)
+	if err != nil {
+		return fmt.Errorf("failed to read agent pool %s: %w", opts.Identifier, err)
+	}
+
+	if !opts.Yes {
+		prompter := opts.Prompter()
+		if !prompter.CanPrompt() {
+			return fmt.Errorf("confirmation required: use --yes to delete the agent pool without prompting")
+		}
+
+		ok, err := prompter.Confirm(fmt.Sprintf("Delete agent pool %s (%s)?", pool.Name, pool.ID))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Fprintln(opts.IO.Out, "Delete cancelled")
+			return nil
+		}
+	}
+
+	if err := client.AgentPools.Delete(ctx, pool.ID); err != nil {
+		return fmt.Errorf("failed to delete agent pool %s: %w", pool.Name, err)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Deleted agent pool %s (%s)\n", pool.Name, pool.ID)
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package list
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+const (
+	ColumnID         string = "ID"
+	ColumnName       string = "NAME"
+	ColumnAgents     string = "AGENTS"
+	ColumnWorkspaces string = "WORKSPACES"
+	ColumnScope      string = "SCOPE"
+)
+
+var (
+	ColumnsDefault = []string{
+		ColumnName,
+		ColumnAgents,
+		ColumnWorkspaces,
+		ColumnScope,
+	}
+	ColumnsAll = []string{
+		ColumnID,
+		ColumnName,
+		ColumnAgents,
+		ColumnWorkspaces,
+		ColumnScope,
+	}
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	Org     string
+	Name    string
+	Limit   int
+	Columns []string
+}
+
+func NewCmdList(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List agent pools",
+		Aliases: []string{"ls"},
+		Long: text.Heredoc(`
+			List the agent pools of an organization.
+
+			The scope is organization when every workspace of the
+			organization can use the pool, and workspaces when only the
+			allowed workspaces can.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+	cmd.Flags().StringVarP(&opts.Name, "name", "n", "", "Search by the agent pool name.")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "l", 20, "Limit the number of results.")
+	_ = cmdutil.FlagStringEnumSliceP(cmd, &opts.Columns, "columns", "c", ColumnsDefault, "Columns to show.", ColumnsAll)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, _ []string) {
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if opts.Org == "" {
+		return cmdutil.ErrOrgRequired
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	pools, pagination, err := client.AgentPools.List(ctx, opts.Org, &tfc.AgentPoolListOptions{
+		ListOptions: tfc.ListOptions{Limit: opts.Limit},
+		Query:       opts.Name,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list agent pools for %s: %w", opts.Org, err)
+	}
+
+	if pagination.ReachedLimit {
+		fmt.Fprintf(opts.IO.Out, "Showing top %d results\n\n", opts.Limit)
+	}
+
+	p := cmdutil.FieldPrinter(opts.IO, opts.Columns...)
+	for _, pool := range pools {
+		p.Write(extractFields(pool))
+	}
+	p.Flush()
+
+	return nil
+}
+
+func extractFields(p *tfc.AgentPool) map[string]string {
+	scope := "workspaces"
+	if p.OrganizationScoped {
+		scope = "organization"
+	}
+
+	return map[string]string{
+		ColumnID:         p.ID,
+		ColumnName:       p.Name,
+		ColumnAgents:     strconv.Itoa(p.AgentCount),
+		ColumnWorkspaces: strconv.Itoa(len(p.Workspaces)),
+		ColumnScope:      scope,
+	}
+}
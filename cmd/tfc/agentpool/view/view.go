@@ -0,0 +1,259 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/hashicorp/go-tfe"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+// agentLimit is the maximum number of agents read to break down their
+// statuses.
+const agentLimit = 1000
+
+// runLimit is the maximum number of queued runs shown.
+const runLimit = 20
+
+// agentStatuses are the agent statuses in the order they're shown.
+var agentStatuses = []struct {
+	status string
+	label  string
+}{
+	{tfc.AgentIdle, "Idle"},
+	{tfc.AgentBusy, "Busy"},
+	{tfc.AgentUnknown, "Unknown"},
+	{tfc.AgentErrored, "Errored"},
+	{tfc.AgentExited, "Exited"},
+}
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+	Clock           *cmdutil.Clock
+
+	Org        string
+	Identifier string // Agent pool name or ID
+}
+
+func NewCmdView(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+		Clock:           f.Clock,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view <NAME|ID>",
+		Short: "View agent pool details",
+		Long: text.Heredoc(`
+			View detailed information about an agent pool.
+
+			Displays the agents of the pool by status, the workspaces using
+			the pool and the runs queued for its agents. Runs waiting while
+			no agent is idle point to a pool that needs more agents.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# View an agent pool by name
+			$ tfc agent-pools view on-prem --org myorg
+
+			# View an agent pool by ID
+			$ tfc agent-pools view apool-abc123
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, args []string) {
+	opts.Identifier = args[0]
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	found, err := client.AgentPools.ReadByNameOrID(ctx, opts.Org, opts.Identifier)
+	if err != nil {
+		return fmt.Errorf("failed to read agent pool %s: %w", opts.Identifier, err)
+	}
+
+	pool, err := client.AgentPools.ReadWithWorkspaces(ctx, found.ID)
+	if err != nil {
+		return fmt.Errorf("failed to read agent pool %s: %w", found.Name, err)
+	}
+
+	org := opts.Org
+	if pool.Organization != nil {
+		org = pool.Organization.Name
+	}
+
+	agents, agentPagination, err := client.Agents.List(ctx, pool.ID, &tfc.AgentListOptions{
+		ListOptions: tfc.ListOptions{Limit: agentLimit},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list agents for agent pool %s: %w", pool.Name, err)
+	}
+
+	var statuses []string
+	for _, s := range tfc.RunStatusesInGroup(tfc.RunStatusGroupHolding) {
+		statuses = append(statuses, string(s))
+	}
+	sort.Strings(statuses)
+
+	runs, runPagination, err := client.Organizations.ListRuns(ctx, org, &tfc.OrganizationRunListOptions{
+		ListOptions:    tfc.ListOptions{Limit: runLimit},
+		AgentPoolNames: pool.Name,
+		Status:         strings.Join(statuses, ","),
+		Include:        []tfe.RunIncludeOpt{tfe.RunWorkspace},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list queued runs for agent pool %s: %w", pool.Name, err)
+	}
+
+	opts.displayPool(pool, org)
+	opts.displayAgents(agents, agentPagination)
+	opts.displayWorkspaces(pool.Workspaces)
+	opts.displayQueuedRuns(runs, runPagination)
+
+	return nil
+}
+
+func (opts *Options) displayPool(pool *tfc.AgentPool, org string) {
+	out := opts.IO.Out
+
+	scope := "organization"
+	if !pool.OrganizationScoped {
+		scope = "allowed workspaces"
+	}
+
+	fmt.Fprintf(out, "%s\n", headerStyle.Render("IDENTITY"))
+	fmt.Fprintf(out, "  Name:                 %s\n", pool.Name)
+	fmt.Fprintf(out, "  ID:                   %s\n", faintStyle.Render(pool.ID))
+
+	if org != "" {
+		fmt.Fprintf(out, "  Organization:         %s\n", org)
+	}
+
+	fmt.Fprintf(out, "  Scope:                %s\n", scope)
+	fmt.Fprintf(out, "  Created:              %s\n", text.RelativeTimeAgo(opts.Clock.Now(), pool.CreatedAt))
+}
+
+func (opts *Options) displayAgents(agents []*tfc.Agent, pagination *tfc.Pagination) {
+	out := opts.IO.Out
+
+	counts := map[string]int{}
+	for _, a := range agents {
+		counts[a.Status]++
+	}
+
+	total := len(agents)
+	if pagination.ReachedLimit {
+		total = pagination.TotalCount
+	}
+
+	fmt.Fprintf(out, "\n%s\n", headerStyle.Render("AGENTS"))
+	fmt.Fprintf(out, "  Total:                %d\n", total)
+
+	for _, s := range agentStatuses {
+		if n := counts[s.status]; n > 0 {
+			fmt.Fprintf(out, "  %s:%s%d\n", s.label, cmdutil.LabelPadding(s.label), n)
+		}
+	}
+
+	if pagination.ReachedLimit {
+		fmt.Fprintf(out, "  %s\n", faintStyle.Render(
+			fmt.Sprintf("(statuses of the first %d agents)", len(agents)),
+		))
+	}
+}
+
+func (opts *Options) displayWorkspaces(workspaces []*tfc.Workspace) {
+	var names []string
+	for _, ws := range workspaces {
+		name := ws.Name
+		if name == "" {
+			name = ws.ID
+		}
+		names = append(names, name)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "\n%s\n", headerStyle.Render("WORKSPACES"))
+	cmdutil.WriteNames(opts.IO.Out, names)
+}
+
+func (opts *Options) displayQueuedRuns(runs []*tfc.Run, pagination *tfc.Pagination) {
+	out := opts.IO.Out
+
+	fmt.Fprintf(out, "\n%s\n", headerStyle.Render("QUEUED RUNS"))
+	if len(runs) == 0 {
+		fmt.Fprintf(out, "  %s\n", faintStyle.Render("(none)"))
+		return
+	}
+
+	type row struct{ workspace, id, status, queued string }
+
+	var rows []row
+	widths := [3]int{}
+	for _, r := range runs {
+		ws := ""
+		if r.Workspace != nil {
+			ws = r.Workspace.Name
+		}
+
+		rw := row{
+			workspace: ws,
+			id:        r.ID,
+			status:    string(r.Status),
+			queued:    "queued " + text.RelativeTimeAgo(opts.Clock.Now(), r.CreatedAt),
+		}
+		rows = append(rows, rw)
+
+		widths[0] = max(widths[0], len(rw.workspace))
+		widths[1] = max(widths[1], len(rw.id))
+		widths[2] = max(widths[2], len(rw.status))
+	}
+
+	for _, r := range rows {
+		fmt.Fprintf(out, "  %-*s  %-*s  %-*s  %s\n",
+			widths[0], r.workspace, widths[1], r.id, widths[2], r.status, faintStyle.Render(r.queued))
+	}
+
+	if pagination.ReachedLimit {
+		fmt.Fprintf(out, "  %s\n", faintStyle.Render(
+			fmt.Sprintf("(%d of %d queued runs)", len(runs), pagination.TotalCount),
+		))
+	}
+}
+
+var (
+	faintStyle  = lipgloss.NewStyle().Faint(true)
+	headerStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("6"))
+)
@@ -0,0 +1,172 @@
+package view_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/zkhvan/tfc/cmd/tfc/agentpool/view"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/clock"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+var referenceTime = time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+func TestView(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/agent-pools",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [{"id": "apool-1", "type": "agent-pools", "attributes": {"name": "on-prem"}}]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/agent-pools/apool-1",
+		func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("include"); got != "workspaces" {
+				t.Errorf("got include %q", got)
+			}
+
+			fmt.Fprint(w, `
+				{
+					"data": {
+						"id": "apool-1",
+						"type": "agent-pools",
+						"attributes": {
+							"name": "on-prem",
+							"organization-scoped": true,
+							"created-at": "1999-06-01T12:00:00Z"
+						},
+						"relationships": {
+							"organization": {"data": {"id": "myorg", "type": "organizations"}},
+							"workspaces": {"data": [{"id": "ws-1", "type": "workspaces"}, {"id": "ws-2", "type": "workspaces"}]}
+						}
+					},
+					"included": [
+						{"id": "ws-1", "type": "workspaces", "attributes": {"name": "network"}},
+						{"id": "ws-2", "type": "workspaces", "attributes": {"name": "dns"}}
+					]
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/agent-pools/apool-1/agents",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{"id": "agent-1", "type": "agents", "attributes": {"status": "busy"}},
+						{"id": "agent-2", "type": "agents", "attributes": {"status": "busy"}},
+						{"id": "agent-3", "type": "agents", "attributes": {"status": "errored"}}
+					]
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/runs",
+		func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("filter[agent_pool_names]"); got != "on-prem" {
+				t.Errorf("got agent pool filter %q", got)
+			}
+			if got := r.URL.Query().Get("filter[status]"); got != "apply_queued,pending,plan_queued,queuing" {
+				t.Errorf("got status filter %q", got)
+			}
+
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "run-1",
+							"type": "runs",
+							"attributes": {"status": "plan_queued", "created-at": "2000-01-01T11:30:00Z"},
+							"relationships": {"workspace": {"data": {"id": "ws-1", "type": "workspaces"}}}
+						},
+						{
+							"id": "run-22",
+							"type": "runs",
+							"attributes": {"status": "pending", "created-at": "2000-01-01T09:00:00Z"},
+							"relationships": {"workspace": {"data": {"id": "ws-2", "type": "workspaces"}}}
+						}
+					],
+					"included": [
+						{"id": "ws-1", "type": "workspaces", "attributes": {"name": "network"}},
+						{"id": "ws-2", "type": "workspaces", "attributes": {"name": "dns"}}
+					]
+				}
+			`)
+		},
+	)
+
+	result := runCommand(t, client, "on-prem", "--org", "myorg")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		IDENTITY
+		  Name:                 on-prem
+		  ID:                   apool-1
+		  Organization:         myorg
+		  Scope:                organization
+		  Created:              about 7 months ago
+
+		AGENTS
+		  Total:                3
+		  Busy:                 2
+		  Errored:              1
+
+		WORKSPACES
+		  dns
+		  network
+
+		QUEUED RUNS
+		  network  run-1   plan_queued  queued about 30 minutes ago
+		  dns      run-22  pending      queued about 3 hours ago
+	`))
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+		Clock:           cmdutil.NewClock(clock.FrozenClock(referenceTime)),
+	}
+
+	cmd := view.NewCmdView(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
@@ -3,9 +3,22 @@ package main
 import (
 	"github.com/spf13/cobra"
 
+	agentCmd "github.com/zkhvan/tfc/cmd/tfc/agent"
+	agentpoolCmd "github.com/zkhvan/tfc/cmd/tfc/agentpool"
+	execCmd "github.com/zkhvan/tfc/cmd/tfc/execvars"
+	graphCmd "github.com/zkhvan/tfc/cmd/tfc/graph"
 	initCmd "github.com/zkhvan/tfc/cmd/tfc/init"
+	notificationsCmd "github.com/zkhvan/tfc/cmd/tfc/notifications"
 	organizationCmd "github.com/zkhvan/tfc/cmd/tfc/organization"
+	policysetCmd "github.com/zkhvan/tfc/cmd/tfc/policyset"
+	projectCmd "github.com/zkhvan/tfc/cmd/tfc/project"
 	runCmd "github.com/zkhvan/tfc/cmd/tfc/run"
+	runtaskCmd "github.com/zkhvan/tfc/cmd/tfc/runtask"
+	searchCmd "github.com/zkhvan/tfc/cmd/tfc/search"
+	stateCmd "github.com/zkhvan/tfc/cmd/tfc/state"
+	teamCmd "github.com/zkhvan/tfc/cmd/tfc/team"
+	tokenCmd "github.com/zkhvan/tfc/cmd/tfc/token"
+	varsetCmd "github.com/zkhvan/tfc/cmd/tfc/varset"
 	versionCmd "github.com/zkhvan/tfc/cmd/tfc/version"
 	workspaceCmd "github.com/zkhvan/tfc/cmd/tfc/workspace"
 	"github.com/zkhvan/tfc/pkg/cmdutil"
@@ -36,7 +49,20 @@ func NewCmdRoot(f *cmdutil.Factory, version, date string) *cobra.Command {
 	cmd.AddCommand(initCmd.NewCmdInit(f))
 	cmd.AddCommand(workspaceCmd.NewCmdWorkspace(f))
 	cmd.AddCommand(organizationCmd.NewCmdOrganization(f))
+	cmd.AddCommand(projectCmd.NewCmdProject(f))
+	cmd.AddCommand(teamCmd.NewCmdTeam(f))
+	cmd.AddCommand(tokenCmd.NewCmdToken(f))
+	cmd.AddCommand(agentpoolCmd.NewCmdAgentPool(f))
+	cmd.AddCommand(agentCmd.NewCmdAgent(f))
+	cmd.AddCommand(policysetCmd.NewCmdPolicySet(f))
 	cmd.AddCommand(runCmd.NewCmdRun(f))
+	cmd.AddCommand(runtaskCmd.NewCmdRunTask(f))
+	cmd.AddCommand(stateCmd.NewCmdState(f))
+	cmd.AddCommand(searchCmd.NewCmdSearch(f))
+	cmd.AddCommand(graphCmd.NewCmdGraph(f))
+	cmd.AddCommand(notificationsCmd.NewCmdNotifications(f))
+	cmd.AddCommand(varsetCmd.NewCmdVarset(f))
+	cmd.AddCommand(execCmd.NewCmdExec(f))
 
 	return cmd
 }
@@ -0,0 +1,207 @@
+package execvars
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"syscall"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/dotenv"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/signal"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+	"github.com/zkhvan/tfc/pkg/vareffective"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	WorkspaceID  cmdutil.WorkspaceIdentifier
+	SecretsFile  string
+	AllowMissing bool
+	Command      []string
+}
+
+func NewCmdExec(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "exec [-W ORG/WORKSPACE] -- <command> [<args>...]",
+		Short: "Run a command with a workspace's environment variables",
+		Long: text.Heredoc(`
+			Run a local command with the environment variables of a workspace.
+
+			The env variables of the workspace and of the variable sets applied
+			to it are resolved with the same precedence as in runs, see
+			"tfc workspaces variables list --effective", and added to the
+			command's environment.
+
+			Sensitive variables can't be read back, so their values are read
+			from the .env formatted --secrets-file. Missing values are an
+			error, unless --allow-missing is given.
+
+			Signals are forwarded to the command, except Ctrl-C and Ctrl-\ on
+			a terminal, which already reach it. tfc exits with the command's
+			exit code, or 128 plus the signal number if a signal killed it.
+
+			If -W/--workspace is not specified and state.tf is present,
+			the organization and workspace will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Check which identity the workspace's AWS credentials resolve to
+			$ tfc exec -W myorg/app-prod -- aws sts get-caller-identity
+
+			# Fill sensitive variables from a local file
+			$ tfc exec -W myorg/app-prod --secrets-file .secrets.env -- terraform plan
+		`),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	// Flags after the command belong to the command.
+	cmd.Flags().SetInterspersed(false)
+
+	cmdutil.AddWorkspaceFlag(cmd, &opts.WorkspaceID, opts.TFEClient)
+
+	cmd.Flags().StringVar(&opts.SecretsFile, "secrets-file", "",
+		"File with the values of sensitive variables in .env format",
+	)
+	cmd.Flags().BoolVar(&opts.AllowMissing, "allow-missing", false,
+		"Run the command even if sensitive variables have no value",
+	)
+
+	_ = cmdutil.MarkFlagsWithNoFileCompletions(cmd, "allow-missing")
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, args []string) {
+	opts.Command = args
+	cmdutil.CompleteWorkspaceIdentifierSilent(cmd, &opts.WorkspaceID, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := opts.WorkspaceID.Validate(); err != nil {
+		return fmt.Errorf("workspace required: use -W ORG/WORKSPACE or ensure state.tf exists")
+	}
+
+	secrets, err := opts.readSecrets()
+	if err != nil {
+		return err
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	ws, err := client.Workspaces.Read(ctx, opts.WorkspaceID.Org, opts.WorkspaceID.Workspace)
+	if err != nil {
+		return fmt.Errorf("failed to read workspace %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	defs, err := vareffective.Load(ctx, client, ws)
+	if err != nil {
+		return err
+	}
+
+	env := os.Environ()
+
+	var missing []string
+	for _, v := range vareffective.Resolve(defs) {
+		if v.Category != tfe.CategoryEnv {
+			continue
+		}
+
+		value := v.Value
+		if v.Sensitive {
+			s, ok := secrets[v.Key]
+			if !ok {
+				missing = append(missing, v.Key)
+				continue
+			}
+			value = s
+		}
+
+		env = append(env, v.Key+"="+value)
+	}
+
+	if len(missing) > 0 {
+		if !opts.AllowMissing {
+			return fmt.Errorf("missing values for sensitive variables %s: set them in --secrets-file or use --allow-missing",
+				strings.Join(missing, ", "),
+			)
+		}
+
+		fmt.Fprintf(opts.IO.ErrOut, "Warning: missing values for sensitive variables %s\n", strings.Join(missing, ", "))
+	}
+
+	return opts.exec(env)
+}
+
+// readSecrets reads the values of sensitive variables from --secrets-file.
+func (opts *Options) readSecrets() (map[string]string, error) {
+	if opts.SecretsFile == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(opts.SecretsFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read secrets file: %w", err)
+	}
+
+	entries, err := dotenv.Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse secrets file %s: %w", opts.SecretsFile, err)
+	}
+
+	return dotenv.Map(entries), nil
+}
+
+// exec runs the command with the environment, forwarding signals to it. The
+// command isn't tied to the context: it's stopped by the forwarded signals.
+func (opts *Options) exec(env []string) error {
+	cmd := exec.Command(opts.Command[0], opts.Command[1:]...)
+	cmd.Env = env
+	cmd.Stdin = opts.IO.In
+	cmd.Stdout = opts.IO.Out
+	cmd.Stderr = opts.IO.ErrOut
+
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to run %s: %w", opts.Command[0], err)
+	}
+
+	stop := signal.Forward(cmd.Process)
+	err := cmd.Wait()
+	stop()
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		code := exitErr.ExitCode()
+		if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+			// Killed by a signal, exit like a shell would.
+			code = 128 + int(status.Signal())
+		}
+		return &cmdutil.ExitError{Code: code}
+	}
+
+	return err
+}
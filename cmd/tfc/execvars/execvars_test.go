@@ -0,0 +1,443 @@
+package execvars_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/zkhvan/tfc/cmd/tfc/execvars"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+const printEnv = `printf '%s|%s|%s|%s\n' "$AWS_REGION" "$AWS_ACCESS_KEY_ID" "$AWS_SECRET_ACCESS_KEY" "$region"`
+
+func TestExec(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces/app-prod",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ws-1","type":"workspaces","attributes":{"name":"app-prod"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/ws-1/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-1",
+							"type": "vars",
+							"attributes": {"key": "AWS_REGION", "value": "eu-west-1", "category": "env"}
+						},
+						{
+							"id": "var-2",
+							"type": "vars",
+							"attributes": {"key": "region", "value": "eu-west-1", "category": "terraform"}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/ws-1/varsets",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":[{"id":"varset-1","type":"varsets","attributes":{"name":"aws","global":true}}]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/varsets/varset-1/relationships/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-3",
+							"type": "vars",
+							"attributes": {"key": "AWS_REGION", "value": "us-east-1", "category": "env"}
+						},
+						{
+							"id": "var-4",
+							"type": "vars",
+							"attributes": {"key": "AWS_ACCESS_KEY_ID", "value": "AKIA123", "category": "env"}
+						},
+						{
+							"id": "var-5",
+							"type": "vars",
+							"attributes": {"key": "AWS_SECRET_ACCESS_KEY", "category": "env", "sensitive": true}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	secrets := filepath.Join(t.TempDir(), "secrets.env")
+	if err := os.WriteFile(secrets, []byte("AWS_SECRET_ACCESS_KEY=s3cr3t\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := runCommand(t, client, "-W", "myorg/app-prod", "--secrets-file", secrets, "--", "sh", "-c", printEnv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, "eu-west-1|AKIA123|s3cr3t|\n")
+}
+
+func TestExec_missing_secret(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces/app-prod",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ws-1","type":"workspaces","attributes":{"name":"app-prod"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/ws-1/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-1",
+							"type": "vars",
+							"attributes": {"key": "AWS_REGION", "value": "eu-west-1", "category": "env"}
+						},
+						{
+							"id": "var-2",
+							"type": "vars",
+							"attributes": {"key": "region", "value": "eu-west-1", "category": "terraform"}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/ws-1/varsets",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":[{"id":"varset-1","type":"varsets","attributes":{"name":"aws","global":true}}]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/varsets/varset-1/relationships/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-3",
+							"type": "vars",
+							"attributes": {"key": "AWS_REGION", "value": "us-east-1", "category": "env"}
+						},
+						{
+							"id": "var-4",
+							"type": "vars",
+							"attributes": {"key": "AWS_ACCESS_KEY_ID", "value": "AKIA123", "category": "env"}
+						},
+						{
+							"id": "var-5",
+							"type": "vars",
+							"attributes": {"key": "AWS_SECRET_ACCESS_KEY", "category": "env", "sensitive": true}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	result, _ := runCommand(t, client, "-W", "myorg/app-prod", "--", "sh", "-c", printEnv)
+
+	test.BufferEmpty(t, result.OutBuf)
+	test.Buffer(t, result.ErrBuf,
+		"missing values for sensitive variables AWS_SECRET_ACCESS_KEY: set them in --secrets-file or use --allow-missing\n",
+	)
+}
+
+func TestExec_allow_missing(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces/app-prod",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ws-1","type":"workspaces","attributes":{"name":"app-prod"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/ws-1/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-1",
+							"type": "vars",
+							"attributes": {"key": "AWS_REGION", "value": "eu-west-1", "category": "env"}
+						},
+						{
+							"id": "var-2",
+							"type": "vars",
+							"attributes": {"key": "region", "value": "eu-west-1", "category": "terraform"}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/ws-1/varsets",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":[{"id":"varset-1","type":"varsets","attributes":{"name":"aws","global":true}}]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/varsets/varset-1/relationships/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-3",
+							"type": "vars",
+							"attributes": {"key": "AWS_REGION", "value": "us-east-1", "category": "env"}
+						},
+						{
+							"id": "var-4",
+							"type": "vars",
+							"attributes": {"key": "AWS_ACCESS_KEY_ID", "value": "AKIA123", "category": "env"}
+						},
+						{
+							"id": "var-5",
+							"type": "vars",
+							"attributes": {"key": "AWS_SECRET_ACCESS_KEY", "category": "env", "sensitive": true}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	result, err := runCommand(t, client, "-W", "myorg/app-prod", "--allow-missing", "sh", "-c", printEnv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	test.Buffer(t, result.ErrBuf, "Warning: missing values for sensitive variables AWS_SECRET_ACCESS_KEY\n")
+	test.Buffer(t, result.OutBuf, "eu-west-1|AKIA123||\n")
+}
+
+func TestExec_exit_code(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces/app-prod",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ws-1","type":"workspaces","attributes":{"name":"app-prod"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/ws-1/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-1",
+							"type": "vars",
+							"attributes": {"key": "AWS_REGION", "value": "eu-west-1", "category": "env"}
+						},
+						{
+							"id": "var-2",
+							"type": "vars",
+							"attributes": {"key": "region", "value": "eu-west-1", "category": "terraform"}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/ws-1/varsets",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":[{"id":"varset-1","type":"varsets","attributes":{"name":"aws","global":true}}]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/varsets/varset-1/relationships/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-3",
+							"type": "vars",
+							"attributes": {"key": "AWS_REGION", "value": "us-east-1", "category": "env"}
+						},
+						{
+							"id": "var-4",
+							"type": "vars",
+							"attributes": {"key": "AWS_ACCESS_KEY_ID", "value": "AKIA123", "category": "env"}
+						},
+						{
+							"id": "var-5",
+							"type": "vars",
+							"attributes": {"key": "AWS_SECRET_ACCESS_KEY", "category": "env", "sensitive": true}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	_, err := runCommand(t, client, "-W", "myorg/app-prod", "--allow-missing", "--", "sh", "-c", "exit 3")
+
+	var exitErr *cmdutil.ExitError
+	if !errors.As(err, &exitErr) || exitErr.Code != 3 {
+		t.Fatalf("got error %v, want exit status 3", err)
+	}
+}
+
+func TestExec_killed_by_signal(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("processes aren't killed by signals on Windows")
+	}
+
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces/app-prod",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ws-1","type":"workspaces","attributes":{"name":"app-prod"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/ws-1/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-1",
+							"type": "vars",
+							"attributes": {"key": "AWS_REGION", "value": "eu-west-1", "category": "env"}
+						},
+						{
+							"id": "var-2",
+							"type": "vars",
+							"attributes": {"key": "region", "value": "eu-west-1", "category": "terraform"}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/ws-1/varsets",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":[{"id":"varset-1","type":"varsets","attributes":{"name":"aws","global":true}}]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/varsets/varset-1/relationships/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-3",
+							"type": "vars",
+							"attributes": {"key": "AWS_REGION", "value": "us-east-1", "category": "env"}
+						},
+						{
+							"id": "var-4",
+							"type": "vars",
+							"attributes": {"key": "AWS_ACCESS_KEY_ID", "value": "AKIA123", "category": "env"}
+						},
+						{
+							"id": "var-5",
+							"type": "vars",
+							"attributes": {"key": "AWS_SECRET_ACCESS_KEY", "category": "env", "sensitive": true}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	_, err := runCommand(t, client, "-W", "myorg/app-prod", "--allow-missing", "--", "sh", "-c", "kill -TERM $$")
+
+	var exitErr *cmdutil.ExitError
+	if !errors.As(err, &exitErr) || exitErr.Code != 143 {
+		t.Fatalf("got error %v, want exit status 143", err)
+	}
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) (*tfetest.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+	}
+
+	cmd := execvars.NewCmdExec(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		var exitErr *cmdutil.ExitError
+		if !errors.As(err, &exitErr) {
+			fmt.Fprintln(stderr, err)
+		}
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
@@ -0,0 +1,28 @@
+package graph
+
+import (
+	"github.com/spf13/cobra"
+
+	remotestateCmd "github.com/zkhvan/tfc/cmd/tfc/graph/remotestate"
+	runtriggersCmd "github.com/zkhvan/tfc/cmd/tfc/graph/runtriggers"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+func NewCmdGraph(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "graph",
+		Short: "Graph the dependencies between workspaces",
+		Long: text.Heredoc(`
+			Graph the dependencies between the workspaces of an organization.
+
+			The graphs are printed as a report, or as Graphviz DOT, Mermaid
+			or JSON to render or process them elsewhere.
+		`),
+	}
+
+	cmd.AddCommand(remotestateCmd.NewCmdRemoteState(f))
+	cmd.AddCommand(runtriggersCmd.NewCmdRunTriggers(f))
+
+	return cmd
+}
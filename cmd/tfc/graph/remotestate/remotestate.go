@@ -0,0 +1,402 @@
+package remotestate
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/hashicorp/go-tfe"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/cmd/tfc/graph/runtriggers"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/graph"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/parallel"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+// SourceConsumer is the source of an edge from a workspace to one of its
+// remote state consumers. The other sources are the data sources of the
+// configuration of the reading workspace.
+const SourceConsumer string = "consumer"
+
+// Edge is a workspace whose state is read by another workspace, or allowed to
+// be read.
+type Edge struct {
+	From    string   `json:"from"`
+	To      string   `json:"to"`
+	Sources []string `json:"sources"`
+}
+
+// Report is the analysis of the remote state graph of an organization.
+type Report struct {
+	Nodes []string `json:"nodes"`
+	Edges []Edge   `json:"edges"`
+
+	// UnreadGlobalRemoteState are the workspaces with global remote state
+	// whose state no configuration reads.
+	UnreadGlobalRemoteState []string `json:"unread_global_remote_state"`
+
+	// UnknownGlobalRemoteState are the workspaces with global remote state
+	// whose state no scanned configuration reads, but that a configuration
+	// that couldn't be fully scanned may read.
+	UnknownGlobalRemoteState []string `json:"unknown_global_remote_state"`
+
+	// ReadsWithoutAccess are the configurations reading the state of a
+	// workspace without global remote state that they're not a remote state
+	// consumer of.
+	ReadsWithoutAccess []graph.Edge `json:"reads_without_access"`
+}
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	Org         string
+	Concurrency int
+	Format      string
+}
+
+func NewCmdRemoteState(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "remote-state",
+		Short: "Graph which workspaces read the state of which",
+		Long: text.Heredoc(`
+			Graph which workspaces of an organization read the state of which.
+			An edge from a workspace to another means the second reads, or is
+			allowed to read, the state of the first.
+
+			The graph combines the remote state consumers of the workspaces
+			with the terraform_remote_state and tfe_outputs data sources
+			found in their current configuration versions. Data sources
+			reading other organizations are left out, and data sources whose
+			workspace depends on variables are warned about.
+
+			The report flags the workspaces with global remote state that no
+			configuration reads, and the configurations reading a workspace
+			they aren't a remote state consumer of. Configurations that fail
+			to download or parse, or have data sources depending on
+			variables, may read any workspace, so the workspaces they could
+			read are reported as unknown rather than unread. The DOT and Mermaid graphs leave out
+			the workspaces without any edge.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Show who reads whom in an organization
+			$ tfc graph remote-state --org myorg
+
+			# Render the graph with Mermaid
+			$ tfc graph remote-state --org myorg --format mermaid
+		`),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+
+	_ = cmdutil.FlagStringEnum(cmd, &opts.Format, "format", cmdutil.FormatTable, "Output format", runtriggers.Formats)
+	cmd.Flags().IntVar(&opts.Concurrency, "concurrency", parallel.DefaultConcurrency,
+		"Number of workspaces to scan concurrently.",
+	)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, _ []string) {
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+// scan is what a workspace shares its state with and reads the state of.
+type scan struct {
+	consumers []*tfc.Workspace
+	refs      []tfconfig.RemoteStateReference
+
+	// scanned is false when the workspace has no uploaded configuration
+	// version to find data sources in.
+	scanned bool
+
+	// err is the error parsing the configuration, refs are then only the data
+	// sources of the files that could be parsed.
+	err error
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if opts.Org == "" {
+		return cmdutil.ErrOrgRequired
+	}
+
+	if err := cmdutil.ValidateEnum("format", opts.Format, runtriggers.Formats); err != nil {
+		return err
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	workspaces, _, err := client.Workspaces.List(ctx, opts.Org, &tfc.WorkspaceListOptions{
+		ListOptions: tfc.ListOptions{Limit: math.MaxInt},
+		Include:     []tfe.WSIncludeOpt{tfe.WSCurrentConfigVer},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list workspaces for %s: %w", opts.Org, err)
+	}
+
+	scans, fetchErrs := parallel.Map(ctx, workspaces, opts.Concurrency,
+		func(ctx context.Context, ws *tfc.Workspace) (*scan, error) {
+			return scanWorkspace(ctx, client, ws)
+		},
+	)
+
+	var errs []error
+	for i, ws := range workspaces {
+		if fetchErrs[i] != nil {
+			errs = append(errs, fmt.Errorf("error scanning workspace %q: %w", ws.Name, fetchErrs[i]))
+		}
+	}
+
+	g, r := opts.buildReport(workspaces, scans)
+
+	switch opts.Format {
+	case cmdutil.FormatJSON:
+		if err := cmdutil.PrintJSON(opts.IO, r); err != nil {
+			return err
+		}
+	case runtriggers.FormatDOT:
+		if err := g.Connected().WriteDOT(opts.IO.Out, "remote-state"); err != nil {
+			return err
+		}
+	case runtriggers.FormatMermaid:
+		if err := g.Connected().WriteMermaid(opts.IO.Out); err != nil {
+			return err
+		}
+	default:
+		writeReport(opts.IO.Out, r)
+	}
+
+	return errors.Join(errs...)
+}
+
+func scanWorkspace(ctx context.Context, client *tfc.Client, ws *tfc.Workspace) (*scan, error) {
+	s := &scan{}
+
+	// The remote state consumers only apply without global remote state.
+	if !ws.GlobalRemoteState {
+		consumers, err := client.Workspaces.ListRemoteStateConsumers(ctx, ws.ID)
+		if err != nil {
+			return nil, err
+		}
+		s.consumers = consumers
+	}
+
+	cv := ws.CurrentConfigurationVersion
+	if cv == nil || cv.Status != tfe.ConfigurationUploaded {
+		return s, nil
+	}
+
+	archive, err := client.ConfigurationVersions.Download(ctx, cv.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download configuration version %s: %w", cv.ID, err)
+	}
+
+	s.refs, s.err = tfconfig.ArchiveRemoteStateReferences(bytes.NewReader(archive))
+	s.scanned = true
+
+	return s, nil
+}
+
+// buildReport builds the graph of the remote state reads and its report,
+// warning about the data sources and workspaces it can't account for.
+func (opts *Options) buildReport(workspaces []*tfc.Workspace, scans []*scan) (*graph.Graph, *Report) {
+	var (
+		g      = graph.New()
+		exists = map[string]bool{}
+		global = map[string]bool{}
+
+		// The sources of each edge, and the edges from workspaces to their
+		// remote state consumers.
+		sources  = map[graph.Edge][]string{}
+		readable = map[graph.Edge]bool{}
+
+		// Workspaces without a configuration to scan.
+		unknown []string
+
+		// Workspaces whose configuration may read the state of workspaces
+		// of the organization that the graph is missing.
+		partial []string
+	)
+
+	addEdge := func(e graph.Edge, source string) {
+		g.AddEdge(e.From, e.To)
+		if !slices.Contains(sources[e], source) {
+			sources[e] = append(sources[e], source)
+		}
+	}
+
+	for _, ws := range workspaces {
+		g.AddNode(ws.Name)
+		exists[ws.Name] = true
+		global[ws.Name] = ws.GlobalRemoteState
+	}
+
+	for i, ws := range workspaces {
+		s := scans[i]
+		if s == nil {
+			// The scan failed, so the workspace may read any state.
+			partial = append(partial, ws.Name)
+			continue
+		}
+
+		if !s.scanned {
+			unknown = append(unknown, ws.Name)
+		}
+
+		for _, c := range s.consumers {
+			e := graph.Edge{From: ws.Name, To: c.Name}
+			readable[e] = true
+			addEdge(e, SourceConsumer)
+		}
+
+		if s.err != nil {
+			fmt.Fprintf(opts.IO.ErrOut, "Warning: failed to scan the configuration of %s: %v\n", ws.Name, s.err)
+			partial = append(partial, ws.Name)
+		}
+
+		unresolved := 0
+		for _, ref := range s.refs {
+			if !ref.Resolved {
+				unresolved++
+				if ref.Organization == "" || ref.Organization == opts.Org {
+					partial = append(partial, ws.Name)
+				}
+				continue
+			}
+			if ref.Organization != "" && ref.Organization != opts.Org {
+				continue
+			}
+			if !exists[ref.Workspace] {
+				fmt.Fprintf(opts.IO.ErrOut, "Warning: %s reads the state of %s, which doesn't exist (%s.%s in %s)\n",
+					ws.Name, ref.Workspace, ref.Source, ref.Name, ref.Filename,
+				)
+				continue
+			}
+
+			addEdge(graph.Edge{From: ref.Workspace, To: ws.Name}, ref.Source)
+		}
+
+		if unresolved > 0 {
+			fmt.Fprintf(opts.IO.ErrOut, "Warning: %s has %s whose workspace depends on variables\n",
+				ws.Name, text.Pluralize(unresolved, "remote state data source"),
+			)
+		}
+	}
+
+	if len(unknown) > 0 {
+		fmt.Fprintf(opts.IO.ErrOut, "Warning: no configuration to scan for %s\n", strings.Join(unknown, ", "))
+	}
+
+	r := &Report{
+		Nodes:                    g.Nodes(),
+		Edges:                    []Edge{},
+		UnreadGlobalRemoteState:  []string{},
+		UnknownGlobalRemoteState: []string{},
+		ReadsWithoutAccess:       []graph.Edge{},
+	}
+
+	read := map[string]bool{}
+	for _, e := range g.Edges() {
+		srcs := sources[e]
+		slices.Sort(srcs)
+		r.Edges = append(r.Edges, Edge{From: e.From, To: e.To, Sources: srcs})
+
+		configured := slices.ContainsFunc(srcs, func(s string) bool { return s != SourceConsumer })
+		if configured {
+			read[e.From] = true
+		}
+		if configured && !global[e.From] && !readable[e] {
+			r.ReadsWithoutAccess = append(r.ReadsWithoutAccess, e)
+		}
+	}
+
+	slices.Sort(partial)
+	partial = slices.Compact(partial)
+
+	for _, n := range r.Nodes {
+		if !global[n] || read[n] {
+			continue
+		}
+
+		// A configuration doesn't read its own workspace's state.
+		if slices.ContainsFunc(partial, func(p string) bool { return p != n }) {
+			r.UnknownGlobalRemoteState = append(r.UnknownGlobalRemoteState, n)
+		} else {
+			r.UnreadGlobalRemoteState = append(r.UnreadGlobalRemoteState, n)
+		}
+	}
+
+	return g, r
+}
+
+func writeReport(out io.Writer, r *Report) {
+	fmt.Fprintf(out, "%s\n", headerStyle.Render("REMOTE STATE"))
+	if len(r.Edges) == 0 {
+		fmt.Fprintf(out, "  %s\n", faintStyle.Render("(none)"))
+	}
+
+	widths := [2]int{}
+	for _, e := range r.Edges {
+		widths[0] = max(widths[0], len(e.From))
+		widths[1] = max(widths[1], len(e.To))
+	}
+	for _, e := range r.Edges {
+		fmt.Fprintf(out, "  %-*s  ->  %-*s  %s\n",
+			widths[0], e.From, widths[1], e.To, faintStyle.Render(strings.Join(e.Sources, ", ")))
+	}
+
+	fmt.Fprintf(out, "\n%s\n", headerStyle.Render("UNREAD GLOBAL REMOTE STATE"))
+	if len(r.UnreadGlobalRemoteState) == 0 && len(r.UnknownGlobalRemoteState) == 0 {
+		fmt.Fprintf(out, "  %s\n", faintStyle.Render("(none)"))
+	}
+	for _, n := range r.UnreadGlobalRemoteState {
+		fmt.Fprintf(out, "  %s\n", n)
+	}
+	for _, n := range r.UnknownGlobalRemoteState {
+		fmt.Fprintf(out, "  %s  %s\n", n, faintStyle.Render("(unknown)"))
+	}
+
+	fmt.Fprintf(out, "\n%s\n", headerStyle.Render("READS WITHOUT ACCESS"))
+	if len(r.ReadsWithoutAccess) == 0 {
+		fmt.Fprintf(out, "  %s\n", faintStyle.Render("(none)"))
+	}
+	for _, e := range r.ReadsWithoutAccess {
+		fmt.Fprintf(out, "  %s reads %s\n", e.To, e.From)
+	}
+}
+
+var (
+	faintStyle  = lipgloss.NewStyle().Faint(true)
+	headerStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("6"))
+)
@@ -0,0 +1,523 @@
+package remotestate_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/zkhvan/tfc/cmd/tfc/graph/remotestate"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+// configs are the .tf files of the configuration versions of network, dns and
+// app: app reads network and dns, and a workspace depending on variables, and
+// dns reads network.
+var configs = map[string][]string{
+	"cv-1": {"main.tf", `resource "aws_vpc" "main" {}`},
+	"cv-2": {"main.tf", `data "tfe_outputs" "network" { workspace = "network" }`},
+	"cv-3": {"main.tf", text.Heredoc(`
+		data "terraform_remote_state" "network" {
+		  backend = "remote"
+		  config = {
+		    organization = "myorg"
+		    workspaces = {
+		      name = "network"
+		    }
+		  }
+		}
+
+		data "tfe_outputs" "dns" {
+		  organization = "myorg"
+		  workspace    = "dns"
+		}
+
+		data "tfe_outputs" "env" {
+		  workspace = "app-${var.env}"
+		}
+
+		data "tfe_outputs" "iam" {
+		  organization = "security"
+		  workspace    = "iam"
+		}
+	`)},
+}
+
+func workspace(id, name string, global bool, cv string) string {
+	return fmt.Sprintf(`{
+		"id": %q,
+		"type": "workspaces",
+		"attributes": {"name": %q, "global-remote-state": %t},
+		"relationships": {"current-configuration-version": {"data": {"id": %q, "type": "configuration-versions"}}}
+	}`, id, name, global, cv)
+}
+
+func TestRemoteState(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces",
+		func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("include"); got != "current_configuration_version" {
+				t.Errorf("got include %q", got)
+			}
+
+			fmt.Fprint(w, `{
+				"data": [
+					`+workspace("ws-1", "network", false, "cv-1")+`,
+					`+workspace("ws-2", "dns", true, "cv-2")+`,
+					`+workspace("ws-3", "app", false, "cv-3")+`,
+					{"id": "ws-4", "type": "workspaces", "attributes": {"name": "iam", "global-remote-state": true}}
+				],
+				"included": [
+					{"id": "cv-1", "type": "configuration-versions", "attributes": {"status": "uploaded"}},
+					{"id": "cv-2", "type": "configuration-versions", "attributes": {"status": "uploaded"}},
+					{"id": "cv-3", "type": "configuration-versions", "attributes": {"status": "uploaded"}}
+				]
+			}`)
+		},
+	)
+
+	consumers := map[string]string{
+		"ws-1": `{"id": "ws-3", "type": "workspaces", "attributes": {"name": "app"}}`,
+	}
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{id}/relationships/remote-state-consumers",
+		func(w http.ResponseWriter, r *http.Request) {
+			if id := r.PathValue("id"); id == "ws-2" || id == "ws-4" {
+				t.Errorf("listed the remote state consumers of %s, which has global remote state", id)
+			}
+
+			fmt.Fprintf(w, `{"data": [%s]}`, consumers[r.PathValue("id")])
+		},
+	)
+
+	archives := map[string][]byte{
+		"cv-1": tarball(t, configs["cv-1"]...),
+		"cv-2": tarball(t, configs["cv-2"]...),
+		"cv-3": tarball(t, configs["cv-3"]...),
+	}
+	mux.HandleFunc(
+		"GET /api/v2/configuration-versions/{id}/download",
+		func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write(archives[r.PathValue("id")])
+		},
+	)
+
+	result := runCommand(t, client, "--org", "myorg")
+
+	test.Buffer(t, result.ErrBuf, text.Heredoc(`
+		Warning: app has 1 remote state data source whose workspace depends on variables
+		Warning: no configuration to scan for iam
+	`))
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		REMOTE STATE
+		  dns      ->  app  tfe_outputs
+		  network  ->  app  consumer, terraform_remote_state
+		  network  ->  dns  tfe_outputs
+
+		UNREAD GLOBAL REMOTE STATE
+		  iam  (unknown)
+
+		READS WITHOUT ACCESS
+		  dns reads network
+	`))
+}
+
+func TestRemoteState_unread(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces",
+		func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("include"); got != "current_configuration_version" {
+				t.Errorf("got include %q", got)
+			}
+
+			fmt.Fprint(w, `{
+				"data": [
+					`+workspace("ws-1", "network", false, "cv-1")+`,
+					`+workspace("ws-2", "dns", true, "cv-2")+`,
+					`+workspace("ws-3", "app", false, "cv-3")+`,
+					{"id": "ws-4", "type": "workspaces", "attributes": {"name": "iam", "global-remote-state": true}}
+				],
+				"included": [
+					{"id": "cv-1", "type": "configuration-versions", "attributes": {"status": "uploaded"}},
+					{"id": "cv-2", "type": "configuration-versions", "attributes": {"status": "uploaded"}},
+					{"id": "cv-3", "type": "configuration-versions", "attributes": {"status": "uploaded"}}
+				]
+			}`)
+		},
+	)
+
+	consumers := map[string]string{
+		"ws-1": `{"id": "ws-3", "type": "workspaces", "attributes": {"name": "app"}}`,
+	}
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{id}/relationships/remote-state-consumers",
+		func(w http.ResponseWriter, r *http.Request) {
+			if id := r.PathValue("id"); id == "ws-2" || id == "ws-4" {
+				t.Errorf("listed the remote state consumers of %s, which has global remote state", id)
+			}
+
+			fmt.Fprintf(w, `{"data": [%s]}`, consumers[r.PathValue("id")])
+		},
+	)
+
+	archives := map[string][]byte{
+		"cv-1": tarball(t, configs["cv-1"]...),
+		"cv-2": tarball(t, configs["cv-2"]...),
+		"cv-3": tarball(t, "main.tf", `data "tfe_outputs" "dns" { workspace = "dns" }`),
+	}
+	mux.HandleFunc(
+		"GET /api/v2/configuration-versions/{id}/download",
+		func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write(archives[r.PathValue("id")])
+		},
+	)
+
+	result := runCommand(t, client, "--org", "myorg")
+
+	test.Buffer(t, result.ErrBuf, "Warning: no configuration to scan for iam\n")
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		REMOTE STATE
+		  dns      ->  app  tfe_outputs
+		  network  ->  app  consumer
+		  network  ->  dns  tfe_outputs
+
+		UNREAD GLOBAL REMOTE STATE
+		  iam
+
+		READS WITHOUT ACCESS
+		  dns reads network
+	`))
+}
+
+func TestRemoteState_download_error(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces",
+		func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("include"); got != "current_configuration_version" {
+				t.Errorf("got include %q", got)
+			}
+
+			fmt.Fprint(w, `{
+				"data": [
+					`+workspace("ws-1", "network", false, "cv-1")+`,
+					`+workspace("ws-2", "dns", true, "cv-2")+`,
+					`+workspace("ws-3", "app", false, "cv-3")+`,
+					{"id": "ws-4", "type": "workspaces", "attributes": {"name": "iam", "global-remote-state": true}}
+				],
+				"included": [
+					{"id": "cv-1", "type": "configuration-versions", "attributes": {"status": "uploaded"}},
+					{"id": "cv-2", "type": "configuration-versions", "attributes": {"status": "uploaded"}},
+					{"id": "cv-3", "type": "configuration-versions", "attributes": {"status": "uploaded"}}
+				]
+			}`)
+		},
+	)
+
+	consumers := map[string]string{
+		"ws-1": `{"id": "ws-3", "type": "workspaces", "attributes": {"name": "app"}}`,
+	}
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{id}/relationships/remote-state-consumers",
+		func(w http.ResponseWriter, r *http.Request) {
+			if id := r.PathValue("id"); id == "ws-2" || id == "ws-4" {
+				t.Errorf("listed the remote state consumers of %s, which has global remote state", id)
+			}
+
+			fmt.Fprintf(w, `{"data": [%s]}`, consumers[r.PathValue("id")])
+		},
+	)
+
+	archives := map[string][]byte{
+		"cv-1": tarball(t, configs["cv-1"]...),
+		"cv-2": tarball(t, configs["cv-2"]...),
+	}
+	mux.HandleFunc(
+		"GET /api/v2/configuration-versions/{id}/download",
+		func(w http.ResponseWriter, r *http.Request) {
+			archive, ok := archives[r.PathValue("id")]
+			if !ok {
+				http.Error(w, "internal error", http.StatusInternalServerError)
+				return
+			}
+			_, _ = w.Write(archive)
+		},
+	)
+
+	result := runCommand(t, client, "--org", "myorg")
+
+	test.Buffer(t, result.ErrBuf, text.Heredoc(`
+		Warning: no configuration to scan for iam
+		error scanning workspace "app": failed to download configuration version cv-3: 500 Internal Server Error
+	`))
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		REMOTE STATE
+		  network  ->  app  consumer
+		  network  ->  dns  tfe_outputs
+
+		UNREAD GLOBAL REMOTE STATE
+		  dns  (unknown)
+		  iam  (unknown)
+
+		READS WITHOUT ACCESS
+		  dns reads network
+	`))
+}
+
+func TestRemoteState_parse_error(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces",
+		func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("include"); got != "current_configuration_version" {
+				t.Errorf("got include %q", got)
+			}
+
+			fmt.Fprint(w, `{
+				"data": [
+					`+workspace("ws-1", "network", false, "cv-1")+`,
+					`+workspace("ws-2", "dns", true, "cv-2")+`,
+					`+workspace("ws-3", "app", false, "cv-3")+`,
+					{"id": "ws-4", "type": "workspaces", "attributes": {"name": "iam", "global-remote-state": true}}
+				],
+				"included": [
+					{"id": "cv-1", "type": "configuration-versions", "attributes": {"status": "uploaded"}},
+					{"id": "cv-2", "type": "configuration-versions", "attributes": {"status": "uploaded"}},
+					{"id": "cv-3", "type": "configuration-versions", "attributes": {"status": "uploaded"}}
+				]
+			}`)
+		},
+	)
+
+	consumers := map[string]string{
+		"ws-1": `{"id": "ws-3", "type": "workspaces", "attributes": {"name": "app"}}`,
+	}
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{id}/relationships/remote-state-consumers",
+		func(w http.ResponseWriter, r *http.Request) {
+			if id := r.PathValue("id"); id == "ws-2" || id == "ws-4" {
+				t.Errorf("listed the remote state consumers of %s, which has global remote state", id)
+			}
+
+			fmt.Fprintf(w, `{"data": [%s]}`, consumers[r.PathValue("id")])
+		},
+	)
+
+	archives := map[string][]byte{
+		"cv-1": tarball(t, configs["cv-1"]...),
+		"cv-2": tarball(t, configs["cv-2"]...),
+		"cv-3": tarball(t,
+			"main.tf", `data "tfe_outputs" "dns" { workspace = "dns" }`,
+			"broken.tf", `data "tfe_outputs" "iam" {`,
+		),
+	}
+	mux.HandleFunc(
+		"GET /api/v2/configuration-versions/{id}/download",
+		func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write(archives[r.PathValue("id")])
+		},
+	)
+
+	result := runCommand(t, client, "--org", "myorg")
+
+	want := "Warning: failed to scan the configuration of app: failed to parse broken.tf: "
+	if !strings.HasPrefix(result.ErrBuf.String(), want) {
+		t.Errorf("got stderr %q, want a warning about broken.tf", result.ErrBuf.String())
+	}
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		REMOTE STATE
+		  dns      ->  app  tfe_outputs
+		  network  ->  app  consumer
+		  network  ->  dns  tfe_outputs
+
+		UNREAD GLOBAL REMOTE STATE
+		  iam  (unknown)
+
+		READS WITHOUT ACCESS
+		  dns reads network
+	`))
+}
+
+func TestRemoteState_json(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces",
+		func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("include"); got != "current_configuration_version" {
+				t.Errorf("got include %q", got)
+			}
+
+			fmt.Fprint(w, `{
+				"data": [
+					`+workspace("ws-1", "network", false, "cv-1")+`,
+					`+workspace("ws-2", "dns", true, "cv-2")+`,
+					`+workspace("ws-3", "app", false, "cv-3")+`,
+					{"id": "ws-4", "type": "workspaces", "attributes": {"name": "iam", "global-remote-state": true}}
+				],
+				"included": [
+					{"id": "cv-1", "type": "configuration-versions", "attributes": {"status": "uploaded"}},
+					{"id": "cv-2", "type": "configuration-versions", "attributes": {"status": "uploaded"}},
+					{"id": "cv-3", "type": "configuration-versions", "attributes": {"status": "uploaded"}}
+				]
+			}`)
+		},
+	)
+
+	consumers := map[string]string{
+		"ws-1": `{"id": "ws-3", "type": "workspaces", "attributes": {"name": "app"}}`,
+	}
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{id}/relationships/remote-state-consumers",
+		func(w http.ResponseWriter, r *http.Request) {
+			if id := r.PathValue("id"); id == "ws-2" || id == "ws-4" {
+				t.Errorf("listed the remote state consumers of %s, which has global remote state", id)
+			}
+
+			fmt.Fprintf(w, `{"data": [%s]}`, consumers[r.PathValue("id")])
+		},
+	)
+
+	archives := map[string][]byte{
+		"cv-1": tarball(t, configs["cv-1"]...),
+		"cv-2": tarball(t, configs["cv-2"]...),
+		"cv-3": tarball(t, configs["cv-3"]...),
+	}
+	mux.HandleFunc(
+		"GET /api/v2/configuration-versions/{id}/download",
+		func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write(archives[r.PathValue("id")])
+		},
+	)
+
+	result := runCommand(t, client, "--org", "myorg", "--format", "json")
+
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		{
+		  "nodes": [
+		    "app",
+		    "dns",
+		    "iam",
+		    "network"
+		  ],
+		  "edges": [
+		    {
+		      "from": "dns",
+		      "to": "app",
+		      "sources": [
+		        "tfe_outputs"
+		      ]
+		    },
+		    {
+		      "from": "network",
+		      "to": "app",
+		      "sources": [
+		        "consumer",
+		        "terraform_remote_state"
+		      ]
+		    },
+		    {
+		      "from": "network",
+		      "to": "dns",
+		      "sources": [
+		        "tfe_outputs"
+		      ]
+		    }
+		  ],
+		  "unread_global_remote_state": [],
+		  "unknown_global_remote_state": [
+		    "iam"
+		  ],
+		  "reads_without_access": [
+		    {
+		      "from": "network",
+		      "to": "dns"
+		    }
+		  ]
+		}
+	`))
+}
+
+// tarball returns a gzipped tarball of files given as pairs of names and
+// contents, like a configuration version.
+func tarball(t *testing.T, files ...string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for i := 0; i < len(files); i += 2 {
+		name, content := files[i], files[i+1]
+		if err := tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0o644,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+	}
+
+	cmd := remotestate.NewCmdRemoteState(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
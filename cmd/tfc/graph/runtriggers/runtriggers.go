@@ -0,0 +1,308 @@
+package runtriggers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/graph"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/parallel"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+const (
+	FormatDOT     string = "dot"
+	FormatMermaid string = "mermaid"
+)
+
+var Formats = []string{cmdutil.FormatTable, FormatDOT, FormatMermaid, cmdutil.FormatJSON}
+
+// Report is the analysis of a graph of workspaces.
+type Report struct {
+	Nodes  []string     `json:"nodes"`
+	Edges  []graph.Edge `json:"edges"`
+	Cycles [][]string   `json:"cycles"`
+
+	// Orphans are the workspaces without any edge.
+	Orphans []string `json:"orphans"`
+
+	// Order is a topological order of the workspaces, empty if the graph
+	// has cycles.
+	Order []string `json:"order"`
+}
+
+// NewReport analyzes a graph.
+func NewReport(g *graph.Graph) *Report {
+	r := &Report{
+		Nodes:   g.Nodes(),
+		Edges:   g.Edges(),
+		Cycles:  g.Cycles(),
+		Orphans: g.Isolated(),
+	}
+
+	// The order is only missing when there are cycles, which are reported.
+	r.Order, _ = g.TopologicalOrder()
+
+	if r.Edges == nil {
+		r.Edges = []graph.Edge{}
+	}
+	if r.Cycles == nil {
+		r.Cycles = [][]string{}
+	}
+	if r.Orphans == nil {
+		r.Orphans = []string{}
+	}
+	if r.Order == nil {
+		r.Order = []string{}
+	}
+
+	return r
+}
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	Org         string
+	Downstream  string // Workspace name
+	Concurrency int
+	Format      string
+}
+
+func NewCmdRunTriggers(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "run-triggers",
+		Aliases: []string{"run-trigger"},
+		Short:   "Graph the run triggers of an organization",
+		Long: text.Heredoc(`
+			Graph the run triggers between the workspaces of an organization.
+			An edge from a workspace to another means an apply in the first
+			queues a run in the second.
+
+			The report lists the run triggers, the cycles of run triggers,
+			the orphaned workspaces without any run trigger, and the order in
+			which the workspaces apply. The DOT and Mermaid graphs leave out
+			the orphaned workspaces.
+
+			With --downstream, the graph is limited to a workspace and the
+			workspaces its applies queue runs in, directly or through other
+			workspaces: the workspaces a change to the workspace affects.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Show the run triggers of an organization
+			$ tfc graph run-triggers --org myorg
+
+			# Render the run triggers with Graphviz
+			$ tfc graph run-triggers --org myorg --format dot | dot -Tsvg > run-triggers.svg
+
+			# Show the workspaces a change to network affects
+			$ tfc graph run-triggers --org myorg --downstream network
+		`),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+
+	_ = cmdutil.FlagStringEnum(cmd, &opts.Format, "format", cmdutil.FormatTable, "Output format", Formats)
+	cmd.Flags().StringVar(&opts.Downstream, "downstream", "",
+		"Only graph a workspace and the workspaces downstream of it",
+	)
+	cmd.Flags().IntVar(&opts.Concurrency, "concurrency", parallel.DefaultConcurrency,
+		"Number of workspaces to list the run triggers of concurrently.",
+	)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, _ []string) {
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if opts.Org == "" {
+		return cmdutil.ErrOrgRequired
+	}
+
+	if err := cmdutil.ValidateEnum("format", opts.Format, Formats); err != nil {
+		return err
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	g, err := BuildGraph(ctx, client, opts.Org, opts.Concurrency)
+	if g == nil {
+		return err
+	}
+
+	if opts.Downstream != "" {
+		if !g.HasNode(opts.Downstream) {
+			return fmt.Errorf("workspace %q not found in organization %q", opts.Downstream, opts.Org)
+		}
+		g = g.Subgraph(append([]string{opts.Downstream}, g.Descendants(opts.Downstream)...))
+	}
+
+	if werr := WriteGraph(opts.IO, g, NewReport(g), opts.Format, "run-triggers"); werr != nil {
+		return werr
+	}
+
+	return err
+}
+
+// BuildGraph builds the graph of the run triggers between the workspaces of
+// an organization. The graph has every workspace, even the ones without run
+// triggers. Errors listing the run triggers of some workspaces are returned
+// along with the graph of the others.
+func BuildGraph(ctx context.Context, client *tfc.Client, org string, concurrency int) (*graph.Graph, error) {
+	workspaces, _, err := client.Workspaces.List(ctx, org, &tfc.WorkspaceListOptions{
+		ListOptions: tfc.ListOptions{Limit: math.MaxInt},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workspaces for %s: %w", org, err)
+	}
+
+	// Inbound run triggers are enough, every run trigger is the inbound run
+	// trigger of a workspace.
+	triggers, fetchErrs := parallel.Map(ctx, workspaces, concurrency,
+		func(ctx context.Context, ws *tfc.Workspace) ([]*tfc.RunTrigger, error) {
+			return client.RunTriggers.ListInbound(ctx, ws.ID)
+		},
+	)
+
+	var errs []error
+
+	g := graph.New()
+	for i, ws := range workspaces {
+		g.AddNode(ws.Name)
+
+		if fetchErrs[i] != nil {
+			errs = append(errs, fmt.Errorf("error listing run triggers for workspace %q: %w", ws.Name, fetchErrs[i]))
+			continue
+		}
+
+		for _, rt := range triggers[i] {
+			g.AddEdge(rt.SourceableName, ws.Name)
+		}
+	}
+
+	return g, errors.Join(errs...)
+}
+
+// WriteGraph writes a graph and its report in a format. The report is
+// written for the table and JSON formats, and the cycles of the report are
+// warned about on the error output for the DOT and Mermaid formats.
+func WriteGraph(ios *iolib.IOStreams, g *graph.Graph, r *Report, format, name string) error {
+	switch format {
+	case cmdutil.FormatJSON:
+		return cmdutil.PrintJSON(ios, r)
+	case FormatDOT, FormatMermaid:
+		for _, c := range r.Cycles {
+			fmt.Fprintf(ios.ErrOut, "Warning: cycle between %s\n", formatCycle(c))
+		}
+
+		connected := g.Connected()
+		if format == FormatDOT {
+			return connected.WriteDOT(ios.Out, name)
+		}
+		return connected.WriteMermaid(ios.Out)
+	}
+
+	writeReport(ios.Out, r)
+	return nil
+}
+
+func writeReport(out io.Writer, r *Report) {
+	fmt.Fprintf(out, "%s\n", headerStyle.Render("RUN TRIGGERS"))
+	if len(r.Edges) == 0 {
+		fmt.Fprintf(out, "  %s\n", faintStyle.Render("(none)"))
+	}
+
+	width := 0
+	for _, e := range r.Edges {
+		width = max(width, len(e.From))
+	}
+	for _, e := range r.Edges {
+		fmt.Fprintf(out, "  %-*s  ->  %s\n", width, e.From, e.To)
+	}
+
+	fmt.Fprintf(out, "\n%s\n", headerStyle.Render("CYCLES"))
+	if len(r.Cycles) == 0 {
+		fmt.Fprintf(out, "  %s\n", faintStyle.Render("(none)"))
+	}
+	for _, c := range r.Cycles {
+		fmt.Fprintf(out, "  %s\n", formatCycle(c))
+	}
+
+	fmt.Fprintf(out, "\n%s\n", headerStyle.Render("ORPHANED WORKSPACES"))
+	if len(r.Orphans) == 0 {
+		fmt.Fprintf(out, "  %s\n", faintStyle.Render("(none)"))
+	}
+	for _, n := range r.Orphans {
+		fmt.Fprintf(out, "  %s\n", n)
+	}
+
+	fmt.Fprintf(out, "\n%s\n", headerStyle.Render("ORDER"))
+	if len(r.Cycles) > 0 {
+		fmt.Fprintf(out, "  %s\n", faintStyle.Render("(no order, the graph has cycles)"))
+		return
+	}
+
+	// Orphans can go anywhere, they're only listed once above.
+	i := 0
+	orphans := map[string]bool{}
+	for _, n := range r.Orphans {
+		orphans[n] = true
+	}
+	for _, n := range r.Order {
+		if orphans[n] {
+			continue
+		}
+		i++
+		fmt.Fprintf(out, "  %d. %s\n", i, n)
+	}
+	if i == 0 {
+		fmt.Fprintf(out, "  %s\n", faintStyle.Render("(none)"))
+	}
+}
+
+// formatCycle formats the workspaces of a cycle, e.g. "app, dns" or
+// "app -> app" for a workspace triggering itself.
+func formatCycle(c []string) string {
+	if len(c) == 1 {
+		return c[0] + " -> " + c[0]
+	}
+	return strings.Join(c, ", ")
+}
+
+var (
+	faintStyle  = lipgloss.NewStyle().Faint(true)
+	headerStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("6"))
+)
@@ -0,0 +1,238 @@
+package runtriggers_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/zkhvan/tfc/cmd/tfc/graph/runtriggers"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+func trigger(id, source string) string {
+	return fmt.Sprintf(`{"id": %q, "type": "run-triggers", "attributes": {"sourceable-name": %q}}`, id, source)
+}
+
+var acyclic = map[string]string{
+	"ws-2": trigger("rt-1", "network"),
+	"ws-3": trigger("rt-2", "network") + "," + trigger("rt-3", "dns"),
+}
+
+func TestRunTriggers(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "ws-1", "type": "workspaces", "attributes": {"name": "network"}},
+				{"id": "ws-2", "type": "workspaces", "attributes": {"name": "dns"}},
+				{"id": "ws-3", "type": "workspaces", "attributes": {"name": "app"}},
+				{"id": "ws-4", "type": "workspaces", "attributes": {"name": "iam"}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{id}/run-triggers",
+		func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("filter[run-trigger][type]"); got != "inbound" {
+				t.Errorf("got run trigger type %q", got)
+			}
+
+			fmt.Fprintf(w, `{"data": [%s]}`, acyclic[r.PathValue("id")])
+		},
+	)
+
+	result := runCommand(t, client, "--org", "myorg")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		RUN TRIGGERS
+		  dns      ->  app
+		  network  ->  app
+		  network  ->  dns
+
+		CYCLES
+		  (none)
+
+		ORPHANED WORKSPACES
+		  iam
+
+		ORDER
+		  1. network
+		  2. dns
+		  3. app
+	`))
+}
+
+func TestRunTriggers_cycle(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	inbound := map[string]string{
+		"ws-1": trigger("rt-4", "app"),
+		"ws-2": trigger("rt-1", "network"),
+		"ws-3": trigger("rt-3", "dns"),
+	}
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "ws-1", "type": "workspaces", "attributes": {"name": "network"}},
+				{"id": "ws-2", "type": "workspaces", "attributes": {"name": "dns"}},
+				{"id": "ws-3", "type": "workspaces", "attributes": {"name": "app"}},
+				{"id": "ws-4", "type": "workspaces", "attributes": {"name": "iam"}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{id}/run-triggers",
+		func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("filter[run-trigger][type]"); got != "inbound" {
+				t.Errorf("got run trigger type %q", got)
+			}
+
+			fmt.Fprintf(w, `{"data": [%s]}`, inbound[r.PathValue("id")])
+		},
+	)
+
+	result := runCommand(t, client, "--org", "myorg", "--format", "mermaid")
+
+	test.Buffer(t, result.ErrBuf, "Warning: cycle between app, dns, network\n")
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		flowchart LR
+		  n0["app"]
+		  n1["dns"]
+		  n2["network"]
+		  n0 --> n2
+		  n1 --> n0
+		  n2 --> n1
+	`))
+}
+
+func TestRunTriggers_downstream(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "ws-1", "type": "workspaces", "attributes": {"name": "network"}},
+				{"id": "ws-2", "type": "workspaces", "attributes": {"name": "dns"}},
+				{"id": "ws-3", "type": "workspaces", "attributes": {"name": "app"}},
+				{"id": "ws-4", "type": "workspaces", "attributes": {"name": "iam"}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{id}/run-triggers",
+		func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("filter[run-trigger][type]"); got != "inbound" {
+				t.Errorf("got run trigger type %q", got)
+			}
+
+			fmt.Fprintf(w, `{"data": [%s]}`, acyclic[r.PathValue("id")])
+		},
+	)
+
+	result := runCommand(t, client, "--org", "myorg", "--downstream", "dns", "--format", "json")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		{
+		  "nodes": [
+		    "app",
+		    "dns"
+		  ],
+		  "edges": [
+		    {
+		      "from": "dns",
+		      "to": "app"
+		    }
+		  ],
+		  "cycles": [],
+		  "orphans": [],
+		  "order": [
+		    "dns",
+		    "app"
+		  ]
+		}
+	`))
+}
+
+func TestRunTriggers_downstream_not_found(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "ws-1", "type": "workspaces", "attributes": {"name": "network"}},
+				{"id": "ws-2", "type": "workspaces", "attributes": {"name": "dns"}},
+				{"id": "ws-3", "type": "workspaces", "attributes": {"name": "app"}},
+				{"id": "ws-4", "type": "workspaces", "attributes": {"name": "iam"}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{id}/run-triggers",
+		func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("filter[run-trigger][type]"); got != "inbound" {
+				t.Errorf("got run trigger type %q", got)
+			}
+
+			fmt.Fprintf(w, `{"data": [%s]}`, acyclic[r.PathValue("id")])
+		},
+	)
+
+	result := runCommand(t, client, "--org", "myorg", "--downstream", "vpc")
+
+	test.Buffer(t, result.ErrBuf, "workspace \"vpc\" not found in organization \"myorg\"\n")
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+	}
+
+	cmd := runtriggers.NewCmdRunTriggers(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
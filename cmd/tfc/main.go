@@ -1,10 +1,12 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"os"
 
 	"github.com/zkhvan/tfc/internal/build"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
 	"github.com/zkhvan/tfc/pkg/factory"
 	"github.com/zkhvan/tfc/pkg/signal"
 )
@@ -20,6 +22,11 @@ func main() {
 	}
 
 	if _, err := NewCmdRoot(f, buildVersion, buildDate).ExecuteContextC(signal.Notify()); err != nil {
+		var exitErr *cmdutil.ExitError
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.Code)
+		}
+
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
@@ -0,0 +1,204 @@
+package listen
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/notification"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+// maxPayloadSize is the maximum size of a notification payload.
+const maxPayloadSize = 1 << 20
+
+type Options struct {
+	IO *iolib.IOStreams
+
+	Address string
+	Port    int
+	Token   string
+}
+
+func NewCmdListen(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO: f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "listen",
+		Short: "Print the notification payloads sent to a local server",
+		Long: text.Heredoc(`
+			Start a local HTTP server printing the notification payloads it
+			receives, to develop notification consumers against real
+			payloads.
+
+			Point a generic notification configuration at the server, through
+			a tunnel when the server isn't reachable from Terraform. With
+			--token, the signature of each payload is verified with the token
+			of the notification configuration and payloads with an invalid
+			signature are rejected.
+
+			The server runs until interrupted.
+		`),
+		Example: text.Heredoc(`
+			# Print the payloads sent to port 8080
+			$ tfc notifications listen --port 8080
+
+			# Verify the signature of the payloads
+			$ tfc notifications listen --port 8080 --token s3cr3t
+		`),
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Address, "address", "localhost", "Address to listen on")
+	cmd.Flags().IntVarP(&opts.Port, "port", "p", 8080, "Port to listen on")
+	cmd.Flags().StringVar(&opts.Token, "token", "", "Token of the notification configuration to verify signatures with")
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	addr := net.JoinHostPort(opts.Address, strconv.Itoa(opts.Port))
+
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+
+	srv := &http.Server{
+		Handler:           NewHandler(opts.IO.Out, opts.IO.ErrOut, opts.Token),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "Listening for notifications on http://%s\n", ln.Addr())
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.Serve(ln)
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+	if err := <-errCh; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+// handler prints the notification payloads it receives.
+type handler struct {
+	out    io.Writer
+	errOut io.Writer
+	token  string
+
+	mu sync.Mutex
+}
+
+// NewHandler returns an HTTP handler printing the notification payloads it
+// receives to out, and the rejected payloads to errOut. When token is set,
+// payloads without a valid signature for the token are rejected.
+func NewHandler(out, errOut io.Writer, token string) http.Handler {
+	return &handler{out: out, errOut: errOut, token: token}
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxPayloadSize))
+	if err != nil {
+		http.Error(w, "failed to read the payload", http.StatusBadRequest)
+		return
+	}
+
+	signature := r.Header.Get(notification.SignatureHeader)
+
+	status := "not signed"
+	switch {
+	case h.token != "" && !notification.Verify(h.token, body, signature):
+		fmt.Fprintf(h.errOut, "Rejected a notification from %s: invalid signature\n", r.RemoteAddr)
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	case h.token != "":
+		status = "signature verified"
+	case signature != "":
+		status = "signature not verified, use --token to verify it"
+	}
+
+	payload, err := notification.Parse(body)
+	if err != nil {
+		fmt.Fprintf(h.errOut, "Rejected a notification from %s: invalid payload: %v\n", r.RemoteAddr, err)
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	h.print(payload, body, status)
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// print prints a summary of a payload followed by the payload itself.
+func (h *handler) print(payload *notification.Payload, body []byte, status string) {
+	source := payload.WorkspaceName
+	if payload.OrganizationName != "" {
+		source = payload.OrganizationName + "/" + source
+	}
+
+	fmt.Fprintf(h.out, "Notification %s for %s (%s)\n", payload.NotificationConfigurationID, source, status)
+
+	for _, n := range payload.Notifications {
+		fmt.Fprintf(h.out, "  %s", n.Trigger)
+		if n.RunStatus != "" {
+			fmt.Fprintf(h.out, "  %s", n.RunStatus)
+		}
+		fmt.Fprintf(h.out, "  %s\n", n.Message)
+	}
+
+	if payload.RunURL != "" {
+		fmt.Fprintf(h.out, "  %s\n", payload.RunURL)
+	}
+
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, body, "", "  "); err != nil {
+		indented.Reset()
+		indented.Write(body)
+	}
+
+	fmt.Fprintf(h.out, "%s\n\n", bytes.TrimSpace(indented.Bytes()))
+}
@@ -0,0 +1,132 @@
+package listen_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/zkhvan/tfc/cmd/tfc/notifications/listen"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/pkg/notification"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+const payload = `{"payload_version":1,"notification_configuration_id":"nc-1",` +
+	`"run_url":"https://app.terraform.io/app/myorg/network/runs/run-1","run_id":"run-1",` +
+	`"workspace_name":"network","organization_name":"myorg",` +
+	`"notifications":[{"message":"Applied","trigger":"run:completed","run_status":"applied"}]}`
+
+func post(t *testing.T, srv *httptest.Server, body, signature string) int {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodPost, srv.URL, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signature != "" {
+		req.Header.Set(notification.SignatureHeader, signature)
+	}
+
+	resp, err := srv.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	return resp.StatusCode
+}
+
+func TestListen(t *testing.T) {
+	var out, errOut bytes.Buffer
+	srv := httptest.NewServer(listen.NewHandler(&out, &errOut, ""))
+	defer srv.Close()
+
+	if code := post(t, srv, payload, ""); code != http.StatusOK {
+		t.Errorf("got status %d, want %d", code, http.StatusOK)
+	}
+
+	test.BufferEmpty(t, &errOut)
+	test.Buffer(t, &out, text.Heredoc(`
+		Notification nc-1 for myorg/network (not signed)
+		  run:completed  applied  Applied
+		  https://app.terraform.io/app/myorg/network/runs/run-1
+		{
+		  "payload_version": 1,
+		  "notification_configuration_id": "nc-1",
+		  "run_url": "https://app.terraform.io/app/myorg/network/runs/run-1",
+		  "run_id": "run-1",
+		  "workspace_name": "network",
+		  "organization_name": "myorg",
+		  "notifications": [
+		    {
+		      "message": "Applied",
+		      "trigger": "run:completed",
+		      "run_status": "applied"
+		    }
+		  ]
+		}
+
+	`))
+}
+
+func TestListen_token(t *testing.T) {
+	var out, errOut bytes.Buffer
+	srv := httptest.NewServer(listen.NewHandler(&out, &errOut, "s3cr3t"))
+	defer srv.Close()
+
+	if code := post(t, srv, payload, notification.Sign("s3cr3t", []byte(payload))); code != http.StatusOK {
+		t.Errorf("got status %d, want %d", code, http.StatusOK)
+	}
+
+	test.BufferEmpty(t, &errOut)
+	if got := out.String(); !strings.HasPrefix(got, "Notification nc-1 for myorg/network (signature verified)\n") {
+		t.Errorf("got output %q", got)
+	}
+}
+
+func TestListen_invalid_signature(t *testing.T) {
+	var out, errOut bytes.Buffer
+	srv := httptest.NewServer(listen.NewHandler(&out, &errOut, "s3cr3t"))
+	defer srv.Close()
+
+	for _, signature := range []string{"", notification.Sign("other", []byte(payload))} {
+		if code := post(t, srv, payload, signature); code != http.StatusUnauthorized {
+			t.Errorf("got status %d, want %d", code, http.StatusUnauthorized)
+		}
+	}
+
+	test.BufferEmpty(t, &out)
+	if got := strings.Count(errOut.String(), ": invalid signature\n"); got != 2 {
+		t.Errorf("got %d rejections, want 2: %q", got, errOut.String())
+	}
+}
+
+func TestListen_invalid_payload(t *testing.T) {
+	var out, errOut bytes.Buffer
+	srv := httptest.NewServer(listen.NewHandler(&out, &errOut, ""))
+	defer srv.Close()
+
+	if code := post(t, srv, "not json", ""); code != http.StatusBadRequest {
+		t.Errorf("got status %d, want %d", code, http.StatusBadRequest)
+	}
+
+	test.BufferEmpty(t, &out)
+}
+
+func TestListen_method_not_allowed(t *testing.T) {
+	var out, errOut bytes.Buffer
+	srv := httptest.NewServer(listen.NewHandler(&out, &errOut, ""))
+	defer srv.Close()
+
+	resp, err := srv.Client().Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("got status %d, want %d", resp.StatusCode, http.StatusMethodNotAllowed)
+	}
+}
@@ -0,0 +1,27 @@
+package notifications
+
+import (
+	"github.com/spf13/cobra"
+
+	listenCmd "github.com/zkhvan/tfc/cmd/tfc/notifications/listen"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+func NewCmdNotifications(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "notifications",
+		Aliases: []string{"notification"},
+		Short:   "Work with notification payloads",
+		Long: text.Heredoc(`
+			Work with the payloads sent by notification configurations.
+
+			Manage the notification configurations of a workspace with
+			"tfc workspaces notifications".
+		`),
+	}
+
+	cmd.AddCommand(listenCmd.NewCmdListen(f))
+
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/spf13/cobra"
 
 	listCmd "github.com/zkhvan/tfc/cmd/tfc/organization/list"
+	viewCmd "github.com/zkhvan/tfc/cmd/tfc/organization/view"
 	"github.com/zkhvan/tfc/pkg/cmdutil"
 	"github.com/zkhvan/tfc/pkg/text"
 )
@@ -22,6 +23,7 @@ func NewCmdOrganization(f *cmdutil.Factory) *cobra.Command {
 	}
 
 	cmd.AddCommand(listCmd.NewCmdList(f))
+	cmd.AddCommand(viewCmd.NewCmdView(f))
 
 	return cmd
 }
@@ -0,0 +1,326 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/hashicorp/go-tfe"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/ptr"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+// ownersTeam is the name of the team every organization has, whose members
+// manage the organization.
+const ownersTeam = "owners"
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+	Clock           *cmdutil.Clock
+
+	Org string
+}
+
+func NewCmdView(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+		Clock:           f.Clock,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view [<ORGANIZATION>]",
+		Short: "View organization details",
+		Long: text.Heredoc(`
+			View detailed information about an organization.
+
+			Displays the settings and owners of the organization, the features
+			it's entitled to, and a summary of its workspaces, projects, runs
+			that are queued or running and the resources managed across all
+			of its workspaces. Sections that can't be read, such as the
+			owners without access to the owners team, are shown as not
+			visible with a warning.
+
+			If the organization is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# View an organization
+			$ tfc organizations view myorg
+
+			# View the organization of the current directory
+			$ tfc organizations view
+		`),
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, args []string) {
+	if len(args) > 0 {
+		opts.Org = args[0]
+	}
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if opts.Org == "" {
+		return fmt.Errorf("organization required: pass the organization name or ensure state.tf exists")
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	org, err := client.Organizations.Read(ctx, opts.Org)
+	if err != nil {
+		return fmt.Errorf("failed to read organization %s: %w", opts.Org, err)
+	}
+
+	entitlements, err := client.Organizations.ReadEntitlements(ctx, org.Name)
+	if err != nil {
+		return fmt.Errorf("failed to read entitlements for %s: %w", org.Name, err)
+	}
+
+	// The sections below need more permissions than reading the
+	// organization, so they're shown as not visible when they can't be read.
+	agentPool := faintStyle.Render("(none)")
+	if org.DefaultAgentPool != nil {
+		pool, err := client.AgentPools.Read(ctx, org.DefaultAgentPool.ID)
+		if err != nil {
+			opts.warn(fmt.Errorf("failed to read agent pool %s: %w", org.DefaultAgentPool.ID, err))
+			agentPool = notVisible
+		} else {
+			agentPool = pool.Name
+		}
+	}
+
+	owners, err := readOwners(ctx, client, org.Name)
+	if err != nil {
+		opts.warn(err)
+	}
+
+	summary := opts.readSummary(ctx, client, org.Name)
+
+	opts.displayOrganization(org, agentPool, owners)
+	opts.displayEntitlements(entitlements)
+	opts.displaySummary(summary)
+
+	return nil
+}
+
+// warn writes a warning about a section that couldn't be read.
+func (opts *Options) warn(err error) {
+	fmt.Fprintf(opts.IO.ErrOut, "Warning: %v\n", err)
+}
+
+// readOwners returns the usernames of the members of the owners team, or nil
+// if the team isn't visible to the user.
+func readOwners(ctx context.Context, client *tfc.Client, org string) ([]string, error) {
+	teams, _, err := client.Teams.List(ctx, org, &tfc.TeamListOptions{
+		Query:   ownersTeam,
+		Include: []tfe.TeamIncludeOpt{tfe.TeamUsers},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list teams for %s: %w", org, err)
+	}
+
+	for _, t := range teams {
+		if t.Name != ownersTeam {
+			continue
+		}
+
+		owners := make([]string, 0, len(t.Users))
+		for _, u := range t.Users {
+			owners = append(owners, u.Username)
+		}
+		sort.Strings(owners)
+
+		return owners, nil
+	}
+
+	return nil, nil
+}
+
+// summary holds the counts of an organization, nil when they aren't
+// visible to the user.
+type summary struct {
+	Workspaces *int
+	Projects   *int
+	Queued     *int
+	Running    *int
+	Resources  *int
+}
+
+func (opts *Options) readSummary(ctx context.Context, client *tfc.Client, org string) summary {
+	var s summary
+
+	// Every workspace is read to add up their resources.
+	workspaces, _, err := client.Workspaces.List(ctx, org, &tfc.WorkspaceListOptions{
+		ListOptions: tfc.ListOptions{Limit: math.MaxInt},
+	})
+	if err != nil {
+		opts.warn(fmt.Errorf("failed to list workspaces for %s: %w", org, err))
+	} else {
+		resources := 0
+		for _, ws := range workspaces {
+			resources += ws.ResourceCount
+		}
+		s.Workspaces = ptr.Int(len(workspaces))
+		s.Resources = ptr.Int(resources)
+	}
+
+	projects, pagination, err := client.Projects.List(ctx, org, &tfc.ProjectListOptions{
+		ListOptions: tfc.ListOptions{Limit: 1},
+	})
+	if err != nil {
+		opts.warn(fmt.Errorf("failed to list projects for %s: %w", org, err))
+	} else {
+		s.Projects = ptr.Int(count(len(projects), pagination))
+	}
+
+	s.Queued, err = countRuns(ctx, client, org, tfc.RunStatusGroupHolding)
+	if err != nil {
+		opts.warn(err)
+	}
+
+	s.Running, err = countRuns(ctx, client, org, tfc.RunStatusGroupRunning)
+	if err != nil {
+		opts.warn(err)
+	}
+
+	return s
+}
+
+// countRuns returns the number of runs of an organization in a run status
+// group.
+func countRuns(ctx context.Context, client *tfc.Client, org string, group tfc.RunStatusGroup) (*int, error) {
+	var statuses []string
+	for _, s := range tfc.RunStatusesInGroup(group) {
+		statuses = append(statuses, string(s))
+	}
+	sort.Strings(statuses)
+
+	runs, pagination, err := client.Organizations.ListRuns(ctx, org, &tfc.OrganizationRunListOptions{
+		ListOptions: tfc.ListOptions{Limit: 1},
+		Status:      strings.Join(statuses, ","),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s runs for %s: %w", group, org, err)
+	}
+
+	return ptr.Int(count(len(runs), pagination)), nil
+}
+
+// count returns the total number of results of a list that may have been
+// cut short by its limit.
+func count(n int, pagination *tfc.Pagination) int {
+	if pagination.ReachedLimit {
+		return pagination.TotalCount
+	}
+	return n
+}
+
+func (opts *Options) displayOrganization(org *tfc.Organization, agentPool string, owners []string) {
+	out := opts.IO.Out
+
+	fmt.Fprintf(out, "%s\n", headerStyle.Render("IDENTITY"))
+	fmt.Fprintf(out, "  Name:                 %s\n", org.Name)
+	fmt.Fprintf(out, "  Email:                %s\n", org.Email)
+	fmt.Fprintf(out, "  Created:              %s\n", text.RelativeTimeAgo(opts.Clock.Now(), org.CreatedAt))
+
+	fmt.Fprintf(out, "\n%s\n", headerStyle.Render("SETTINGS"))
+	fmt.Fprintf(out, "  Execution Mode:       %s\n", org.DefaultExecutionMode)
+	fmt.Fprintf(out, "  Default Agent Pool:   %s\n", agentPool)
+	fmt.Fprintf(out, "  Cost Estimation:      %s\n", cmdutil.FormatBool(org.CostEstimationEnabled))
+	fmt.Fprintf(out, "  Assessments Enforced: %s\n", cmdutil.FormatBool(org.AssessmentsEnforced))
+	fmt.Fprintf(out, "  Auth Policy:          %s\n", org.CollaboratorAuthPolicy)
+	fmt.Fprintf(out, "  Session Timeout:      %s\n", formatMinutes(org.SessionTimeout))
+	fmt.Fprintf(out, "  Session Expiration:   %s\n", formatMinutes(org.SessionRemember))
+
+	fmt.Fprintf(out, "\n%s\n", headerStyle.Render("OWNERS"))
+	if owners == nil {
+		fmt.Fprintf(out, "  %s\n", notVisible)
+	}
+	for _, o := range owners {
+		fmt.Fprintf(out, "  %s\n", o)
+	}
+}
+
+func (opts *Options) displayEntitlements(e *tfe.Entitlements) {
+	out := opts.IO.Out
+
+	fmt.Fprintf(out, "\n%s\n", headerStyle.Render("ENTITLEMENTS"))
+	fmt.Fprintf(out, "  Agents:               %s\n", cmdutil.FormatBool(e.Agents))
+	fmt.Fprintf(out, "  Audit Logging:        %s\n", cmdutil.FormatBool(e.AuditLogging))
+	fmt.Fprintf(out, "  Cost Estimation:      %s\n", cmdutil.FormatBool(e.CostEstimation))
+	fmt.Fprintf(out, "  Module Registry:      %s\n", cmdutil.FormatBool(e.PrivateModuleRegistry))
+	fmt.Fprintf(out, "  Operations:           %s\n", cmdutil.FormatBool(e.Operations))
+	fmt.Fprintf(out, "  Run Tasks:            %s\n", cmdutil.FormatBool(e.RunTasks))
+	fmt.Fprintf(out, "  Sentinel:             %s\n", cmdutil.FormatBool(e.Sentinel))
+	fmt.Fprintf(out, "  SSO:                  %s\n", cmdutil.FormatBool(e.SSO))
+	fmt.Fprintf(out, "  State Storage:        %s\n", cmdutil.FormatBool(e.StateStorage))
+	fmt.Fprintf(out, "  Teams:                %s\n", cmdutil.FormatBool(e.Teams))
+	fmt.Fprintf(out, "  VCS Integrations:     %s\n", cmdutil.FormatBool(e.VCSIntegrations))
+}
+
+func (opts *Options) displaySummary(s summary) {
+	out := opts.IO.Out
+
+	fmt.Fprintf(out, "\n%s\n", headerStyle.Render("SUMMARY"))
+	fmt.Fprintf(out, "  Workspaces:           %s\n", formatCount(s.Workspaces))
+	fmt.Fprintf(out, "  Projects:             %s\n", formatCount(s.Projects))
+	fmt.Fprintf(out, "  Runs Queued:          %s\n", formatCount(s.Queued))
+	fmt.Fprintf(out, "  Runs Running:         %s\n", formatCount(s.Running))
+	fmt.Fprintf(out, "  Managed Resources:    %s\n", formatCount(s.Resources))
+}
+
+var (
+	faintStyle  = lipgloss.NewStyle().Faint(true)
+	headerStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("6"))
+
+	notVisible = faintStyle.Render("(not visible)")
+)
+
+// formatCount formats a count, which is nil when it isn't visible.
+func formatCount(n *int) string {
+	if n == nil {
+		return notVisible
+	}
+	return strconv.Itoa(*n)
+}
+
+// formatMinutes formats a number of minutes in the largest whole unit, where
+// zero means the default of the platform.
+func formatMinutes(minutes int) string {
+	switch {
+	case minutes == 0:
+		return faintStyle.Render("(default)")
+	case minutes%(24*60) == 0:
+		return text.Pluralize(minutes/(24*60), "day")
+	case minutes%60 == 0:
+		return text.Pluralize(minutes/60, "hour")
+	default:
+		return text.Pluralize(minutes, "minute")
+	}
+}
@@ -0,0 +1,349 @@
+package view_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/zkhvan/tfc/cmd/tfc/organization/view"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/clock"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+var referenceTime = time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+func TestView(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": {
+						"id": "myorg",
+						"type": "organizations",
+						"attributes": {
+							"name": "myorg",
+							"email": "admin@example.com",
+							"created-at": "1999-12-01T12:00:00Z",
+							"default-execution-mode": "agent",
+							"cost-estimation-enabled": true,
+							"assessments-enforced": false,
+							"collaborator-auth-policy": "two_factor_mandatory",
+							"session-timeout": 20160,
+							"session-remember": 0
+						},
+						"relationships": {
+							"default-agent-pool": {"data": {"id": "apool-1", "type": "agent-pools"}}
+						}
+					}
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/entitlement-set",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "org-1", "type": "entitlement-sets", "attributes": {
+				"agents": true, "operations": true, "state-storage": true, "teams": true, "vcs-integrations": true
+			}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/agent-pools/apool-1",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "apool-1", "type": "agent-pools", "attributes": {"name": "datacenter"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/teams",
+		func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("include"); got != "users" {
+				t.Errorf("got include %q", got)
+			}
+
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "team-1",
+							"type": "teams",
+							"attributes": {"name": "owners"},
+							"relationships": {
+								"users": {"data": [{"id": "user-2", "type": "users"}, {"id": "user-1", "type": "users"}]}
+							}
+						}
+					],
+					"included": [
+						{"id": "user-1", "type": "users", "attributes": {"username": "alice"}},
+						{"id": "user-2", "type": "users", "attributes": {"username": "bob"}}
+					]
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "ws-1", "type": "workspaces", "attributes": {"name": "network", "resource-count": 40}},
+				{"id": "ws-2", "type": "workspaces", "attributes": {"name": "dns", "resource-count": 2}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/projects",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{
+				"data": [
+					{"id": "prj-1", "type": "projects", "attributes": {"name": "platform"}},
+					{"id": "prj-2", "type": "projects", "attributes": {"name": "apps"}}
+				],
+				"meta": {"pagination": {"current-page": 1, "total-pages": 1, "total-count": 2}}
+			}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/runs",
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Query().Get("filter[status]") {
+			case "apply_queued,pending,plan_queued,queuing":
+				fmt.Fprint(w, `{
+					"data": [{"id": "run-1", "type": "runs"}, {"id": "run-2", "type": "runs"}],
+					"meta": {"pagination": {"current-page": 1, "total-pages": 1, "total-count": 3}}
+				}`)
+			default:
+				fmt.Fprint(w, `{
+					"data": [{"id": "run-3", "type": "runs"}],
+					"meta": {"pagination": {"current-page": 1, "total-pages": 1, "total-count": 1}}
+				}`)
+			}
+		},
+	)
+
+	result := runCommand(t, client, "myorg")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		IDENTITY
+		  Name:                 myorg
+		  Email:                admin@example.com
+		  Created:              about 1 month ago
+
+		SETTINGS
+		  Execution Mode:       agent
+		  Default Agent Pool:   datacenter
+		  Cost Estimation:      Yes
+		  Assessments Enforced: No
+		  Auth Policy:          two_factor_mandatory
+		  Session Timeout:      14 days
+		  Session Expiration:   (default)
+
+		OWNERS
+		  alice
+		  bob
+
+		ENTITLEMENTS
+		  Agents:               Yes
+		  Audit Logging:        No
+		  Cost Estimation:      No
+		  Module Registry:      No
+		  Operations:           Yes
+		  Run Tasks:            No
+		  Sentinel:             No
+		  SSO:                  No
+		  State Storage:        Yes
+		  Teams:                Yes
+		  VCS Integrations:     Yes
+
+		SUMMARY
+		  Workspaces:           2
+		  Projects:             2
+		  Runs Queued:          3
+		  Runs Running:         1
+		  Managed Resources:    42
+	`))
+}
+
+func TestView_not_visible(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": {
+						"id": "myorg",
+						"type": "organizations",
+						"attributes": {
+							"name": "myorg",
+							"email": "admin@example.com",
+							"created-at": "1999-12-01T12:00:00Z",
+							"default-execution-mode": "agent",
+							"collaborator-auth-policy": "password"
+						},
+						"relationships": {
+							"default-agent-pool": {"data": {"id": "apool-1", "type": "agent-pools"}}
+						}
+					}
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/entitlement-set",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "org-1", "type": "entitlement-sets", "attributes": {}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/agent-pools/apool-1",
+		func(w http.ResponseWriter, _ *http.Request) {
+			http.Error(w, "not found", http.StatusNotFound)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/teams",
+		func(w http.ResponseWriter, _ *http.Request) {
+			http.Error(w, "forbidden", http.StatusForbidden)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "ws-1", "type": "workspaces", "attributes": {"name": "network", "resource-count": 40}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/projects",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{
+				"data": [{"id": "prj-1", "type": "projects", "attributes": {"name": "platform"}}],
+				"meta": {"pagination": {"current-page": 1, "total-pages": 1, "total-count": 1}}
+			}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/runs",
+		func(w http.ResponseWriter, _ *http.Request) {
+			http.Error(w, "internal error", http.StatusInternalServerError)
+		},
+	)
+
+	result := runCommand(t, client, "myorg")
+
+	test.Buffer(t, result.ErrBuf, text.Heredoc(`
+		Warning: failed to read agent pool apool-1: resource not found
+		Warning: failed to list teams for myorg: 403 Forbidden
+		Warning: failed to list holding runs for myorg: 500 Internal Server Error
+		Warning: failed to list running runs for myorg: 500 Internal Server Error
+	`))
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		IDENTITY
+		  Name:                 myorg
+		  Email:                admin@example.com
+		  Created:              about 1 month ago
+
+		SETTINGS
+		  Execution Mode:       agent
+		  Default Agent Pool:   (not visible)
+		  Cost Estimation:      No
+		  Assessments Enforced: No
+		  Auth Policy:          password
+		  Session Timeout:      (default)
+		  Session Expiration:   (default)
+
+		OWNERS
+		  (not visible)
+
+		ENTITLEMENTS
+		  Agents:               No
+		  Audit Logging:        No
+		  Cost Estimation:      No
+		  Module Registry:      No
+		  Operations:           No
+		  Run Tasks:            No
+		  Sentinel:             No
+		  SSO:                  No
+		  State Storage:        No
+		  Teams:                No
+		  VCS Integrations:     No
+
+		SUMMARY
+		  Workspaces:           1
+		  Projects:             1
+		  Runs Queued:          (not visible)
+		  Runs Running:         (not visible)
+		  Managed Resources:    40
+	`))
+}
+
+func TestView_org_required(t *testing.T) {
+	client, _, teardown := tfetest.Setup()
+	defer teardown()
+
+	result := runCommand(t, client)
+
+	test.Buffer(t, result.ErrBuf,
+		"organization required: pass the organization name or ensure state.tf exists\n",
+	)
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+		Clock:           cmdutil.NewClock(clock.FrozenClock(referenceTime)),
+	}
+
+	cmd := view.NewCmdView(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
@@ -0,0 +1,156 @@
+package create
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/ptr"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+// Kinds are the kinds of policy sets.
+var Kinds = []string{string(tfe.Sentinel), string(tfe.OPA)}
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	Org          string
+	Name         string
+	Description  string
+	Kind         string
+	Global       bool
+	Overridable  bool
+	VCSRepo      string
+	VCSBranch    string
+	OAuthTokenID string
+	PoliciesPath string
+}
+
+func NewCmdCreate(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create <NAME>",
+		Short: "Create a policy set",
+		Long: text.Heredoc(`
+			Create a policy set.
+
+			The policies of the set are read from a VCS repository with
+			--vcs-repo, or managed individually otherwise. A global policy
+			set is enforced on every workspace of the organization, other
+			policy sets are attached to workspaces and projects with
+			"tfc policy-sets attach".
+
+			OPA policy sets can be made overridable, Sentinel policies are
+			overridden according to their enforcement level.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Create a Sentinel policy set from a repository
+			$ tfc policy-sets create security --org myorg \
+			    --vcs-repo myorg/policies --oauth-token-id ot-abc123 --policies-path security
+
+			# Create a global, overridable OPA policy set
+			$ tfc policy-sets create tagging --org myorg --kind opa --global --overridable
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+
+	cmd.Flags().StringVarP(&opts.Description, "description", "d", "", "Description of the policy set")
+	_ = cmdutil.FlagStringEnum(cmd, &opts.Kind, "kind", string(tfe.Sentinel), "Policy framework", Kinds)
+	cmd.Flags().BoolVar(&opts.Global, "global", false, "Enforce the policy set on every workspace")
+	cmd.Flags().BoolVar(&opts.Overridable, "overridable", false, "Allow overriding the failures of an OPA policy set")
+	cmd.Flags().StringVar(&opts.VCSRepo, "vcs-repo", "", "Repository of the policies, such as org/repo")
+	cmd.Flags().StringVar(&opts.VCSBranch, "vcs-branch", "", "Branch of the repository, the default branch if empty")
+	cmd.Flags().StringVar(&opts.OAuthTokenID, "oauth-token-id", "", "OAuth token of the VCS connection")
+	cmd.Flags().StringVar(&opts.PoliciesPath, "policies-path", "", "Directory of the policies in the repository")
+
+	cmd.MarkFlagsRequiredTogether("vcs-repo", "oauth-token-id")
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, args []string) {
+	opts.Name = args[0]
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if opts.Org == "" {
+		return cmdutil.ErrOrgRequired
+	}
+
+	if err := cmdutil.ValidateEnum("kind", opts.Kind, Kinds); err != nil {
+		return err
+	}
+
+	if opts.Overridable && opts.Kind != string(tfe.OPA) {
+		return fmt.Errorf("--overridable can only be used with --kind opa")
+	}
+
+	if opts.VCSRepo == "" && (opts.VCSBranch != "" || opts.PoliciesPath != "") {
+		return fmt.Errorf("--vcs-branch and --policies-path can only be used with --vcs-repo")
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	o := tfe.PolicySetCreateOptions{
+		Name:   ptr.String(opts.Name),
+		Kind:   tfe.PolicyKind(opts.Kind),
+		Global: ptr.Bool(opts.Global),
+	}
+	if opts.Description != "" {
+		o.Description = ptr.String(opts.Description)
+	}
+	if opts.Overridable {
+		o.Overridable = ptr.Bool(true)
+	}
+	if opts.VCSRepo != "" {
+		o.VCSRepo = &tfe.VCSRepoOptions{
+			Identifier:   ptr.String(opts.VCSRepo),
+			OAuthTokenID: ptr.String(opts.OAuthTokenID),
+		}
+		if opts.VCSBranch != "" {
+			o.VCSRepo.Branch = ptr.String(opts.VCSBranch)
+		}
+		if opts.PoliciesPath != "" {
+			o.PoliciesPath = ptr.String(opts.PoliciesPath)
+		}
+	}
+
+	ps, err := client.PolicySets.Create(ctx, opts.Org, o)
+	if err != nil {
+		return fmt.Errorf("failed to create policy set %s: %w", opts.Name, err)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Created policy set %s (%s)\n", ps.Name, ps.ID)
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package list
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/ptr"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+const (
+	ColumnID          string = "ID"
+	ColumnName        string = "NAME"
+	ColumnKind        string = "KIND"
+	ColumnGlobal      string = "GLOBAL"
+	ColumnOverridable string = "OVERRIDABLE"
+	ColumnPolicies    string = "POLICIES"
+	ColumnWorkspaces  string = "WORKSPACES"
+	ColumnProjects    string = "PROJECTS"
+	ColumnSource      string = "SOURCE"
+)
+
+var (
+	ColumnsDefault = []string{
+		ColumnName,
+		ColumnKind,
+		ColumnGlobal,
+		ColumnWorkspaces,
+		ColumnProjects,
+	}
+	ColumnsAll = []string{
+		ColumnID,
+		ColumnName,
+		ColumnKind,
+		ColumnGlobal,
+		ColumnOverridable,
+		ColumnPolicies,
+		ColumnWorkspaces,
+		ColumnProjects,
+		ColumnSource,
+	}
+)
+
+// Kinds are the kinds of policy sets.
+var Kinds = []string{string(tfe.Sentinel), string(tfe.OPA)}
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	Org     string
+	Name    string
+	Kind    string
+	Limit   int
+	Columns []string
+}
+
+func NewCmdList(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List policy sets",
+		Aliases: []string{"ls"},
+		Long: text.Heredoc(`
+			List the policy sets of an organization.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+	cmd.Flags().StringVarP(&opts.Name, "name", "n", "", "Search by the policy set name.")
+	_ = cmdutil.FlagStringEnum(cmd, &opts.Kind, "kind", "", "Only list the policy sets of a kind.", Kinds)
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "l", 20, "Limit the number of results.")
+	_ = cmdutil.FlagStringEnumSliceP(cmd, &opts.Columns, "columns", "c", ColumnsDefault, "Columns to show.", ColumnsAll)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, _ []string) {
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if opts.Org == "" {
+		return cmdutil.ErrOrgRequired
+	}
+
+	if opts.Kind != "" {
+		if err := cmdutil.ValidateEnum("kind", opts.Kind, Kinds); err != nil {
+			return err
+		}
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	sets, pagination, err := client.PolicySets.List(ctx, opts.Org, &tfc.PolicySetListOptions{
+		ListOptions: tfc.ListOptions{Limit: opts.Limit},
+		Query:       opts.Name,
+		Kind:        tfe.PolicyKind(opts.Kind),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list policy sets for %s: %w", opts.Org, err)
+	}
+
+	if pagination.ReachedLimit {
+		fmt.Fprintf(opts.IO.Out, "Showing top %d results\n\n", opts.Limit)
+	}
+
+	p := cmdutil.FieldPrinter(opts.IO, opts.Columns...)
+	for _, ps := range sets {
+		p.Write(extractFields(ps))
+	}
+	p.Flush()
+
+	return nil
+}
+
+func extractFields(ps *tfc.PolicySet) map[string]string {
+	workspaces := strconv.Itoa(ps.WorkspaceCount)
+	projects := strconv.Itoa(ps.ProjectCount)
+	if ps.Global {
+		workspaces = "all"
+		projects = "all"
+	}
+
+	source := ""
+	if ps.VCSRepo != nil {
+		source = ps.VCSRepo.Identifier
+	}
+
+	return map[string]string{
+		ColumnID:          ps.ID,
+		ColumnName:        ps.Name,
+		ColumnKind:        string(ps.Kind),
+		ColumnGlobal:      strconv.FormatBool(ps.Global),
+		ColumnOverridable: strconv.FormatBool(ptr.Deref(ps.Overridable)),
+		ColumnPolicies:    strconv.Itoa(ps.PolicyCount),
+		ColumnWorkspaces:  workspaces,
+		ColumnProjects:    projects,
+		ColumnSource:      source,
+	}
+}
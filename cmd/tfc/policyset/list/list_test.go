@@ -0,0 +1,79 @@
+package list_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/zkhvan/tfc/cmd/tfc/policyset/list"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+func TestList(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/policy-sets",
+		func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("filter[kind]"); got != "opa" {
+				t.Errorf("got kind filter %q", got)
+			}
+
+			fmt.Fprint(w, `{"data": [
+				{"id": "polset-1", "type": "policy-sets", "attributes": {
+					"name": "guardrails", "kind": "opa", "global": true, "workspace-count": 0, "project-count": 0}},
+				{"id": "polset-2", "type": "policy-sets", "attributes": {
+					"name": "networking", "kind": "opa", "global": false, "workspace-count": 3, "project-count": 1}}
+			]}`)
+		},
+	)
+
+	result := runCommand(t, client, "--org", "myorg", "--kind", "opa")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		NAME        KIND  GLOBAL  WORKSPACES  PROJECTS
+		guardrails  opa   true    all         all
+		networking  opa   false   3           1
+	`))
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+	}
+
+	cmd := list.NewCmdList(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
@@ -0,0 +1,36 @@
+package policyset
+
+import (
+	"github.com/spf13/cobra"
+
+	attachCmd "github.com/zkhvan/tfc/cmd/tfc/policyset/attach"
+	createCmd "github.com/zkhvan/tfc/cmd/tfc/policyset/create"
+	detachCmd "github.com/zkhvan/tfc/cmd/tfc/policyset/detach"
+	listCmd "github.com/zkhvan/tfc/cmd/tfc/policyset/list"
+	viewCmd "github.com/zkhvan/tfc/cmd/tfc/policyset/view"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+func NewCmdPolicySet(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "policy-sets",
+		Aliases: []string{"policy-set"},
+		Short:   "Manage policy sets",
+		Long: text.Heredoc(`
+			Manage the policy sets of an organization.
+
+			A policy set groups Sentinel or OPA policies, and is enforced on
+			the runs of every workspace of the organization when global, or
+			of the workspaces and projects it's attached to.
+		`),
+	}
+
+	cmd.AddCommand(listCmd.NewCmdList(f))
+	cmd.AddCommand(viewCmd.NewCmdView(f))
+	cmd.AddCommand(createCmd.NewCmdCreate(f))
+	cmd.AddCommand(attachCmd.NewCmdAttach(f))
+	cmd.AddCommand(detachCmd.NewCmdDetach(f))
+
+	return cmd
+}
@@ -0,0 +1,166 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/ptr"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	Org        string
+	Identifier string // Policy set name or ID
+}
+
+func NewCmdView(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view <NAME|ID>",
+		Short: "View policy set details",
+		Long: text.Heredoc(`
+			View detailed information about a policy set.
+
+			Displays the kind of the policy set, where its policies come
+			from, and the workspaces and projects it's enforced on.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# View a policy set by name
+			$ tfc policy-sets view security --org myorg
+
+			# View a policy set by ID
+			$ tfc policy-sets view polset-abc123
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, args []string) {
+	opts.Identifier = args[0]
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	ps, err := client.PolicySets.ReadByNameOrID(ctx, opts.Org, opts.Identifier)
+	if err != nil {
+		return fmt.Errorf("failed to read policy set %s: %w", opts.Identifier, err)
+	}
+
+	opts.displayPolicySet(ps)
+
+	return nil
+}
+
+func (opts *Options) displayPolicySet(ps *tfc.PolicySet) {
+	out := opts.IO.Out
+
+	faintStyle := lipgloss.NewStyle().Faint(true)
+	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("6"))
+
+	// Identity Section
+	fmt.Fprintf(out, "%s\n", headerStyle.Render("IDENTITY"))
+	fmt.Fprintf(out, "  Name:                 %s\n", ps.Name)
+	fmt.Fprintf(out, "  ID:                   %s\n", faintStyle.Render(ps.ID))
+
+	if ps.Organization != nil {
+		fmt.Fprintf(out, "  Organization:         %s\n", ps.Organization.Name)
+	}
+
+	if ps.Description != "" {
+		fmt.Fprintf(out, "  Description:          %s\n", ps.Description)
+	}
+
+	// Policies Section
+	fmt.Fprintf(out, "\n%s\n", headerStyle.Render("POLICIES"))
+	fmt.Fprintf(out, "  Kind:                 %s\n", ps.Kind)
+	fmt.Fprintf(out, "  Overridable:          %s\n", cmdutil.FormatBool(ptr.Deref(ps.Overridable)))
+	fmt.Fprintf(out, "  Agent Enabled:        %s\n", cmdutil.FormatBool(ps.AgentEnabled))
+
+	if ps.PolicyToolVersion != "" {
+		fmt.Fprintf(out, "  Tool Version:         %s\n", ps.PolicyToolVersion)
+	}
+
+	switch {
+	case ps.VCSRepo != nil:
+		source := ps.VCSRepo.Identifier
+		if ps.VCSRepo.Branch != "" {
+			source += "@" + ps.VCSRepo.Branch
+		}
+		fmt.Fprintf(out, "  Source:               %s\n", source)
+		if ps.PoliciesPath != "" {
+			fmt.Fprintf(out, "  Policies Path:        %s\n", ps.PoliciesPath)
+		}
+	default:
+		fmt.Fprintf(out, "  Source:               %s\n", "individually managed policies")
+		fmt.Fprintf(out, "  Policies:             %d\n", ps.PolicyCount)
+	}
+
+	// Scope Section
+	fmt.Fprintf(out, "\n%s\n", headerStyle.Render("SCOPE"))
+	fmt.Fprintf(out, "  Global:               %s\n", cmdutil.FormatBool(ps.Global))
+
+	// Global policy sets are enforced on every workspace except the
+	// excluded ones, they can't be attached to workspaces or projects.
+	if !ps.Global {
+		var workspaces []string
+		for _, ws := range ps.Workspaces {
+			workspaces = append(workspaces, ws.Name)
+		}
+
+		var projects []string
+		for _, p := range ps.Projects {
+			projects = append(projects, p.Name)
+		}
+
+		fmt.Fprintf(out, "\n%s\n", headerStyle.Render("WORKSPACES"))
+		cmdutil.WriteNames(out, workspaces)
+
+		fmt.Fprintf(out, "\n%s\n", headerStyle.Render("PROJECTS"))
+		cmdutil.WriteNames(out, projects)
+	}
+
+	if ps.Global || len(ps.WorkspaceExclusions) > 0 {
+		var excluded []string
+		for _, ws := range ps.WorkspaceExclusions {
+			excluded = append(excluded, ws.Name)
+		}
+
+		fmt.Fprintf(out, "\n%s\n", headerStyle.Render("EXCLUDED WORKSPACES"))
+		cmdutil.WriteNames(out, excluded)
+	}
+}
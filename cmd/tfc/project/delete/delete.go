@@ -0,0 +1,110 @@
+package delete
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+	Prompter        func() *cmdutil.Prompter
+
+	Org        string
+	Identifier string // Project name or ID
+	Yes        bool
+}
+
+func NewCmdDelete(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+		Prompter:        f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete <NAME|ID>",
+		Short: "Delete a project",
+		Long: text.Heredoc(`
+			Delete a project.
+
+			A project can only be deleted once it has no workspaces left, use
+			"tfc projects move-workspaces" to move them to another project.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Delete a project by name
+			$ tfc projects delete platform --org myorg
+
+			# Delete a project by ID without confirmation
+			$ tfc projects delete prj-abc123 --yes
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+
+	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Delete the project without asking for confirmation")
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, args []string) {
+	opts.Identifier = args[0]
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	prj, err := client.Projects.ReadByNameOrID(ctx, opts.Org, opts.Identifier)
+	if err != nil {
+		return fmt.Errorf("failed to read project %s: %w", opts.Identifier, err)
+	}
+
+	if !opts.Yes {
+		prompter := opts.Prompter()
+		if !prompter.CanPrompt() {
+			return fmt.Errorf("confirmation required: use --yes to delete the project without prompting")
+		}
+
+		ok, err := prompter.Confirm(fmt.Sprintf("Delete project %s (%s)?", prj.Name, prj.ID))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Fprintln(opts.IO.Out, "Delete cancelled")
+			return nil
+		}
+	}
+
+	if err := client.Projects.Delete(ctx, prj.ID); err != nil {
+		return fmt.Errorf("failed to delete project %s: %w", prj.Name, err)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Deleted project %s (%s)\n", prj.Name, prj.ID)
+
+	return nil
+}
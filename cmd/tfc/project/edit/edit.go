@@ -0,0 +1,115 @@
+package edit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	Org         string
+	Identifier  string // Project name or ID
+	Name        *string
+	Description *string
+}
+
+func NewCmdEdit(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	var (
+		name        string
+		description string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "edit <NAME|ID>",
+		Short: "Edit a project",
+		Long: text.Heredoc(`
+			Edit the settings of a project.
+
+			Only the settings of the given flags are changed.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Rename a project
+			$ tfc projects edit platform --org myorg --name platform-core
+
+			# Change the description of a project
+			$ tfc projects edit prj-abc123 --description "Shared infrastructure"
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			if flags.Changed("name") {
+				opts.Name = &name
+			}
+			if flags.Changed("description") {
+				opts.Description = &description
+			}
+
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+
+	cmd.Flags().StringVarP(&name, "name", "n", "", "New project name")
+	cmd.Flags().StringVarP(&description, "description", "d", "", "Project description")
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, args []string) {
+	opts.Identifier = args[0]
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if opts.Name == nil && opts.Description == nil {
+		return fmt.Errorf("nothing to edit: use --name or --description")
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	prj, err := client.Projects.ReadByNameOrID(ctx, opts.Org, opts.Identifier)
+	if err != nil {
+		return fmt.Errorf("failed to read project %s: %w", opts.Identifier, err)
+	}
+
+	prj, err = client.Projects.Update(ctx, prj.ID, tfe.ProjectUpdateOptions{
+		Name:        opts.Name,
+		Description: opts.Description,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update project %s: %w", opts.Identifier, err)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Updated project %s (%s)\n", prj.Name, prj.ID)
+
+	return nil
+}
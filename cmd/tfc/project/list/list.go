@@ -0,0 +1,124 @@
+package list
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+const (
+	ColumnID            string = "ID"
+	ColumnName          string = "NAME"
+	ColumnDescription   string = "DESCRIPTION"
+	ColumnExecutionMode string = "EXECUTION_MODE"
+)
+
+var (
+	ColumnsDefault = []string{
+		ColumnName,
+		ColumnID,
+		ColumnDescription,
+	}
+	ColumnsAll = []string{
+		ColumnID,
+		ColumnName,
+		ColumnDescription,
+		ColumnExecutionMode,
+	}
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	Org     string
+	Name    string
+	Limit   int
+	Columns []string
+}
+
+func NewCmdList(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List projects",
+		Aliases: []string{"ls"},
+		Long: text.Heredoc(`
+			List the projects of an organization.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+	cmd.Flags().StringVarP(&opts.Name, "name", "n", "", "Search by the project name.")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "l", 20, "Limit the number of results.")
+	_ = cmdutil.FlagStringEnumSliceP(cmd, &opts.Columns, "columns", "c", ColumnsDefault, "Columns to show.", ColumnsAll)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, _ []string) {
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if opts.Org == "" {
+		return cmdutil.ErrOrgRequired
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	projects, pagination, err := client.Projects.List(ctx, opts.Org, &tfc.ProjectListOptions{
+		ListOptions: tfc.ListOptions{Limit: opts.Limit},
+		Query:       opts.Name,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list projects for %s: %w", opts.Org, err)
+	}
+
+	if pagination.ReachedLimit {
+		fmt.Fprintf(opts.IO.Out, "Showing top %d results\n\n", opts.Limit)
+	}
+
+	p := cmdutil.FieldPrinter(opts.IO, opts.Columns...)
+	for _, prj := range projects {
+		p.Write(extractFields(prj))
+	}
+	p.Flush()
+
+	return nil
+}
+
+func extractFields(prj *tfc.Project) map[string]string {
+	return map[string]string{
+		ColumnID:            prj.ID,
+		ColumnName:          prj.Name,
+		ColumnDescription:   prj.Description,
+		ColumnExecutionMode: prj.DefaultExecutionMode,
+	}
+}
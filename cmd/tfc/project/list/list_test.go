@@ -0,0 +1,98 @@
+package list_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/zkhvan/tfc/cmd/tfc/project/list"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+func TestList(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/projects",
+		func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("q"); got != "plat" {
+				t.Errorf("got query %q, want %q", got, "plat")
+			}
+
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "prj-1",
+							"type": "projects",
+							"attributes": {"name": "platform", "description": "Shared infrastructure"}
+						},
+						{
+							"id": "prj-2",
+							"type": "projects",
+							"attributes": {"name": "platform-legacy", "description": "Old accounts"}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	result := runCommand(t, client, "--org", "myorg", "--name", "plat")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		NAME             ID     DESCRIPTION
+		platform         prj-1  Shared infrastructure
+		platform-legacy  prj-2  Old accounts
+	`))
+}
+
+func TestList_org_required(t *testing.T) {
+	client, _, teardown := tfetest.Setup()
+	defer teardown()
+
+	result := runCommand(t, client)
+
+	test.Buffer(t, result.ErrBuf, "organization required: use --org or ensure state.tf exists\n")
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+	}
+
+	cmd := list.NewCmdList(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
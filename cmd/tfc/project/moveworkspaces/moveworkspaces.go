@@ -0,0 +1,258 @@
+package moveworkspaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+// nonFilterFlags are the flags of the command that don't select workspaces.
+var nonFilterFlags = []string{"org", "to", "limit", "yes"}
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+	Prompter        func() *cmdutil.Prompter
+
+	To     string // Project name or ID
+	Names  []string
+	Filter cmdutil.WorkspaceFilter
+	Limit  int
+	Yes    bool
+
+	filtered bool
+}
+
+func NewCmdMoveWorkspaces(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+		Prompter:        f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "move-workspaces --to <PROJECT> [<WORKSPACE>...]",
+		Short: "Move workspaces to a project",
+		Long: text.Heredoc(`
+			Move workspaces to another project.
+
+			The workspaces are either given by name, or selected with the same
+			filter flags as "tfc workspaces list", e.g. --project to move every
+			workspace of a project. Workspaces already in the --to project are
+			skipped.
+
+			The workspaces to move are listed before asking for confirmation.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Move workspaces by name
+			$ tfc projects move-workspaces --org myorg --to platform app-dev app-prod
+
+			# Move every workspace of a project
+			$ tfc projects move-workspaces --org myorg --project legacy --to platform
+
+			# Move the workspaces matching a pattern without confirmation
+			$ tfc projects move-workspaces --org myorg --wildcard 'network-*' --to platform --yes
+		`),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceFilterFlags(cmd, &opts.Filter)
+
+	cmd.Flags().StringVar(&opts.To, "to", "", "Name or ID of the project to move the workspaces to")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "l", 100, "Limit the number of workspaces matched by the filter flags.")
+	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Move the workspaces without asking for confirmation")
+
+	_ = cmd.MarkFlagRequired("to")
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, args []string) {
+	opts.Names = args
+
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		if !slices.Contains(nonFilterFlags, f.Name) {
+			opts.filtered = true
+		}
+	})
+
+	cmdutil.CompleteOrg(&opts.Filter.Organization, opts.TerraformConfig)
+	opts.Filter.Complete()
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if opts.Filter.Organization == "" {
+		return cmdutil.ErrOrgRequired
+	}
+
+	if !opts.Filter.OrganizationExact {
+		return fmt.Errorf("invalid organization %q: workspaces can only be moved within one organization",
+			opts.Filter.Organization,
+		)
+	}
+
+	if len(opts.Names) == 0 && !opts.filtered {
+		return fmt.Errorf("workspaces required: pass workspace names or use the filter flags")
+	}
+
+	if len(opts.Names) > 0 && opts.filtered {
+		return fmt.Errorf("workspace names can't be combined with the filter flags")
+	}
+
+	org := opts.Filter.Organization
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	to, err := client.Projects.ReadByNameOrID(ctx, org, opts.To)
+	if err != nil {
+		return fmt.Errorf("failed to read project %s: %w", opts.To, err)
+	}
+
+	workspaces, err := opts.workspaces(ctx, client, org)
+	if err != nil {
+		return err
+	}
+
+	var (
+		moves   []*tfc.Workspace
+		skipped int
+	)
+	for _, ws := range workspaces {
+		if ws.Project != nil && ws.Project.ID == to.ID {
+			skipped++
+			continue
+		}
+		moves = append(moves, ws)
+	}
+
+	if skipped > 0 {
+		fmt.Fprintf(opts.IO.ErrOut, "Skipped %d workspaces already in %s\n", skipped, to.Name)
+	}
+
+	if len(moves) == 0 {
+		fmt.Fprintln(opts.IO.Out, "No workspaces to move.")
+		return nil
+	}
+
+	projects, err := projectNames(ctx, client, org)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Workspaces to move to %s:\n", to.Name)
+	for _, ws := range moves {
+		from := ""
+		if ws.Project != nil {
+			from = projects[ws.Project.ID]
+		}
+		fmt.Fprintf(opts.IO.Out, "  %s (%s -> %s)\n", ws.Name, from, to.Name)
+	}
+
+	if !opts.Yes {
+		prompter := opts.Prompter()
+		if !prompter.CanPrompt() {
+			return fmt.Errorf("confirmation required: use --yes to move the workspaces without prompting")
+		}
+
+		ok, err := prompter.Confirm(fmt.Sprintf("\nMove %d workspaces to %s?", len(moves), to.Name))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Fprintln(opts.IO.Out, "Move cancelled")
+			return nil
+		}
+	}
+
+	var errs []error
+	moved := 0
+	for _, ws := range moves {
+		_, err := client.Workspaces.Update(ctx, org, ws.Name, tfe.WorkspaceUpdateOptions{
+			Project: &tfe.Project{ID: to.ID},
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to move %s: %w", ws.Name, err))
+			continue
+		}
+		moved++
+	}
+
+	fmt.Fprintf(opts.IO.Out, "\nMoved %d workspaces to %s\n", moved, to.Name)
+
+	return errors.Join(errs...)
+}
+
+// workspaces returns the workspaces given by name, or matched by the filter
+// flags.
+func (opts *Options) workspaces(ctx context.Context, client *tfc.Client, org string) ([]*tfc.Workspace, error) {
+	if len(opts.Names) == 0 {
+		workspaces, truncated, err := opts.Filter.Workspaces(ctx, client, opts.Limit)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(truncated) > 0 {
+			fmt.Fprintf(
+				opts.IO.ErrOut,
+				"Warning: only the first %d matching workspaces of %s are included: use --limit to include more\n",
+				opts.Limit, strings.Join(truncated, ", "),
+			)
+		}
+
+		return workspaces, nil
+	}
+
+	var workspaces []*tfc.Workspace
+	for _, name := range opts.Names {
+		ws, err := client.Workspaces.Read(ctx, org, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read workspace %s/%s: %w", org, name, err)
+		}
+		workspaces = append(workspaces, ws)
+	}
+
+	return workspaces, nil
+}
+
+// projectNames returns the names of the projects of an organization by ID.
+func projectNames(ctx context.Context, client *tfc.Client, org string) (map[string]string, error) {
+	projects, _, err := client.Projects.List(ctx, org, &tfc.ProjectListOptions{
+		ListOptions: tfc.ListOptions{Limit: math.MaxInt},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects for %s: %w", org, err)
+	}
+
+	names := make(map[string]string, len(projects))
+	for _, p := range projects {
+		names[p.ID] = p.Name
+	}
+
+	return names, nil
+}
@@ -0,0 +1,400 @@
+package moveworkspaces_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/zkhvan/tfc/cmd/tfc/project/moveworkspaces"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+func TestMoveWorkspaces_names(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/projects",
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Query().Get("filter[names]") {
+			case "platform":
+				fmt.Fprint(w, `{"data": [{"id": "prj-platform", "type": "projects", "attributes": {"name": "platform"}}]}`)
+			case "legacy":
+				fmt.Fprint(w, `{"data": [{"id": "prj-legacy", "type": "projects", "attributes": {"name": "legacy"}}]}`)
+			default:
+				fmt.Fprint(w, `{"data": [
+					{"id": "prj-platform", "type": "projects", "attributes": {"name": "platform"}},
+					{"id": "prj-legacy", "type": "projects", "attributes": {"name": "legacy"}},
+					{"id": "prj-default", "type": "projects", "attributes": {"name": "Default Project"}}
+				]}`)
+			}
+		},
+	)
+
+	projects := map[string]string{
+		"network": "prj-legacy",
+		"dns":     "prj-default",
+		"iam":     "prj-platform",
+	}
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces/{workspace}",
+		func(w http.ResponseWriter, r *http.Request) {
+			name := r.PathValue("workspace")
+			fmt.Fprintf(w, `{"data": {"id": "ws-%s", "type": "workspaces", "attributes": {"name": %q},
+				"relationships": {"project": {"data": {"id": %q, "type": "projects"}}}}}`,
+				name, name, projects[name],
+			)
+		},
+	)
+
+	var requests []string
+	mux.HandleFunc(
+		"PATCH /api/v2/organizations/myorg/workspaces/{workspace}",
+		func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			if !strings.Contains(string(body), `"id":"prj-platform"`) {
+				t.Errorf("got body %s", body)
+			}
+
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			fmt.Fprintf(w, `{"data": {"id": "ws-1", "type": "workspaces", "attributes": {"name": %q}}}`,
+				r.PathValue("workspace"),
+			)
+		},
+	)
+
+	result := runCommand(t, client, "--org", "myorg", "--to", "platform", "network", "dns", "iam", "--yes")
+
+	test.Buffer(t, result.ErrBuf, "Skipped 1 workspaces already in platform\n")
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		Workspaces to move to platform:
+		  network (legacy -> platform)
+		  dns (Default Project -> platform)
+
+		Moved 2 workspaces to platform
+	`))
+
+	test.StringSlice(t, requests, []string{
+		"PATCH /api/v2/organizations/myorg/workspaces/network",
+		"PATCH /api/v2/organizations/myorg/workspaces/dns",
+	})
+}
+
+func TestMoveWorkspaces_filter(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/projects",
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Query().Get("filter[names]") {
+			case "platform":
+				fmt.Fprint(w, `{"data": [{"id": "prj-platform", "type": "projects", "attributes": {"name": "platform"}}]}`)
+			case "legacy":
+				fmt.Fprint(w, `{"data": [{"id": "prj-legacy", "type": "projects", "attributes": {"name": "legacy"}}]}`)
+			default:
+				fmt.Fprint(w, `{"data": [
+					{"id": "prj-platform", "type": "projects", "attributes": {"name": "platform"}},
+					{"id": "prj-legacy", "type": "projects", "attributes": {"name": "legacy"}},
+					{"id": "prj-default", "type": "projects", "attributes": {"name": "Default Project"}}
+				]}`)
+			}
+		},
+	)
+
+	projects := map[string]string{
+		"network": "prj-legacy",
+		"dns":     "prj-default",
+		"iam":     "prj-platform",
+	}
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces/{workspace}",
+		func(w http.ResponseWriter, r *http.Request) {
+			name := r.PathValue("workspace")
+			fmt.Fprintf(w, `{"data": {"id": "ws-%s", "type": "workspaces", "attributes": {"name": %q},
+				"relationships": {"project": {"data": {"id": %q, "type": "projects"}}}}}`,
+				name, name, projects[name],
+			)
+		},
+	)
+
+	var requests []string
+	mux.HandleFunc(
+		"PATCH /api/v2/organizations/myorg/workspaces/{workspace}",
+		func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			if !strings.Contains(string(body), `"id":"prj-platform"`) {
+				t.Errorf("got body %s", body)
+			}
+
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			fmt.Fprintf(w, `{"data": {"id": "ws-1", "type": "workspaces", "attributes": {"name": %q}}}`,
+				r.PathValue("workspace"),
+			)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "myorg", "type": "organizations", "attributes": {"name": "myorg"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces",
+		func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("filter[project][id]"); got != "prj-legacy" {
+				t.Errorf("got project filter %q", got)
+			}
+
+			fmt.Fprint(w, `{"data": [
+				{"id": "ws-network", "type": "workspaces", "attributes": {"name": "network"},
+					"relationships": {"project": {"data": {"id": "prj-legacy", "type": "projects"}}}},
+				{"id": "ws-vpn", "type": "workspaces", "attributes": {"name": "vpn"},
+					"relationships": {"project": {"data": {"id": "prj-legacy", "type": "projects"}}}}
+			]}`)
+		},
+	)
+
+	result := runCommand(t, client, "--org", "myorg", "--project", "legacy", "--to", "platform", "--yes")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		Workspaces to move to platform:
+		  network (legacy -> platform)
+		  vpn (legacy -> platform)
+
+		Moved 2 workspaces to platform
+	`))
+
+	test.StringSlice(t, requests, []string{
+		"PATCH /api/v2/organizations/myorg/workspaces/network",
+		"PATCH /api/v2/organizations/myorg/workspaces/vpn",
+	})
+}
+
+func TestMoveWorkspaces_filter_limit(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/projects",
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Query().Get("filter[names]") {
+			case "platform":
+				fmt.Fprint(w, `{"data": [{"id": "prj-platform", "type": "projects", "attributes": {"name": "platform"}}]}`)
+			case "legacy":
+				fmt.Fprint(w, `{"data": [{"id": "prj-legacy", "type": "projects", "attributes": {"name": "legacy"}}]}`)
+			default:
+				fmt.Fprint(w, `{"data": [
+					{"id": "prj-platform", "type": "projects", "attributes": {"name": "platform"}},
+					{"id": "prj-legacy", "type": "projects", "attributes": {"name": "legacy"}},
+					{"id": "prj-default", "type": "projects", "attributes": {"name": "Default Project"}}
+				]}`)
+			}
+		},
+	)
+
+	projects := map[string]string{
+		"network": "prj-legacy",
+		"dns":     "prj-default",
+		"iam":     "prj-platform",
+	}
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces/{workspace}",
+		func(w http.ResponseWriter, r *http.Request) {
+			name := r.PathValue("workspace")
+			fmt.Fprintf(w, `{"data": {"id": "ws-%s", "type": "workspaces", "attributes": {"name": %q},
+				"relationships": {"project": {"data": {"id": %q, "type": "projects"}}}}}`,
+				name, name, projects[name],
+			)
+		},
+	)
+
+	var requests []string
+	mux.HandleFunc(
+		"PATCH /api/v2/organizations/myorg/workspaces/{workspace}",
+		func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			if !strings.Contains(string(body), `"id":"prj-platform"`) {
+				t.Errorf("got body %s", body)
+			}
+
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			fmt.Fprintf(w, `{"data": {"id": "ws-1", "type": "workspaces", "attributes": {"name": %q}}}`,
+				r.PathValue("workspace"),
+			)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "myorg", "type": "organizations", "attributes": {"name": "myorg"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "ws-network", "type": "workspaces", "attributes": {"name": "network"},
+					"relationships": {"project": {"data": {"id": "prj-legacy", "type": "projects"}}}},
+				{"id": "ws-vpn", "type": "workspaces", "attributes": {"name": "vpn"},
+					"relationships": {"project": {"data": {"id": "prj-legacy", "type": "projects"}}}}
+			], "meta": {"pagination": {"current-page": 1, "total-pages": 1, "total-count": 2}}}`)
+		},
+	)
+
+	result := runCommand(t, client, "--org", "myorg", "--project", "legacy", "--to", "platform", "--limit", "1", "--yes")
+
+	test.Buffer(t, result.ErrBuf,
+		"Warning: only the first 1 matching workspaces of myorg are included: use --limit to include more\n",
+	)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		Workspaces to move to platform:
+		  network (legacy -> platform)
+
+		Moved 1 workspaces to platform
+	`))
+
+	test.StringSlice(t, requests, []string{
+		"PATCH /api/v2/organizations/myorg/workspaces/network",
+	})
+}
+
+func TestMoveWorkspaces_confirmation_required(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/projects",
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Query().Get("filter[names]") {
+			case "platform":
+				fmt.Fprint(w, `{"data": [{"id": "prj-platform", "type": "projects", "attributes": {"name": "platform"}}]}`)
+			case "legacy":
+				fmt.Fprint(w, `{"data": [{"id": "prj-legacy", "type": "projects", "attributes": {"name": "legacy"}}]}`)
+			default:
+				fmt.Fprint(w, `{"data": [
+					{"id": "prj-platform", "type": "projects", "attributes": {"name": "platform"}},
+					{"id": "prj-legacy", "type": "projects", "attributes": {"name": "legacy"}},
+					{"id": "prj-default", "type": "projects", "attributes": {"name": "Default Project"}}
+				]}`)
+			}
+		},
+	)
+
+	projects := map[string]string{
+		"network": "prj-legacy",
+		"dns":     "prj-default",
+		"iam":     "prj-platform",
+	}
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces/{workspace}",
+		func(w http.ResponseWriter, r *http.Request) {
+			name := r.PathValue("workspace")
+			fmt.Fprintf(w, `{"data": {"id": "ws-%s", "type": "workspaces", "attributes": {"name": %q},
+				"relationships": {"project": {"data": {"id": %q, "type": "projects"}}}}}`,
+				name, name, projects[name],
+			)
+		},
+	)
+
+	var requests []string
+	mux.HandleFunc(
+		"PATCH /api/v2/organizations/myorg/workspaces/{workspace}",
+		func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			if !strings.Contains(string(body), `"id":"prj-platform"`) {
+				t.Errorf("got body %s", body)
+			}
+
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			fmt.Fprintf(w, `{"data": {"id": "ws-1", "type": "workspaces", "attributes": {"name": %q}}}`,
+				r.PathValue("workspace"),
+			)
+		},
+	)
+
+	result := runCommand(t, client, "--org", "myorg", "--to", "platform", "network")
+
+	test.Buffer(t, result.ErrBuf,
+		"confirmation required: use --yes to move the workspaces without prompting\n",
+	)
+	test.StringSlice(t, requests, nil)
+}
+
+func TestMoveWorkspaces_workspaces_required(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/projects",
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Query().Get("filter[names]") {
+			case "platform":
+				fmt.Fprint(w, `{"data": [{"id": "prj-platform", "type": "projects", "attributes": {"name": "platform"}}]}`)
+			case "legacy":
+				fmt.Fprint(w, `{"data": [{"id": "prj-legacy", "type": "projects", "attributes": {"name": "legacy"}}]}`)
+			default:
+				fmt.Fprint(w, `{"data": [
+					{"id": "prj-platform", "type": "projects", "attributes": {"name": "platform"}},
+					{"id": "prj-legacy", "type": "projects", "attributes": {"name": "legacy"}},
+					{"id": "prj-default", "type": "projects", "attributes": {"name": "Default Project"}}
+				]}`)
+			}
+		},
+	)
+
+	result := runCommand(t, client, "--org", "myorg", "--to", "platform")
+
+	test.Buffer(t, result.ErrBuf, "workspaces required: pass workspace names or use the filter flags\n")
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+		Prompter:        func() *cmdutil.Prompter { return cmdutil.NewPrompter(ios) },
+	}
+
+	cmd := moveworkspaces.NewCmdMoveWorkspaces(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
@@ -0,0 +1,37 @@
+package project
+
+import (
+	"github.com/spf13/cobra"
+
+	createCmd "github.com/zkhvan/tfc/cmd/tfc/project/create"
+	deleteCmd "github.com/zkhvan/tfc/cmd/tfc/project/delete"
+	editCmd "github.com/zkhvan/tfc/cmd/tfc/project/edit"
+	listCmd "github.com/zkhvan/tfc/cmd/tfc/project/list"
+	moveWorkspacesCmd "github.com/zkhvan/tfc/cmd/tfc/project/moveworkspaces"
+	viewCmd "github.com/zkhvan/tfc/cmd/tfc/project/view"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+func NewCmdProject(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "projects",
+		Aliases: []string{"project", "prj"},
+		Short:   "Manage projects",
+		Long: text.Heredoc(`
+			Manage the projects of an organization.
+
+			Projects group workspaces, and the variable sets and team access
+			of a project apply to all of its workspaces.
+		`),
+	}
+
+	cmd.AddCommand(listCmd.NewCmdList(f))
+	cmd.AddCommand(viewCmd.NewCmdView(f))
+	cmd.AddCommand(createCmd.NewCmdCreate(f))
+	cmd.AddCommand(editCmd.NewCmdEdit(f))
+	cmd.AddCommand(deleteCmd.NewCmdDelete(f))
+	cmd.AddCommand(moveWorkspacesCmd.NewCmdMoveWorkspaces(f))
+
+	return cmd
+}
@@ -0,0 +1,262 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/hashicorp/go-tfe"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+// workspaceLimit is the maximum number of workspaces read to break down
+// their run statuses.
+const workspaceLimit = 1000
+
+// runStatusGroups are the run status groups in the order they're shown.
+var runStatusGroups = []struct {
+	group tfc.RunStatusGroup
+	label string
+}{
+	{tfc.RunStatusGroupApplied, "Applied"},
+	{tfc.RunStatusGroupPending, "Pending"},
+	{tfc.RunStatusGroupRunning, "Running"},
+	{tfc.RunStatusGroupHolding, "Holding"},
+	{tfc.RunStatusGroupErrored, "Errored"},
+}
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	Org        string
+	Identifier string // Project name or ID
+}
+
+func NewCmdView(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view <NAME|ID>",
+		Short: "View project details",
+		Long: text.Heredoc(`
+			View detailed information about a project.
+
+			Displays the number of workspaces in the project broken down by
+			the status of their current run, the variable sets applied to the
+			project and the teams with access to it.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# View a project by name
+			$ tfc projects view platform --org myorg
+
+			# View a project by ID
+			$ tfc projects view prj-abc123
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, args []string) {
+	opts.Identifier = args[0]
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	prj, err := client.Projects.ReadByNameOrID(ctx, opts.Org, opts.Identifier)
+	if err != nil {
+		return fmt.Errorf("failed to read project %s: %w", opts.Identifier, err)
+	}
+
+	org := opts.Org
+	if prj.Organization != nil {
+		org = prj.Organization.Name
+	}
+
+	workspaces, pagination, err := client.Workspaces.List(ctx, org, &tfc.WorkspaceListOptions{
+		ListOptions: tfc.ListOptions{Limit: workspaceLimit},
+		ProjectID:   prj.ID,
+		Include:     []tfe.WSIncludeOpt{tfe.WSCurrentRun},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list workspaces for project %s: %w", prj.Name, err)
+	}
+
+	sets, _, err := client.VariableSets.ListForProject(ctx, prj.ID, &tfc.VariableSetListOptions{
+		ListOptions: tfc.ListOptions{Limit: math.MaxInt},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list variable sets for project %s: %w", prj.Name, err)
+	}
+
+	access, err := client.Projects.ListTeamAccess(ctx, prj.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list team access for project %s: %w", prj.Name, err)
+	}
+
+	// Team access only references teams by ID.
+	teams := map[string]string{}
+	if len(access) > 0 {
+		list, _, err := client.Teams.List(ctx, org, &tfc.TeamListOptions{
+			ListOptions: tfc.ListOptions{Limit: math.MaxInt},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list teams for %s: %w", org, err)
+		}
+		for _, t := range list {
+			teams[t.ID] = t.Name
+		}
+	}
+
+	opts.displayProject(prj, org)
+	opts.displayWorkspaces(workspaces, pagination)
+	opts.displayVariableSets(sets)
+	opts.displayTeams(access, teams)
+
+	return nil
+}
+
+func (opts *Options) displayProject(prj *tfc.Project, org string) {
+	out := opts.IO.Out
+
+	fmt.Fprintf(out, "%s\n", headerStyle.Render("IDENTITY"))
+	fmt.Fprintf(out, "  Name:                 %s\n", prj.Name)
+	fmt.Fprintf(out, "  ID:                   %s\n", faintStyle.Render(prj.ID))
+
+	if org != "" {
+		fmt.Fprintf(out, "  Organization:         %s\n", org)
+	}
+
+	if prj.Description != "" {
+		fmt.Fprintf(out, "  Description:          %s\n", prj.Description)
+	}
+
+	if prj.DefaultExecutionMode != "" {
+		fmt.Fprintf(out, "  Execution Mode:       %s\n", prj.DefaultExecutionMode)
+	}
+}
+
+func (opts *Options) displayWorkspaces(workspaces []*tfc.Workspace, pagination *tfc.Pagination) {
+	out := opts.IO.Out
+
+	counts := map[tfc.RunStatusGroup]int{}
+	noRuns := 0
+	for _, ws := range workspaces {
+		if ws.CurrentRun == nil {
+			noRuns++
+			continue
+		}
+		counts[tfc.RunStatusGroupOf(ws.CurrentRun.Status)]++
+	}
+
+	total := len(workspaces)
+	if pagination.ReachedLimit {
+		total = pagination.TotalCount
+	}
+
+	fmt.Fprintf(out, "\n%s\n", headerStyle.Render("WORKSPACES"))
+	fmt.Fprintf(out, "  Total:                %d\n", total)
+
+	for _, g := range runStatusGroups {
+		if n := counts[g.group]; n > 0 {
+			fmt.Fprintf(out, "  %s:%s%d\n", g.label, cmdutil.LabelPadding(g.label), n)
+		}
+	}
+
+	if n := counts[tfc.RunStatusGroupUnknown]; n > 0 {
+		fmt.Fprintf(out, "  Other:%s%d\n", cmdutil.LabelPadding("Other"), n)
+	}
+
+	if noRuns > 0 {
+		fmt.Fprintf(out, "  No runs:%s%d\n", cmdutil.LabelPadding("No runs"), noRuns)
+	}
+
+	if pagination.ReachedLimit {
+		fmt.Fprintf(out, "  %s\n", faintStyle.Render(
+			fmt.Sprintf("(run statuses of the first %d workspaces)", len(workspaces)),
+		))
+	}
+}
+
+func (opts *Options) displayVariableSets(sets []*tfc.VariableSet) {
+	var names []string
+	for _, vs := range sets {
+		name := vs.Name
+		if vs.Priority {
+			name += " " + faintStyle.Render("(priority)")
+		}
+		names = append(names, name)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "\n%s\n", headerStyle.Render("VARIABLE SETS"))
+	cmdutil.WriteNames(opts.IO.Out, names)
+}
+
+func (opts *Options) displayTeams(access []*tfc.TeamProjectAccess, teams map[string]string) {
+	out := opts.IO.Out
+
+	fmt.Fprintf(out, "\n%s\n", headerStyle.Render("TEAMS"))
+	if len(access) == 0 {
+		fmt.Fprintf(out, "  %s\n", faintStyle.Render("(none)"))
+		return
+	}
+
+	type row struct{ team, access string }
+
+	var rows []row
+	width := 0
+	for _, a := range access {
+		r := row{access: string(a.Access)}
+		if a.Team != nil {
+			r.team = a.Team.ID
+			if name, ok := teams[a.Team.ID]; ok {
+				r.team = name
+			}
+		}
+		rows = append(rows, r)
+		width = max(width, len(r.team))
+	}
+
+	sort.Slice(rows, func(i, j int) bool { return rows[i].team < rows[j].team })
+
+	for _, r := range rows {
+		fmt.Fprintf(out, "  %-*s  %s\n", width, r.team, r.access)
+	}
+}
+
+var (
+	faintStyle  = lipgloss.NewStyle().Faint(true)
+	headerStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("6"))
+)
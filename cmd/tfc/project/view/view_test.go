@@ -0,0 +1,179 @@
+package view_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/zkhvan/tfc/cmd/tfc/project/view"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+func TestView(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/projects",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{
+					"id": "prj-1",
+					"type": "projects",
+					"attributes": {"name": "platform", "description": "Shared infrastructure"},
+					"relationships": {"organization": {"data": {"id": "myorg", "type": "organizations"}}}
+				}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces",
+		func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("filter[project][id]"); got != "prj-1" {
+				t.Errorf("got project filter %q", got)
+			}
+			if got := r.URL.Query().Get("include"); got != "current_run" {
+				t.Errorf("got include %q", got)
+			}
+
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{"id": "ws-1", "type": "workspaces", "attributes": {"name": "network"},
+							"relationships": {"current-run": {"data": {"id": "run-1", "type": "runs"}}}},
+						{"id": "ws-2", "type": "workspaces", "attributes": {"name": "dns"},
+							"relationships": {"current-run": {"data": {"id": "run-2", "type": "runs"}}}},
+						{"id": "ws-3", "type": "workspaces", "attributes": {"name": "iam"},
+							"relationships": {"current-run": {"data": {"id": "run-3", "type": "runs"}}}},
+						{"id": "ws-4", "type": "workspaces", "attributes": {"name": "vpn"},
+							"relationships": {"current-run": {"data": null}}}
+					],
+					"included": [
+						{"id": "run-1", "type": "runs", "attributes": {"status": "applied"}},
+						{"id": "run-2", "type": "runs", "attributes": {"status": "planned_and_finished"}},
+						{"id": "run-3", "type": "runs", "attributes": {"status": "errored"}}
+					]
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/projects/prj-1/varsets",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "varset-1", "type": "varsets", "attributes": {"name": "aws-credentials"}},
+				{"id": "varset-2", "type": "varsets", "attributes": {"name": "defaults", "priority": true}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/team-projects",
+		func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("filter[project][id]"); got != "prj-1" {
+				t.Errorf("got project filter %q", got)
+			}
+
+			fmt.Fprint(w, `{"data": [
+				{"id": "tprj-1", "type": "team-projects", "attributes": {"access": "write"},
+					"relationships": {"team": {"data": {"id": "team-1", "type": "teams"}}}},
+				{"id": "tprj-2", "type": "team-projects", "attributes": {"access": "admin"},
+					"relationships": {"team": {"data": {"id": "team-2", "type": "teams"}}}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/teams",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "team-1", "type": "teams", "attributes": {"name": "developers"}},
+				{"id": "team-2", "type": "teams", "attributes": {"name": "platform-admins"}}
+			]}`)
+		},
+	)
+
+	result := runCommand(t, client, "platform", "--org", "myorg")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		IDENTITY
+		  Name:                 platform
+		  ID:                   prj-1
+		  Organization:         myorg
+		  Description:          Shared infrastructure
+
+		WORKSPACES
+		  Total:                4
+		  Applied:              2
+		  Errored:              1
+		  No runs:              1
+
+		VARIABLE SETS
+		  aws-credentials
+		  defaults (priority)
+
+		TEAMS
+		  developers       write
+		  platform-admins  admin
+	`))
+}
+
+func TestView_not_found(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/projects",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": []}`)
+		},
+	)
+
+	result := runCommand(t, client, "platform", "--org", "myorg")
+
+	test.Buffer(t, result.ErrBuf,
+		"failed to read project platform: project \"platform\" not found in organization \"myorg\"\n",
+	)
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+	}
+
+	cmd := view.NewCmdView(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
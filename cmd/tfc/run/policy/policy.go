@@ -0,0 +1,466 @@
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/hashicorp/go-tfe"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+// Report is the result of the policies enforced on a run.
+type Report struct {
+	RunID       string       `json:"run_id"`
+	Checks      []Check      `json:"policy_checks"`
+	Evaluations []Evaluation `json:"policy_evaluations"`
+}
+
+// Check is a Sentinel policy check, used by policy sets that don't run on
+// agents.
+type Check struct {
+	ID          string            `json:"id"`
+	Status      string            `json:"status"`
+	Overridable bool              `json:"overridable"`
+	PolicySets  []PolicySetResult `json:"policy_sets"`
+}
+
+// Evaluation is a policy evaluation of a task stage, used by OPA policy sets
+// and Sentinel policy sets running on agents.
+type Evaluation struct {
+	ID          string            `json:"id"`
+	TaskStageID string            `json:"task_stage_id"`
+	Stage       string            `json:"stage"`
+	Kind        string            `json:"kind"`
+	Status      string            `json:"status"`
+	Overridable bool              `json:"overridable"`
+	PolicySets  []PolicySetResult `json:"policy_sets"`
+}
+
+// PolicySetResult is the result of the policies of a policy set.
+type PolicySetResult struct {
+	Name     string         `json:"name"`
+	Error    string         `json:"error,omitempty"`
+	Policies []PolicyResult `json:"policies"`
+}
+
+// PolicyResult is the result of a policy.
+type PolicyResult struct {
+	Policy           string `json:"policy"`
+	EnforcementLevel string `json:"enforcement_level"`
+	Result           string `json:"result"`
+	Output           string `json:"output,omitempty"`
+}
+
+type Options struct {
+	IO        *iolib.IOStreams
+	TFEClient func() (*tfc.Client, error)
+	Prompter  func() *cmdutil.Prompter
+
+	RunID    string
+	Override bool
+	Comment  string
+	Yes      bool
+	Format   string
+}
+
+func NewCmdPolicy(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:        f.IOStreams,
+		TFEClient: f.TFEClient,
+		Prompter:  f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "policy <RUN-ID>",
+		Short: "Show the policy results of a run",
+		Long: text.Heredoc(`
+			Show the result of the policies enforced on a run.
+
+			Sentinel policy checks and the policy evaluations of OPA and
+			agent-based Sentinel policy sets are shown with the enforcement
+			level, result and output of each policy.
+
+			Use --override with a --comment explaining why to override the
+			soft-mandatory failures holding the run. The comment is added to
+			the run.
+		`),
+		Example: text.Heredoc(`
+			# Show why a run failed its policies
+			$ tfc run policy run-abc123
+
+			# Override the soft-mandatory failures of a run
+			$ tfc run policy run-abc123 --override --comment "Approved by security, see SEC-42"
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddFormatFlag(cmd, &opts.Format)
+
+	cmd.Flags().BoolVar(&opts.Override, "override", false, "Override the soft-mandatory policy failures of the run")
+	cmd.Flags().StringVar(&opts.Comment, "comment", "", "Reason for the override")
+	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Override without asking for confirmation")
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, args []string) {
+	opts.RunID = args[0]
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := cmdutil.ValidateEnum("format", opts.Format, []string{cmdutil.FormatTable, cmdutil.FormatJSON}); err != nil {
+		return err
+	}
+
+	if opts.Override && opts.Comment == "" {
+		return fmt.Errorf("comment required: use --comment to explain the override")
+	}
+	if !opts.Override && opts.Comment != "" {
+		return fmt.Errorf("--comment can only be used with --override")
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	report, err := buildReport(ctx, client, opts.RunID)
+	if err != nil {
+		return err
+	}
+
+	if opts.Override {
+		return opts.override(ctx, client, report)
+	}
+
+	if opts.Format == cmdutil.FormatJSON {
+		return cmdutil.PrintJSON(opts.IO, report)
+	}
+
+	opts.displayReport(report)
+
+	return nil
+}
+
+// buildReport reads the policy checks and policy evaluations of a run.
+func buildReport(ctx context.Context, client *tfc.Client, runID string) (*Report, error) {
+	report := &Report{
+		RunID:       runID,
+		Checks:      []Check{},
+		Evaluations: []Evaluation{},
+	}
+
+	checks, err := client.PolicyChecks.List(ctx, runID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list policy checks for run %s: %w", runID, err)
+	}
+
+	for _, pc := range checks {
+		c, err := newCheck(pc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the result of policy check %s: %w", pc.ID, err)
+		}
+		report.Checks = append(report.Checks, c)
+	}
+
+	stages, err := client.TaskStages.List(ctx, runID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list task stages for run %s: %w", runID, err)
+	}
+
+	for _, ts := range stages {
+		if len(ts.PolicyEvaluations) == 0 {
+			continue
+		}
+
+		evaluations, err := client.PolicyEvaluations.List(ctx, ts.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list policy evaluations for the %s stage: %w", ts.Stage, err)
+		}
+
+		for _, pe := range evaluations {
+			outcomes, err := client.PolicyEvaluations.ListOutcomes(ctx, pe.ID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list the outcomes of policy evaluation %s: %w", pe.ID, err)
+			}
+
+			report.Evaluations = append(report.Evaluations, newEvaluation(ts, pe, outcomes))
+		}
+	}
+
+	return report, nil
+}
+
+func newCheck(pc *tfc.PolicyCheck) (Check, error) {
+	c := Check{
+		ID:          pc.ID,
+		Status:      string(pc.Status),
+		Overridable: pc.Status == tfe.PolicySoftFailed && pc.Actions != nil && pc.Actions.IsOverridable,
+		PolicySets:  []PolicySetResult{},
+	}
+
+	policies, err := tfc.SentinelPolicies(pc)
+	if err != nil {
+		return c, err
+	}
+
+	// Sentinel policies are named after their policy set, as in
+	// "set/policy".
+	for _, p := range policies {
+		if len(c.PolicySets) == 0 || c.PolicySets[len(c.PolicySets)-1].Name != p.PolicySet {
+			c.PolicySets = append(c.PolicySets, PolicySetResult{Name: p.PolicySet})
+		}
+		set := &c.PolicySets[len(c.PolicySets)-1]
+
+		result := "failed"
+		switch {
+		case p.Error != "":
+			result = "errored"
+		case p.Passed:
+			result = "passed"
+		}
+
+		output := p.Output
+		if p.Error != "" {
+			output = strings.TrimSpace(output + "\n" + p.Error)
+		}
+
+		set.Policies = append(set.Policies, PolicyResult{
+			Policy:           strings.TrimPrefix(p.Policy, p.PolicySet+"/"),
+			EnforcementLevel: p.EnforcementLevel,
+			Result:           result,
+			Output:           strings.TrimSpace(output),
+		})
+	}
+
+	return c, nil
+}
+
+func newEvaluation(ts *tfc.TaskStage, pe *tfc.PolicyEvaluation, outcomes []*tfc.PolicySetOutcome) Evaluation {
+	overridable := ts.Actions == nil || ts.Actions.IsOverridable != nil && *ts.Actions.IsOverridable
+
+	e := Evaluation{
+		ID:          pe.ID,
+		TaskStageID: ts.ID,
+		Stage:       string(ts.Stage),
+		Kind:        string(pe.PolicyKind),
+		Status:      string(pe.Status),
+		Overridable: ts.Status == tfe.TaskStageAwaitingOverride && overridable,
+		PolicySets:  []PolicySetResult{},
+	}
+
+	sort.Slice(outcomes, func(i, j int) bool { return outcomes[i].PolicySetName < outcomes[j].PolicySetName })
+
+	for _, o := range outcomes {
+		set := PolicySetResult{Name: o.PolicySetName, Error: o.Error}
+		for _, p := range o.Outcomes {
+			set.Policies = append(set.Policies, PolicyResult{
+				Policy:           p.PolicyName,
+				EnforcementLevel: string(p.EnforcementLevel),
+				Result:           p.Status,
+				Output:           formatOutput(p.Output),
+			})
+		}
+		e.PolicySets = append(e.PolicySets, set)
+	}
+
+	return e
+}
+
+// formatOutput formats the output of a policy, printed lines as text and
+// anything else as JSON.
+func formatOutput(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return strings.TrimSpace(v)
+	case []any:
+		var lines []string
+		for _, item := range v {
+			if m, ok := item.(map[string]any); ok && len(m) == 1 {
+				if p, ok := m["print"]; ok {
+					item = p
+				}
+			}
+			if line := formatOutput(item); line != "" {
+				lines = append(lines, line)
+			}
+		}
+		return strings.Join(lines, "\n")
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(b)
+	}
+}
+
+func (opts *Options) override(ctx context.Context, client *tfc.Client, report *Report) error {
+	var checks []Check
+	for _, c := range report.Checks {
+		if c.Overridable {
+			checks = append(checks, c)
+		}
+	}
+
+	// A task stage can have several policy evaluations, it's overridden as
+	// a whole.
+	var stages []Evaluation
+	seen := map[string]bool{}
+	for _, e := range report.Evaluations {
+		if e.Overridable && !seen[e.TaskStageID] {
+			seen[e.TaskStageID] = true
+			stages = append(stages, e)
+		}
+	}
+
+	if len(checks) == 0 && len(stages) == 0 {
+		return fmt.Errorf("run %s has no soft-mandatory policy failure to override", report.RunID)
+	}
+
+	if !opts.Yes {
+		prompter := opts.Prompter()
+		if !prompter.CanPrompt() {
+			return fmt.Errorf("confirmation required: use --yes to override without prompting")
+		}
+
+		ok, err := prompter.Confirm(fmt.Sprintf("Override the policy failures of run %s?", report.RunID))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Fprintln(opts.IO.Out, "Override cancelled")
+			return nil
+		}
+	}
+
+	var errs []error
+
+	if len(checks) > 0 {
+		// Policy checks don't take a comment, it's added to the run.
+		if err := client.Runs.AddComment(ctx, report.RunID, opts.Comment); err != nil {
+			return fmt.Errorf("failed to comment on run %s: %w", report.RunID, err)
+		}
+
+		for _, c := range checks {
+			if _, err := client.PolicyChecks.Override(ctx, c.ID); err != nil {
+				errs = append(errs, fmt.Errorf("failed to override policy check %s: %w", c.ID, err))
+				continue
+			}
+			fmt.Fprintf(opts.IO.Out, "Overrode policy check %s\n", c.ID)
+		}
+	}
+
+	for _, e := range stages {
+		if _, err := client.TaskStages.Override(ctx, e.TaskStageID, opts.Comment); err != nil {
+			errs = append(errs, fmt.Errorf("failed to override the %s policy evaluation: %w", e.Stage, err))
+			continue
+		}
+		fmt.Fprintf(opts.IO.Out, "Overrode the %s policy evaluation (%s)\n", e.Stage, e.TaskStageID)
+	}
+
+	return errors.Join(errs...)
+}
+
+func (opts *Options) displayReport(report *Report) {
+	out := opts.IO.Out
+
+	if len(report.Checks) == 0 && len(report.Evaluations) == 0 {
+		fmt.Fprintf(out, "No policy checks or evaluations for run %s\n", report.RunID)
+		return
+	}
+
+	first := true
+	section := func(title string) {
+		if !first {
+			fmt.Fprintln(out)
+		}
+		first = false
+		fmt.Fprintf(out, "%s\n", headerStyle.Render(title))
+	}
+
+	for _, c := range report.Checks {
+		section(fmt.Sprintf("POLICY CHECK %s", c.ID))
+		fmt.Fprintf(out, "  Kind:                 %s\n", tfe.Sentinel)
+		fmt.Fprintf(out, "  Status:               %s\n", c.Status)
+		fmt.Fprintf(out, "  Overridable:          %s\n", cmdutil.FormatBool(c.Overridable))
+		writePolicySets(out, c.PolicySets)
+	}
+
+	for _, e := range report.Evaluations {
+		section(fmt.Sprintf("POLICY EVALUATION %s", e.ID))
+		fmt.Fprintf(out, "  Kind:                 %s\n", e.Kind)
+		fmt.Fprintf(out, "  Stage:                %s\n", e.Stage)
+		fmt.Fprintf(out, "  Status:               %s\n", e.Status)
+		fmt.Fprintf(out, "  Overridable:          %s\n", cmdutil.FormatBool(e.Overridable))
+		writePolicySets(out, e.PolicySets)
+	}
+}
+
+// writePolicySets writes the result of each policy of the policy sets, with
+// its output indented below it.
+func writePolicySets(out io.Writer, sets []PolicySetResult) {
+	for _, set := range sets {
+		fmt.Fprintf(out, "\n  %s\n", set.Name)
+
+		if set.Error != "" {
+			fmt.Fprintf(out, "    error: %s\n", set.Error)
+		}
+
+		resultWidth, levelWidth := 0, 0
+		for _, p := range set.Policies {
+			resultWidth = max(resultWidth, len(p.Result))
+			levelWidth = max(levelWidth, len(p.EnforcementLevel))
+		}
+
+		for _, p := range set.Policies {
+			fmt.Fprintf(out, "    %s  %-*s  %s\n",
+				resultStyle(p.Result).Render(fmt.Sprintf("%-*s", resultWidth, p.Result)),
+				levelWidth, p.EnforcementLevel, p.Policy)
+
+			if p.Output == "" {
+				continue
+			}
+			for _, line := range strings.Split(p.Output, "\n") {
+				fmt.Fprintf(out, "      %s\n", faintStyle.Render(line))
+			}
+		}
+	}
+}
+
+var (
+	faintStyle  = lipgloss.NewStyle().Faint(true)
+	headerStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("6"))
+)
+
+// resultStyle returns the style of a policy result.
+func resultStyle(result string) lipgloss.Style {
+	switch result {
+	case "passed":
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("2"))
+	case "failed", "errored":
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("1"))
+	default:
+		return lipgloss.NewStyle()
+	}
+}
@@ -0,0 +1,289 @@
+package policy_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/zkhvan/tfc/cmd/tfc/run/policy"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+func TestPolicy(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/runs/run-1/policy-checks",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [{
+				"id": "polchk-1",
+				"type": "policy-checks",
+				"attributes": {
+					"status": "soft_failed",
+					"actions": {"is-overridable": true},
+					"result": {
+						"result": false,
+						"sentinel": {"data": {"security": {
+							"can-override": true,
+							"policies": [
+								{"policy": "security/restrict-instance-type", "result": false,
+									"trace": {"print": "Instance type m5.24xlarge is not allowed"}},
+								{"policy": "security/require-tags", "result": true, "allowed-failure": false}
+							]
+						}}}
+					}
+				}
+			}]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/runs/run-1/task-stages",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "ts-1", "type": "task-stages", "attributes": {"stage": "pre_plan", "status": "passed"}},
+				{"id": "ts-2", "type": "task-stages",
+					"attributes": {"stage": "post_plan", "status": "awaiting_override", "actions": {"is-overridable": true}},
+					"relationships": {"policy-evaluations": {"data": [{"id": "poleval-1", "type": "policy-evaluations"}]}}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/task-stages/ts-2/policy-evaluations",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "poleval-1", "type": "policy-evaluations", "attributes": {"status": "failed", "policy-kind": "opa"}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/policy-evaluations/poleval-1/policy-set-outcomes",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [{
+				"id": "psout-1",
+				"type": "policy-set-outcomes",
+				"attributes": {
+					"policy-set-name": "opa-guardrails",
+					"overridable": true,
+					"outcomes": [
+						{"policy_name": "deny-public-buckets", "enforcement_level": "mandatory", "status": "failed",
+							"output": [{"print": "bucket logs is public"}, {"resource": "aws_s3_bucket.logs"}]},
+						{"policy_name": "require-encryption", "enforcement_level": "advisory", "status": "passed"}
+					]
+				}
+			}]}`)
+		},
+	)
+
+	result := runCommand(t, client, "run-1")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		POLICY CHECK polchk-1
+		  Kind:                 sentinel
+		  Status:               soft_failed
+		  Overridable:          Yes
+
+		  security
+		    passed  soft-mandatory  require-tags
+		    failed  soft-mandatory  restrict-instance-type
+		      Instance type m5.24xlarge is not allowed
+
+		POLICY EVALUATION poleval-1
+		  Kind:                 opa
+		  Stage:                post_plan
+		  Status:               failed
+		  Overridable:          Yes
+
+		  opa-guardrails
+		    failed  mandatory  deny-public-buckets
+		      bucket logs is public
+		      {"resource":"aws_s3_bucket.logs"}
+		    passed  advisory   require-encryption
+	`))
+}
+
+func TestPolicy_none(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/runs/run-1/policy-checks",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": []}`)
+		},
+	)
+	mux.HandleFunc(
+		"GET /api/v2/runs/run-1/task-stages",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": []}`)
+		},
+	)
+
+	result := runCommand(t, client, "run-1")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, "No policy checks or evaluations for run run-1\n")
+}
+
+func TestPolicy_override(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/runs/run-1/policy-checks",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [{
+				"id": "polchk-1",
+				"type": "policy-checks",
+				"attributes": {
+					"status": "soft_failed",
+					"actions": {"is-overridable": true},
+					"result": {
+						"result": false,
+						"sentinel": {"data": {"security": {
+							"can-override": true,
+							"policies": [
+								{"policy": "security/restrict-instance-type", "result": false,
+									"trace": {"print": "Instance type m5.24xlarge is not allowed"}},
+								{"policy": "security/require-tags", "result": true, "allowed-failure": false}
+							]
+						}}}
+					}
+				}
+			}]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/runs/run-1/task-stages",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "ts-1", "type": "task-stages", "attributes": {"stage": "pre_plan", "status": "passed"}},
+				{"id": "ts-2", "type": "task-stages",
+					"attributes": {"stage": "post_plan", "status": "awaiting_override", "actions": {"is-overridable": true}},
+					"relationships": {"policy-evaluations": {"data": [{"id": "poleval-1", "type": "policy-evaluations"}]}}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/task-stages/ts-2/policy-evaluations",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "poleval-1", "type": "policy-evaluations", "attributes": {"status": "failed", "policy-kind": "opa"}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/policy-evaluations/poleval-1/policy-set-outcomes",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [{
+				"id": "psout-1",
+				"type": "policy-set-outcomes",
+				"attributes": {
+					"policy-set-name": "opa-guardrails",
+					"overridable": true,
+					"outcomes": [
+						{"policy_name": "deny-public-buckets", "enforcement_level": "mandatory", "status": "failed",
+							"output": [{"print": "bucket logs is public"}, {"resource": "aws_s3_bucket.logs"}]},
+						{"policy_name": "require-encryption", "enforcement_level": "advisory", "status": "passed"}
+					]
+				}
+			}]}`)
+		},
+	)
+
+	var comment, stageBody string
+	mux.HandleFunc(
+		"POST /api/v2/runs/run-1/comments",
+		func(w http.ResponseWriter, r *http.Request) {
+			b, _ := io.ReadAll(r.Body)
+			comment = string(b)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"data": {"id": "wsc-1", "type": "comments", "attributes": {"body": "Approved"}}}`)
+		},
+	)
+	mux.HandleFunc(
+		"POST /api/v2/policy-checks/polchk-1/actions/override",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "polchk-1", "type": "policy-checks", "attributes": {"status": "overridden"}}}`)
+		},
+	)
+	mux.HandleFunc(
+		"POST /api/v2/task-stages/ts-2/actions/override",
+		func(w http.ResponseWriter, r *http.Request) {
+			b, _ := io.ReadAll(r.Body)
+			stageBody = string(b)
+			fmt.Fprint(w, `{"data": {"id": "ts-2", "type": "task-stages", "attributes": {"status": "overridden"}}}`)
+		},
+	)
+
+	result := runCommand(t, client, "run-1", "--override", "--comment", "Approved", "--yes")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		Overrode policy check polchk-1
+		Overrode the post_plan policy evaluation (ts-2)
+	`))
+
+	if want := `"body":"Approved"`; !bytes.Contains([]byte(comment), []byte(want)) {
+		t.Errorf("got comment %s, want it to contain %s", comment, want)
+	}
+	if want := `{"comment":"Approved"}`; stageBody != want {
+		t.Errorf("got override body %s, want %s", stageBody, want)
+	}
+}
+
+func TestPolicy_override_without_comment(t *testing.T) {
+	client, _, teardown := tfetest.Setup()
+	defer teardown()
+
+	result := runCommand(t, client, "run-1", "--override", "--yes")
+
+	test.Buffer(t, result.ErrBuf, "comment required: use --comment to explain the override\n")
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+	}
+
+	cmd := policy.NewCmdPolicy(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
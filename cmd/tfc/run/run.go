@@ -4,7 +4,9 @@ import (
 	"github.com/spf13/cobra"
 
 	listCmd "github.com/zkhvan/tfc/cmd/tfc/run/list"
+	policyCmd "github.com/zkhvan/tfc/cmd/tfc/run/policy"
 	triggerCmd "github.com/zkhvan/tfc/cmd/tfc/run/trigger"
+	viewCmd "github.com/zkhvan/tfc/cmd/tfc/run/view"
 	"github.com/zkhvan/tfc/pkg/cmdutil"
 	"github.com/zkhvan/tfc/pkg/text"
 )
@@ -19,7 +21,9 @@ func NewCmdRun(f *cmdutil.Factory) *cobra.Command {
 	}
 
 	cmd.AddCommand(listCmd.NewCmdList(f))
+	cmd.AddCommand(policyCmd.NewCmdPolicy(f))
 	cmd.AddCommand(triggerCmd.NewCmdTrigger(f))
+	cmd.AddCommand(viewCmd.NewCmdView(f))
 
 	return cmd
 }
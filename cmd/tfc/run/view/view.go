@@ -0,0 +1,250 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+type Options struct {
+	IO        *iolib.IOStreams
+	TFEClient func() (*tfc.Client, error)
+	Clock     *cmdutil.Clock
+
+	RunID string
+}
+
+func NewCmdView(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:        f.IOStreams,
+		TFEClient: f.TFEClient,
+		Clock:     f.Clock,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view <RUN-ID>",
+		Short: "View run details",
+		Long: text.Heredoc(`
+			View detailed information about a run.
+
+			Displays the status and plan of the run, and the result of each
+			run task in the stages of the run, with the message and link
+			reported by the task.
+
+			Use "tfc run policy" for the result of the policies of the run.
+		`),
+		Example: text.Heredoc(`
+			$ tfc run view run-abc123
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, args []string) {
+	opts.RunID = args[0]
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	run, err := client.Runs.Read(ctx, opts.RunID)
+	if err != nil {
+		return fmt.Errorf("failed to read run %s: %w", opts.RunID, err)
+	}
+
+	stages, err := client.TaskStages.List(ctx, run.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list task stages for run %s: %w", run.ID, err)
+	}
+
+	// Listed task stages only reference their task results by ID.
+	for i, ts := range stages {
+		if len(ts.TaskResults) == 0 {
+			continue
+		}
+
+		stage, err := client.TaskStages.ReadWithResults(ctx, ts.ID)
+		if err != nil {
+			return fmt.Errorf("failed to read the %s stage of run %s: %w", ts.Stage, run.ID, err)
+		}
+		stages[i] = stage
+	}
+
+	opts.displayRun(run)
+	opts.displayPlan(run.Plan)
+	opts.displayTaskStages(run.ID, stages)
+
+	return nil
+}
+
+func (opts *Options) displayRun(run *tfc.Run) {
+	out := opts.IO.Out
+
+	fmt.Fprintf(out, "%s\n", headerStyle.Render("RUN"))
+	fmt.Fprintf(out, "  ID:                   %s\n", faintStyle.Render(run.ID))
+
+	if run.Workspace != nil {
+		fmt.Fprintf(out, "  Workspace:            %s\n", run.Workspace.Name)
+	}
+
+	status := lipgloss.NewStyle().Foreground(tfc.RunStatusColor(run.Status))
+	fmt.Fprintf(out, "  Status:               %s\n", status.Render(string(run.Status)))
+
+	if msg := strings.TrimSpace(run.Message); msg != "" {
+		// Only the first line of multiline commit messages.
+		if idx := strings.Index(msg, "\n"); idx != -1 {
+			msg = msg[:idx]
+		}
+		fmt.Fprintf(out, "  Message:              %s\n", msg)
+	}
+
+	if run.Source != "" {
+		fmt.Fprintf(out, "  Source:               %s\n", run.Source)
+	}
+
+	if run.TriggerReason != "" {
+		fmt.Fprintf(out, "  Trigger Reason:       %s\n", run.TriggerReason)
+	}
+
+	if run.CreatedBy != nil && run.CreatedBy.Username != "" {
+		fmt.Fprintf(out, "  Created By:           %s\n", run.CreatedBy.Username)
+	}
+
+	fmt.Fprintf(out, "  Created:              %s\n", text.RelativeTimeAgo(opts.Clock.Now(), run.CreatedAt))
+
+	var kind []string
+	if run.IsDestroy {
+		kind = append(kind, "destroy")
+	}
+	if run.PlanOnly {
+		kind = append(kind, "plan only")
+	}
+	if run.RefreshOnly {
+		kind = append(kind, "refresh only")
+	}
+	if len(kind) > 0 {
+		fmt.Fprintf(out, "  Kind:                 %s\n", strings.Join(kind, ", "))
+	}
+}
+
+func (opts *Options) displayPlan(plan *tfc.Plan) {
+	if plan == nil || plan.Status == "" {
+		return
+	}
+
+	out := opts.IO.Out
+
+	fmt.Fprintf(out, "\n%s\n", headerStyle.Render("PLAN"))
+	fmt.Fprintf(out, "  Status:               %s\n", plan.Status)
+
+	if !plan.HasChanges {
+		fmt.Fprintf(out, "  Changes:              %s\n", faintStyle.Render("none"))
+		return
+	}
+
+	changes := fmt.Sprintf("%d to add, %d to change, %d to destroy",
+		plan.ResourceAdditions, plan.ResourceChanges, plan.ResourceDestructions,
+	)
+	if plan.ResourceImports > 0 {
+		changes += fmt.Sprintf(", %d to import", plan.ResourceImports)
+	}
+	fmt.Fprintf(out, "  Changes:              %s\n", changes)
+}
+
+func (opts *Options) displayTaskStages(runID string, stages []*tfc.TaskStage) {
+	out := opts.IO.Out
+
+	fmt.Fprintf(out, "\n%s\n", headerStyle.Render("TASK STAGES"))
+	if len(stages) == 0 {
+		fmt.Fprintf(out, "  %s\n", faintStyle.Render("(none)"))
+		return
+	}
+
+	for i, ts := range stages {
+		if i > 0 {
+			fmt.Fprintln(out)
+		}
+
+		fmt.Fprintf(out, "  %s  %s\n", ts.Stage, statusStyle(string(ts.Status)).Render(string(ts.Status)))
+
+		if len(ts.TaskResults) == 0 && len(ts.PolicyEvaluations) == 0 {
+			fmt.Fprintf(out, "    %s\n", faintStyle.Render("(no run tasks)"))
+		}
+
+		writeTaskResults(out, ts.TaskResults)
+
+		if n := len(ts.PolicyEvaluations); n > 0 {
+			fmt.Fprintf(out, "    %s\n", faintStyle.Render(
+				fmt.Sprintf("%d policy evaluation(s), see: tfc run policy %s", n, runID),
+			))
+		}
+	}
+}
+
+// writeTaskResults writes the status of each run task with its message and
+// link indented below it.
+func writeTaskResults(out io.Writer, results []*tfc.TaskResult) {
+	nameWidth, statusWidth := 0, 0
+	for _, r := range results {
+		nameWidth = max(nameWidth, len(r.TaskName))
+		statusWidth = max(statusWidth, len(r.Status))
+	}
+
+	for _, r := range results {
+		fmt.Fprintf(out, "    %-*s  %s  %s\n",
+			nameWidth, r.TaskName,
+			statusStyle(string(r.Status)).Render(fmt.Sprintf("%-*s", statusWidth, r.Status)),
+			r.WorkspaceTaskEnforcementLevel,
+		)
+
+		if msg := strings.TrimSpace(r.Message); msg != "" {
+			for _, line := range strings.Split(msg, "\n") {
+				fmt.Fprintf(out, "      %s\n", line)
+			}
+		}
+
+		if r.URL != "" {
+			fmt.Fprintf(out, "      %s\n", faintStyle.Render(r.URL))
+		}
+	}
+}
+
+var (
+	faintStyle  = lipgloss.NewStyle().Faint(true)
+	headerStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("6"))
+)
+
+// statusStyle returns the style of a task stage or task result status.
+func statusStyle(status string) lipgloss.Style {
+	switch status {
+	case "passed", "overridden":
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("2"))
+	case "failed", "errored", "unreachable", "awaiting_override":
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("1"))
+	case "pending", "running":
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("4"))
+	default:
+		return lipgloss.NewStyle()
+	}
+}
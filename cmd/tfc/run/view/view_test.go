@@ -0,0 +1,168 @@
+package view_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/zkhvan/tfc/cmd/tfc/run/view"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/clock"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+var referenceTime = time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+func TestView(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/runs/run-1",
+		func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("include"); got != "workspace,plan,created_by" {
+				t.Errorf("got include %q", got)
+			}
+
+			fmt.Fprint(w, `{
+				"data": {
+					"id": "run-1",
+					"type": "runs",
+					"attributes": {
+						"status": "post_plan_completed",
+						"message": "Add the logs bucket\n\nLong description",
+						"source": "tfe-api",
+						"trigger-reason": "manual",
+						"created-at": "2000-01-01T11:55:00Z"
+					},
+					"relationships": {
+						"workspace": {"data": {"id": "ws-1", "type": "workspaces"}},
+						"plan": {"data": {"id": "plan-1", "type": "plans"}},
+						"created-by": {"data": {"id": "user-1", "type": "users"}}
+					}
+				},
+				"included": [
+					{"id": "ws-1", "type": "workspaces", "attributes": {"name": "network"}},
+					{"id": "plan-1", "type": "plans", "attributes": {
+						"status": "finished", "has-changes": true,
+						"resource-additions": 2, "resource-changes": 1, "resource-destructions": 0}},
+					{"id": "user-1", "type": "users", "attributes": {"username": "alice"}}
+				]
+			}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/runs/run-1/task-stages",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "ts-1", "type": "task-stages", "attributes": {"stage": "pre_plan", "status": "passed"}},
+				{"id": "ts-2", "type": "task-stages", "attributes": {"stage": "post_plan", "status": "failed"},
+					"relationships": {
+						"task-results": {"data": [
+							{"id": "taskrs-1", "type": "task-results"},
+							{"id": "taskrs-2", "type": "task-results"}
+						]},
+						"policy-evaluations": {"data": [{"id": "poleval-1", "type": "policy-evaluations"}]}
+					}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/task-stages/ts-2",
+		func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("include"); got != "task_results" {
+				t.Errorf("got include %q", got)
+			}
+
+			fmt.Fprint(w, `{
+				"data": {"id": "ts-2", "type": "task-stages", "attributes": {"stage": "post_plan", "status": "failed"},
+					"relationships": {
+						"task-results": {"data": [
+							{"id": "taskrs-1", "type": "task-results"},
+							{"id": "taskrs-2", "type": "task-results"}
+						]},
+						"policy-evaluations": {"data": [{"id": "poleval-1", "type": "policy-evaluations"}]}
+					}},
+				"included": [
+					{"id": "taskrs-1", "type": "task-results", "attributes": {
+						"task-name": "snyk", "status": "failed", "workspace-task-enforcement-level": "mandatory",
+						"message": "2 high severity issues", "url": "https://app.snyk.io/run/1"}},
+					{"id": "taskrs-2", "type": "task-results", "attributes": {
+						"task-name": "cost-check", "status": "passed", "workspace-task-enforcement-level": "advisory"}}
+				]
+			}`)
+		},
+	)
+
+	result := runCommand(t, client, "run-1")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		RUN
+		  ID:                   run-1
+		  Workspace:            network
+		  Status:               post_plan_completed
+		  Message:              Add the logs bucket
+		  Source:               tfe-api
+		  Trigger Reason:       manual
+		  Created By:           alice
+		  Created:              about 5 minutes ago
+
+		PLAN
+		  Status:               finished
+		  Changes:              2 to add, 1 to change, 0 to destroy
+
+		TASK STAGES
+		  pre_plan  passed
+		    (no run tasks)
+
+		  post_plan  failed
+		    snyk        failed  mandatory
+		      2 high severity issues
+		      https://app.snyk.io/run/1
+		    cost-check  passed  advisory
+		    1 policy evaluation(s), see: tfc run policy run-1
+	`))
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+		Clock:           cmdutil.NewClock(clock.FrozenClock(referenceTime)),
+	}
+
+	cmd := view.NewCmdView(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
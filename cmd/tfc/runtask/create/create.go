@@ -0,0 +1,124 @@
+package create
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/ptr"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	Org         string
+	Name        string
+	URL         string
+	Description string
+	HMACKey     string
+	AgentPool   string
+	Disabled    bool
+}
+
+func NewCmdCreate(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create <NAME>",
+		Short: "Create a run task",
+		Long: text.Heredoc(`
+			Create a run task calling an external service.
+
+			The service receives a payload signed with --hmac-key, when set,
+			and reports its result back to the run.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Create a run task
+			$ tfc run-tasks create snyk --org myorg --url https://api.snyk.io/v1/tfc --hmac-key s3cr3t
+
+			# Create a run task called from an agent pool
+			$ tfc run-tasks create scanner --org myorg --url http://scanner.internal --agent-pool on-prem
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+
+	cmd.Flags().StringVar(&opts.URL, "url", "", "URL the run task payload is sent to")
+	cmd.Flags().StringVarP(&opts.Description, "description", "d", "", "Description of the run task")
+	cmd.Flags().StringVar(&opts.HMACKey, "hmac-key", "", "Key to sign the run task payload with")
+	cmd.Flags().StringVar(&opts.AgentPool, "agent-pool", "", "Agent pool name or ID to call the service from")
+	cmd.Flags().BoolVar(&opts.Disabled, "disabled", false, "Create the run task disabled")
+
+	_ = cmd.MarkFlagRequired("url")
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, args []string) {
+	opts.Name = args[0]
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if opts.Org == "" {
+		return cmdutil.ErrOrgRequired
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	o := tfe.RunTaskCreateOptions{
+		Name:     opts.Name,
+		URL:      opts.URL,
+		Category: "task",
+		Enabled:  ptr.Bool(!opts.Disabled),
+	}
+	if opts.Description != "" {
+		o.Description = ptr.String(opts.Description)
+	}
+	if opts.HMACKey != "" {
+		o.HMACKey = ptr.String(opts.HMACKey)
+	}
+
+	if opts.AgentPool != "" {
+		pool, err := client.AgentPools.ReadByNameOrID(ctx, opts.Org, opts.AgentPool)
+		if err != nil {
+			return fmt.Errorf("failed to read agent pool %s: %w", opts.AgentPool, err)
+		}
+		o.AgentPool = &tfe.AgentPool{ID: pool.ID}
+	}
+
+	task, err := client.RunTasks.Create(ctx, opts.Org, o)
+	if err != nil {
+		return fmt.Errorf("failed to create run task %s: %w", opts.Name, err)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Created run task %s (%s)\n", task.Name, task.ID)
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package delete
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+	Prompter        func() *cmdutil.Prompter
+
+	Org        string
+	Identifier string // Run task name or ID
+	Yes        bool
+}
+
+func NewCmdDelete(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+		Prompter:        f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete <NAME|ID>",
+		Short: "Delete a run task",
+		Long: text.Heredoc(`
+			Delete a run task, detaching it from every workspace.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Delete a run task by name
+			$ tfc run-tasks delete snyk --org myorg
+
+			# Delete a run task by ID without confirmation
+			$ tfc run-tasks delete task-abc123 --yes
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+
+	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Delete the run task without asking for confirmation")
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, args []string) {
+	opts.Identifier = args[0]
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	task, err := client.RunTasks.ReadByNameOrID(ctx, opts.Org, opts.Identifier)
+	if err != nil {
+		return fmt.Errorf("failed to read run task %s: %w", opts.Identifier, err)
+	}
+
+	if !opts.Yes {
+		prompter := opts.Prompter()
+		if !prompter.CanPrompt() {
+			return fmt.Errorf("confirmation required: use --yes to delete the run task without prompting")
+		}
+
+		msg := fmt.Sprintf("Delete run task %s (%s)?", task.Name, task.ID)
+		if n := len(task.WorkspaceRunTasks); n > 0 {
+			msg = fmt.Sprintf("Delete run task %s (%s), attached to %d workspace(s)?", task.Name, task.ID, n)
+		}
+
+		ok, err := prompter.Confirm(msg)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Fprintln(opts.IO.Out, "Delete cancelled")
+			return nil
+		}
+	}
+
+	if err := client.RunTasks.Delete(ctx, task.ID); err != nil {
+		return fmt.Errorf("failed to delete run task %s: %w", task.Name, err)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Deleted run task %s (%s)\n", task.Name, task.ID)
+
+	return nil
+}
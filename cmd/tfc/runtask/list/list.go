@@ -0,0 +1,138 @@
+package list
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+const (
+	ColumnID          string = "ID"
+	ColumnName        string = "NAME"
+	ColumnURL         string = "URL"
+	ColumnEnabled     string = "ENABLED"
+	ColumnWorkspaces  string = "WORKSPACES"
+	ColumnDescription string = "DESCRIPTION"
+	ColumnAgentPool   string = "AGENT_POOL"
+)
+
+var (
+	ColumnsDefault = []string{
+		ColumnName,
+		ColumnEnabled,
+		ColumnWorkspaces,
+		ColumnURL,
+	}
+	ColumnsAll = []string{
+		ColumnID,
+		ColumnName,
+		ColumnURL,
+		ColumnEnabled,
+		ColumnWorkspaces,
+		ColumnDescription,
+		ColumnAgentPool,
+	}
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	Org     string
+	Limit   int
+	Columns []string
+}
+
+func NewCmdList(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List run tasks",
+		Aliases: []string{"ls"},
+		Long: text.Heredoc(`
+			List the run tasks of an organization, with the number of
+			workspaces each one is attached to.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "l", 20, "Limit the number of results.")
+	_ = cmdutil.FlagStringEnumSliceP(cmd, &opts.Columns, "columns", "c", ColumnsDefault, "Columns to show.", ColumnsAll)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, _ []string) {
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if opts.Org == "" {
+		return cmdutil.ErrOrgRequired
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	tasks, pagination, err := client.RunTasks.List(ctx, opts.Org, &tfc.RunTaskListOptions{
+		ListOptions: tfc.ListOptions{Limit: opts.Limit},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list run tasks for %s: %w", opts.Org, err)
+	}
+
+	if pagination.ReachedLimit {
+		fmt.Fprintf(opts.IO.Out, "Showing top %d results\n\n", opts.Limit)
+	}
+
+	p := cmdutil.FieldPrinter(opts.IO, opts.Columns...)
+	for _, t := range tasks {
+		p.Write(extractFields(t))
+	}
+	p.Flush()
+
+	return nil
+}
+
+func extractFields(t *tfc.RunTask) map[string]string {
+	pool := ""
+	if t.AgentPool != nil {
+		pool = t.AgentPool.ID
+	}
+
+	return map[string]string{
+		ColumnID:          t.ID,
+		ColumnName:        t.Name,
+		ColumnURL:         t.URL,
+		ColumnEnabled:     strconv.FormatBool(t.Enabled),
+		ColumnWorkspaces:  strconv.Itoa(len(t.WorkspaceRunTasks)),
+		ColumnDescription: t.Description,
+		ColumnAgentPool:   pool,
+	}
+}
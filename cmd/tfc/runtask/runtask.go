@@ -0,0 +1,32 @@
+package runtask
+
+import (
+	"github.com/spf13/cobra"
+
+	createCmd "github.com/zkhvan/tfc/cmd/tfc/runtask/create"
+	deleteCmd "github.com/zkhvan/tfc/cmd/tfc/runtask/delete"
+	listCmd "github.com/zkhvan/tfc/cmd/tfc/runtask/list"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+func NewCmdRunTask(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "run-tasks",
+		Aliases: []string{"run-task"},
+		Short:   "Manage run tasks",
+		Long: text.Heredoc(`
+			Manage the run tasks of an organization.
+
+			A run task calls an external service before the plan, after the
+			plan or before the apply of a run, and can block the run. Attach
+			a run task to a workspace with "tfc workspaces run-tasks attach".
+		`),
+	}
+
+	cmd.AddCommand(listCmd.NewCmdList(f))
+	cmd.AddCommand(createCmd.NewCmdCreate(f))
+	cmd.AddCommand(deleteCmd.NewCmdDelete(f))
+
+	return cmd
+}
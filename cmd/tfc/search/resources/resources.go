@@ -0,0 +1,196 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/spf13/cobra"
+
+	wsResources "github.com/zkhvan/tfc/cmd/tfc/workspace/resources"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/parallel"
+	"github.com/zkhvan/tfc/pkg/pattern"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+const (
+	ColumnOrg       string = "ORG"
+	ColumnWorkspace string = "WORKSPACE"
+)
+
+var (
+	ColumnsDefault = []string{
+		ColumnOrg,
+		ColumnWorkspace,
+		wsResources.ColumnAddress,
+		wsResources.ColumnProvider,
+		wsResources.ColumnModifiedAt,
+	}
+	ColumnsAll = append(
+		[]string{ColumnOrg, ColumnWorkspace},
+		wsResources.ColumnsAll...,
+	)
+)
+
+type Options struct {
+	IO        *iolib.IOStreams
+	TFEClient func() (*tfc.Client, error)
+	Clock     *cmdutil.Clock
+
+	Filter      cmdutil.WorkspaceFilter
+	Pattern     string
+	Regex       bool
+	Limit       int
+	Concurrency int
+	Columns     []string
+	Format      string
+}
+
+func NewCmdResources(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:        f.IOStreams,
+		TFEClient: f.TFEClient,
+		Clock:     f.Clock,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "resources <pattern>",
+		Short: "Search managed resources across workspaces",
+		Long: text.Heredoc(`
+			Search the managed resources of every matching workspace.
+
+			The pattern is matched against each resource's address and type.
+			A plain pattern matches any address or type containing it, while a
+			pattern with glob characters (*, ?) must match the whole address or
+			type. Use --regex to match with a regular expression instead.
+		`),
+		Example: text.Heredoc(`
+			# Find the workspaces that manage an IAM role named "deployer"
+			$ tfc search resources 'aws_iam_role.deployer' --org myorg
+
+			# Find every IAM role in workspaces tagged "prod"
+			$ tfc search resources 'aws_iam_role' --org myorg --tags prod
+
+			# Output the matches as JSON
+			$ tfc search resources '*.aws_s3_bucket.*' --org myorg --format json
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceFilterFlags(cmd, &opts.Filter)
+	cmdutil.AddFormatFlag(cmd, &opts.Format)
+
+	cmd.Flags().BoolVar(&opts.Regex, "regex", false, "Treat the pattern as a regular expression.")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "l", 100, "Limit the number of workspaces searched per organization.")
+	cmd.Flags().IntVar(&opts.Concurrency, "concurrency", parallel.DefaultConcurrency,
+		"Number of workspaces to search at once.",
+	)
+	_ = cmdutil.FlagStringEnumSliceP(cmd, &opts.Columns, "columns", "c", ColumnsDefault, "Columns to show.", ColumnsAll)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, args []string) {
+	opts.Pattern = args[0]
+	opts.Filter.Complete()
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := cmdutil.ValidateEnum("format", opts.Format, []string{cmdutil.FormatTable, cmdutil.FormatJSON}); err != nil {
+		return err
+	}
+
+	p, err := pattern.Compile(opts.Pattern, opts.Regex)
+	if err != nil {
+		return err
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	workspaces, truncated, err := opts.Filter.Workspaces(ctx, client, opts.Limit)
+	if err != nil {
+		if len(workspaces) == 0 {
+			return err
+		}
+		errs = append(errs, err)
+	}
+
+	for _, org := range truncated {
+		if opts.Format == cmdutil.FormatJSON {
+			fmt.Fprintf(opts.IO.ErrOut, "Warning: only the top %d workspaces for org %q were searched\n", opts.Limit, org)
+		} else {
+			fmt.Fprintf(opts.IO.Out, "Showing results for the top %d workspaces for org %q\n\n", opts.Limit, org)
+		}
+	}
+
+	results, fetchErrs := parallel.Map(ctx, workspaces, opts.Concurrency,
+		func(ctx context.Context, ws *tfc.Workspace) ([]*tfc.WorkspaceResource, error) {
+			resources, _, err := client.WorkspaceResources.List(ctx, ws.ID, &tfc.WorkspaceResourceListOptions{
+				ListOptions: tfc.ListOptions{Limit: math.MaxInt},
+			})
+			return resources, err
+		},
+	)
+
+	var matches []wsResources.Resource
+	fp := cmdutil.FieldPrinter(opts.IO, opts.Columns...)
+	for i, ws := range workspaces {
+		if fetchErrs[i] != nil {
+			errs = append(errs, fmt.Errorf("error listing resources for %q: %w", ws.Name, fetchErrs[i]))
+			continue
+		}
+
+		org := ""
+		if ws.Organization != nil {
+			org = ws.Organization.Name
+		}
+
+		for _, r := range results[i] {
+			if !p.MatchString(r.Address) && !p.MatchString(r.ProviderType) {
+				continue
+			}
+
+			if opts.Format == cmdutil.FormatJSON {
+				m := wsResources.NewResource(r)
+				m.Organization = org
+				m.Workspace = ws.Name
+				matches = append(matches, m)
+				continue
+			}
+
+			fields := wsResources.ExtractFields(opts.Clock, r)
+			fields[ColumnOrg] = org
+			fields[ColumnWorkspace] = ws.Name
+			fp.Write(fields)
+		}
+	}
+
+	if opts.Format == cmdutil.FormatJSON {
+		if matches == nil {
+			matches = []wsResources.Resource{}
+		}
+		if err := cmdutil.PrintJSON(opts.IO, matches); err != nil {
+			return err
+		}
+	} else {
+		fp.Flush()
+	}
+
+	return errors.Join(errs...)
+}
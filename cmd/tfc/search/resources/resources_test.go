@@ -0,0 +1,238 @@
+package resources_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/zkhvan/tfc/cmd/tfc/search/resources"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/clock"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+func TestSearchResources(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"o","type":"organizations","attributes":{"name":"o"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprintf(w, `{"data":[%s,%s],"meta":{"pagination":{"current-page":1,"total-pages":1,"total-count":2}}}`,
+				testWorkspace("ws-a"), testWorkspace("ws-b"),
+			)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/resources",
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.PathValue("workspace_id") {
+			case "ws-a":
+				fmt.Fprintf(w, `{"data":[%s,%s]}`,
+					testResource("wsr-1", "aws_iam_role.deployer", "aws_iam_role", "deployer", "root"),
+					testResource("wsr-2", "aws_s3_bucket.logs", "aws_s3_bucket", "logs", "root"),
+				)
+			case "ws-b":
+				fmt.Fprintf(w, `{"data":[%s]}`,
+					testResource("wsr-3", "module.x.aws_iam_role.r", "aws_iam_role", "r", "x"),
+				)
+			}
+		},
+	)
+
+	result := runCommand(t, client, "aws_iam_role", "--org", "o")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		ORG  WORKSPACE  ADDRESS                  PROVIDER       MODIFIED_AT
+		o    ws-a       aws_iam_role.deployer    hashicorp/aws  about 1 day ago
+		o    ws-b       module.x.aws_iam_role.r  hashicorp/aws  about 1 day ago
+	`))
+}
+
+func TestSearchResources_limit(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"o","type":"organizations","attributes":{"name":"o"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprintf(w, `{"data":[%s,%s],"meta":{"pagination":{"current-page":1,"total-pages":1,"total-count":2}}}`,
+				testWorkspace("ws-a"), testWorkspace("ws-b"),
+			)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/resources",
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.PathValue("workspace_id") {
+			case "ws-a":
+				fmt.Fprintf(w, `{"data":[%s,%s]}`,
+					testResource("wsr-1", "aws_iam_role.deployer", "aws_iam_role", "deployer", "root"),
+					testResource("wsr-2", "aws_s3_bucket.logs", "aws_s3_bucket", "logs", "root"),
+				)
+			case "ws-b":
+				fmt.Fprintf(w, `{"data":[%s]}`,
+					testResource("wsr-3", "module.x.aws_iam_role.r", "aws_iam_role", "r", "x"),
+				)
+			}
+		},
+	)
+
+	result := runCommand(t, client, "aws_iam_role", "--org", "o", "--limit", "1")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		Showing results for the top 1 workspaces for org "o"
+
+		ORG  WORKSPACE  ADDRESS                PROVIDER       MODIFIED_AT
+		o    ws-a       aws_iam_role.deployer  hashicorp/aws  about 1 day ago
+	`))
+}
+
+func TestSearchResources_json(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"o","type":"organizations","attributes":{"name":"o"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprintf(w, `{"data":[%s,%s],"meta":{"pagination":{"current-page":1,"total-pages":1,"total-count":2}}}`,
+				testWorkspace("ws-a"), testWorkspace("ws-b"),
+			)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/resources",
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.PathValue("workspace_id") {
+			case "ws-a":
+				fmt.Fprintf(w, `{"data":[%s,%s]}`,
+					testResource("wsr-1", "aws_iam_role.deployer", "aws_iam_role", "deployer", "root"),
+					testResource("wsr-2", "aws_s3_bucket.logs", "aws_s3_bucket", "logs", "root"),
+				)
+			case "ws-b":
+				fmt.Fprintf(w, `{"data":[%s]}`,
+					testResource("wsr-3", "module.x.aws_iam_role.r", "aws_iam_role", "r", "x"),
+				)
+			}
+		},
+	)
+
+	result := runCommand(t, client, "aws_iam_role.*", "--org", "o", "--format", "json")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		[
+		  {
+		    "organization": "o",
+		    "workspace": "ws-a",
+		    "id": "wsr-1",
+		    "address": "aws_iam_role.deployer",
+		    "type": "aws_iam_role",
+		    "name": "deployer",
+		    "provider": "hashicorp/aws",
+		    "module": "root",
+		    "created_at": "1999-12-31T12:00:00Z",
+		    "modified_at": "1999-12-31T12:00:00Z"
+		  }
+		]
+	`))
+}
+
+func testWorkspace(name string) string {
+	return text.Heredocf(`
+		{
+			"id": "%[1]s",
+			"type": "workspaces",
+			"attributes": {"name": "%[1]s"},
+			"relationships": {
+				"organization": {"data": {"id": "o", "type": "organizations"}}
+			}
+		}
+	`, name)
+}
+
+func testResource(id, address, typ, name, module string) string {
+	return text.Heredocf(`
+		{
+			"id": "%s",
+			"type": "resources",
+			"attributes": {
+				"address": "%s",
+				"provider-type": "%s",
+				"name": "%s",
+				"module": "%s",
+				"provider": "hashicorp/aws",
+				"created-at": "1999-12-31T12:00:00Z",
+				"updated-at": "1999-12-31T12:00:00Z"
+			}
+		}
+	`, id, address, typ, name, module)
+}
+
+var (
+	referenceTime = time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC)
+)
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams: ios,
+		TFEClient: func() (*tfc.Client, error) { return client, nil },
+		Clock:     cmdutil.NewClock(clock.FrozenClock(referenceTime)),
+	}
+
+	cmd := resources.NewCmdResources(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
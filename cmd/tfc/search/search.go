@@ -0,0 +1,28 @@
+package search
+
+import (
+	"github.com/spf13/cobra"
+
+	resourcesCmd "github.com/zkhvan/tfc/cmd/tfc/search/resources"
+	variablesCmd "github.com/zkhvan/tfc/cmd/tfc/search/variables"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+func NewCmdSearch(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "search",
+		Short: "Search across workspaces",
+		Long: text.Heredoc(`
+			Search across workspaces.
+
+			The workspaces to search are selected with the same filters as
+			"tfc workspaces list".
+		`),
+	}
+
+	cmd.AddCommand(resourcesCmd.NewCmdResources(f))
+	cmd.AddCommand(variablesCmd.NewCmdVariables(f))
+
+	return cmd
+}
@@ -0,0 +1,386 @@
+package variables
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/parallel"
+	"github.com/zkhvan/tfc/pkg/pattern"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+const (
+	ColumnOrg       string = "ORG"
+	ColumnWorkspace string = "WORKSPACE"
+	ColumnID        string = "ID"
+	ColumnKey       string = "KEY"
+	ColumnCategory  string = "CATEGORY"
+	ColumnValue     string = "VALUE"
+	ColumnSensitive string = "SENSITIVE"
+	ColumnHCL       string = "HCL"
+	ColumnSource    string = "SOURCE"
+)
+
+// SensitiveValue is shown instead of the value of sensitive variables, which
+// can't be read back.
+const SensitiveValue = "(sensitive)"
+
+var (
+	ColumnsDefault = []string{
+		ColumnOrg,
+		ColumnWorkspace,
+		ColumnKey,
+		ColumnCategory,
+		ColumnValue,
+		ColumnSource,
+	}
+	ColumnsAll = []string{
+		ColumnOrg,
+		ColumnWorkspace,
+		ColumnID,
+		ColumnKey,
+		ColumnCategory,
+		ColumnValue,
+		ColumnSensitive,
+		ColumnHCL,
+		ColumnSource,
+	}
+)
+
+// Match is a variable matching the search, defined either by a workspace or
+// by a variable set applied to some of the searched workspaces.
+type Match struct {
+	Organization string   `json:"organization,omitempty"`
+	Workspace    string   `json:"workspace,omitempty"`
+	Workspaces   []string `json:"workspaces,omitempty"`
+	VarSet       string   `json:"varset,omitempty"`
+	ID           string   `json:"id"`
+	Key          string   `json:"key"`
+	Category     string   `json:"category"`
+	Value        string   `json:"value,omitempty"`
+	Sensitive    bool     `json:"sensitive"`
+	HCL          bool     `json:"hcl"`
+	Source       string   `json:"source"`
+}
+
+type Options struct {
+	IO        *iolib.IOStreams
+	TFEClient func() (*tfc.Client, error)
+
+	Filter       cmdutil.WorkspaceFilter
+	Pattern      string
+	ValuePattern string
+	Regex        bool
+	NoVarSets    bool
+	Limit        int
+	Concurrency  int
+	Columns      []string
+	Format       string
+}
+
+func NewCmdVariables(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:        f.IOStreams,
+		TFEClient: f.TFEClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "variables <key-pattern>",
+		Short:   "Search variables across workspaces and variable sets",
+		Aliases: []string{"vars"},
+		Long: text.Heredoc(`
+			Search the variables of every matching workspace and of the
+			variable sets applied to them.
+
+			The pattern is matched against each variable's key, and
+			--value-pattern against its value. A plain pattern matches any key
+			or value containing it, while a pattern with glob characters (*, ?)
+			must match the whole key or value. Use --regex to match both with
+			regular expressions instead.
+
+			Values of sensitive variables can't be read back, they're masked
+			and never match --value-pattern.
+
+			A variable set is reported once, with the searched workspaces it's
+			applied to. Use --no-varsets to only search workspace variables.
+		`),
+		Example: text.Heredoc(`
+			# Find where AWS_ROLE_ARN is set
+			$ tfc search variables AWS_ROLE_ARN --org myorg
+
+			# Find the variables still pointing to the old account
+			$ tfc search variables '*' --org myorg --value-pattern 123456789012
+
+			# Find the AWS variables of production workspaces with a regex
+			$ tfc search variables '^AWS_' --regex --org myorg --tags prod
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceFilterFlags(cmd, &opts.Filter)
+	cmdutil.AddFormatFlag(cmd, &opts.Format)
+
+	cmd.Flags().StringVar(&opts.ValuePattern, "value-pattern", "", "Only show variables whose value matches the pattern.")
+	cmd.Flags().BoolVar(&opts.Regex, "regex", false, "Treat the patterns as regular expressions.")
+	cmd.Flags().BoolVar(&opts.NoVarSets, "no-varsets", false, "Don't search the variable sets applied to the workspaces.")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "l", 100, "Limit the number of workspaces searched per organization.")
+	cmd.Flags().IntVar(&opts.Concurrency, "concurrency", parallel.DefaultConcurrency,
+		"Number of workspaces to search at once.",
+	)
+	_ = cmdutil.FlagStringEnumSliceP(cmd, &opts.Columns, "columns", "c", ColumnsDefault, "Columns to show.", ColumnsAll)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, args []string) {
+	opts.Pattern = args[0]
+	opts.Filter.Complete()
+}
+
+// workspaceResult holds what's fetched for a workspace.
+type workspaceResult struct {
+	vars []*tfc.Variable
+	sets []*tfc.VariableSet
+}
+
+// varSet is a variable set applied to some of the searched workspaces.
+type varSet struct {
+	*tfc.VariableSet
+
+	org        string
+	workspaces []string
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := cmdutil.ValidateEnum("format", opts.Format, []string{cmdutil.FormatTable, cmdutil.FormatJSON}); err != nil {
+		return err
+	}
+
+	keyPattern, err := pattern.Compile(opts.Pattern, opts.Regex)
+	if err != nil {
+		return err
+	}
+
+	var valuePattern *pattern.Pattern
+	if opts.ValuePattern != "" {
+		valuePattern, err = pattern.Compile(opts.ValuePattern, opts.Regex)
+		if err != nil {
+			return err
+		}
+	}
+
+	matches := func(key, value string, sensitive bool) bool {
+		if !keyPattern.MatchString(key) {
+			return false
+		}
+		if valuePattern == nil {
+			return true
+		}
+		return !sensitive && valuePattern.MatchString(value)
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	workspaces, truncated, err := opts.Filter.Workspaces(ctx, client, opts.Limit)
+	if err != nil {
+		if len(workspaces) == 0 {
+			return err
+		}
+		errs = append(errs, err)
+	}
+
+	for _, org := range truncated {
+		if opts.Format == cmdutil.FormatJSON {
+			fmt.Fprintf(opts.IO.ErrOut, "Warning: only the top %d workspaces for org %q were searched\n", opts.Limit, org)
+		} else {
+			fmt.Fprintf(opts.IO.Out, "Showing results for the top %d workspaces for org %q\n\n", opts.Limit, org)
+		}
+	}
+
+	results, fetchErrs := parallel.Map(ctx, workspaces, opts.Concurrency,
+		func(ctx context.Context, ws *tfc.Workspace) (workspaceResult, error) {
+			var r workspaceResult
+
+			vars, err := client.Variables.ListAll(ctx, ws.ID)
+			if err != nil {
+				return r, err
+			}
+			r.vars = vars
+
+			if opts.NoVarSets {
+				return r, nil
+			}
+
+			sets, _, err := client.VariableSets.ListForWorkspace(ctx, ws.ID, &tfc.VariableSetListOptions{
+				ListOptions: tfc.ListOptions{Limit: math.MaxInt},
+			})
+			if err != nil {
+				return r, err
+			}
+			r.sets = sets
+
+			return r, nil
+		},
+	)
+
+	var (
+		found   []Match
+		sets    []*varSet
+		setByID = make(map[string]*varSet)
+	)
+	for i, ws := range workspaces {
+		if fetchErrs[i] != nil {
+			errs = append(errs, fmt.Errorf("error listing variables for %q: %w", ws.Name, fetchErrs[i]))
+			continue
+		}
+
+		org := ""
+		if ws.Organization != nil {
+			org = ws.Organization.Name
+		}
+
+		for _, v := range results[i].vars {
+			if !matches(v.Key, v.Value, v.Sensitive) {
+				continue
+			}
+
+			found = append(found, newMatch(org, v.ID, v.Key, v.Category, v.Value, v.Sensitive, v.HCL, Match{
+				Workspace: ws.Name,
+				Source:    "workspace",
+			}))
+		}
+
+		for _, vs := range results[i].sets {
+			s, ok := setByID[vs.ID]
+			if !ok {
+				s = &varSet{VariableSet: vs, org: org}
+				setByID[vs.ID] = s
+				sets = append(sets, s)
+			}
+			s.workspaces = append(s.workspaces, ws.Name)
+		}
+	}
+
+	slices.SortFunc(sets, func(a, b *varSet) int {
+		return cmp.Or(cmp.Compare(a.org, b.org), cmp.Compare(a.Name, b.Name))
+	})
+
+	setVars, fetchErrs := parallel.Map(ctx, sets, opts.Concurrency,
+		func(ctx context.Context, vs *varSet) ([]*tfc.VariableSetVariable, error) {
+			return client.VariableSets.ListVariables(ctx, vs.ID)
+		},
+	)
+
+	for i, vs := range sets {
+		if fetchErrs[i] != nil {
+			errs = append(errs, fmt.Errorf("error listing variables for variable set %q: %w", vs.Name, fetchErrs[i]))
+			continue
+		}
+
+		source := "varset " + vs.Name
+		if vs.Priority {
+			source = "priority varset " + vs.Name
+		}
+
+		for _, v := range setVars[i] {
+			if !matches(v.Key, v.Value, v.Sensitive) {
+				continue
+			}
+
+			found = append(found, newMatch(vs.org, v.ID, v.Key, v.Category, v.Value, v.Sensitive, v.HCL, Match{
+				Workspaces: vs.workspaces,
+				VarSet:     vs.Name,
+				Source:     source,
+			}))
+		}
+	}
+
+	if opts.Format == cmdutil.FormatJSON {
+		if found == nil {
+			found = []Match{}
+		}
+		if err := cmdutil.PrintJSON(opts.IO, found); err != nil {
+			return err
+		}
+		return errors.Join(errs...)
+	}
+
+	fp := cmdutil.FieldPrinter(opts.IO, opts.Columns...)
+	for _, m := range found {
+		fp.Write(extractFields(m))
+	}
+	fp.Flush()
+
+	return errors.Join(errs...)
+}
+
+// newMatch completes a match with the attributes of the variable, masking
+// the value of sensitive variables.
+func newMatch(
+	org, id, key string,
+	category tfe.CategoryType,
+	value string,
+	sensitive, hcl bool,
+	m Match,
+) Match {
+	m.Organization = org
+	m.ID = id
+	m.Key = key
+	m.Category = string(category)
+	m.Sensitive = sensitive
+	m.HCL = hcl
+
+	if !sensitive {
+		m.Value = value
+	}
+
+	return m
+}
+
+func extractFields(m Match) map[string]string {
+	workspace := m.Workspace
+	if m.VarSet != "" {
+		workspace = strings.Join(m.Workspaces, ",")
+	}
+
+	value := m.Value
+	if m.Sensitive {
+		value = SensitiveValue
+	}
+
+	return map[string]string{
+		ColumnOrg:       m.Organization,
+		ColumnWorkspace: workspace,
+		ColumnID:        m.ID,
+		ColumnKey:       m.Key,
+		ColumnCategory:  m.Category,
+		ColumnValue:     value,
+		ColumnSensitive: strconv.FormatBool(m.Sensitive),
+		ColumnHCL:       strconv.FormatBool(m.HCL),
+		ColumnSource:    m.Source,
+	}
+}
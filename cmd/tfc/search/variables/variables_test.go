@@ -0,0 +1,303 @@
+package variables_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/zkhvan/tfc/cmd/tfc/search/variables"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+func TestSearchVariables(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"o","type":"organizations","attributes":{"name":"o"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprintf(w, `{"data":[%s,%s]}`, testWorkspace("ws-a"), testWorkspace("ws-b"))
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.PathValue("workspace_id") {
+			case "ws-a":
+				fmt.Fprint(w, `{"data":[
+					{
+						"id": "var-1",
+						"type": "vars",
+						"attributes": {"key": "AWS_ROLE_ARN", "value": "arn:aws:iam::111:role/ci", "category": "env"}
+					},
+					{
+						"id": "var-2",
+						"type": "vars",
+						"attributes": {"key": "region", "value": "us-east-1", "category": "terraform"}
+					}
+				]}`)
+			case "ws-b":
+				fmt.Fprint(w, `{"data":[
+					{
+						"id": "var-3",
+						"type": "vars",
+						"attributes": {"key": "AWS_ROLE_ARN", "value": "arn:aws:iam::222:role/ci", "category": "env"}
+					}
+				]}`)
+			}
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/varsets",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":[{"id": "varset-1", "type": "varsets", "attributes": {"name": "aws"}}]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/varsets/varset-1/relationships/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":[
+				{"id": "var-4", "type": "vars", "attributes": {"key": "AWS_SECRET_KEY", "category": "env", "sensitive": true}}
+			]}`)
+		},
+	)
+
+	result := runCommand(t, client, "AWS_*", "--org", "o")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		ORG  WORKSPACE  KEY             CATEGORY  VALUE                     SOURCE
+		o    ws-a       AWS_ROLE_ARN    env       arn:aws:iam::111:role/ci  workspace
+		o    ws-b       AWS_ROLE_ARN    env       arn:aws:iam::222:role/ci  workspace
+		o    ws-a,ws-b  AWS_SECRET_KEY  env       (sensitive)               varset aws
+	`))
+}
+
+func TestSearchVariables_value_pattern(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"o","type":"organizations","attributes":{"name":"o"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprintf(w, `{"data":[%s,%s]}`, testWorkspace("ws-a"), testWorkspace("ws-b"))
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.PathValue("workspace_id") {
+			case "ws-a":
+				fmt.Fprint(w, `{"data":[
+					{
+						"id": "var-1",
+						"type": "vars",
+						"attributes": {"key": "AWS_ROLE_ARN", "value": "arn:aws:iam::111:role/ci", "category": "env"}
+					},
+					{
+						"id": "var-2",
+						"type": "vars",
+						"attributes": {"key": "region", "value": "us-east-1", "category": "terraform"}
+					}
+				]}`)
+			case "ws-b":
+				fmt.Fprint(w, `{"data":[
+					{
+						"id": "var-3",
+						"type": "vars",
+						"attributes": {"key": "AWS_ROLE_ARN", "value": "arn:aws:iam::222:role/ci", "category": "env"}
+					}
+				]}`)
+			}
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/varsets",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":[{"id": "varset-1", "type": "varsets", "attributes": {"name": "aws"}}]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/varsets/varset-1/relationships/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":[
+				{"id": "var-4", "type": "vars", "attributes": {"key": "AWS_SECRET_KEY", "category": "env", "sensitive": true}}
+			]}`)
+		},
+	)
+
+	result := runCommand(t, client, "*", "--org", "o", "--value-pattern", "111:")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		ORG  WORKSPACE  KEY           CATEGORY  VALUE                     SOURCE
+		o    ws-a       AWS_ROLE_ARN  env       arn:aws:iam::111:role/ci  workspace
+	`))
+}
+
+func TestSearchVariables_regex_json(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"o","type":"organizations","attributes":{"name":"o"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprintf(w, `{"data":[%s,%s]}`, testWorkspace("ws-a"), testWorkspace("ws-b"))
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.PathValue("workspace_id") {
+			case "ws-a":
+				fmt.Fprint(w, `{"data":[
+					{
+						"id": "var-1",
+						"type": "vars",
+						"attributes": {"key": "AWS_ROLE_ARN", "value": "arn:aws:iam::111:role/ci", "category": "env"}
+					},
+					{
+						"id": "var-2",
+						"type": "vars",
+						"attributes": {"key": "region", "value": "us-east-1", "category": "terraform"}
+					}
+				]}`)
+			case "ws-b":
+				fmt.Fprint(w, `{"data":[
+					{
+						"id": "var-3",
+						"type": "vars",
+						"attributes": {"key": "AWS_ROLE_ARN", "value": "arn:aws:iam::222:role/ci", "category": "env"}
+					}
+				]}`)
+			}
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/varsets",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":[{"id": "varset-1", "type": "varsets", "attributes": {"name": "aws"}}]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/varsets/varset-1/relationships/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":[
+				{"id": "var-4", "type": "vars", "attributes": {"key": "AWS_SECRET_KEY", "category": "env", "sensitive": true}}
+			]}`)
+		},
+	)
+
+	result := runCommand(t, client, "^(region|AWS_SECRET_.*)$", "--regex", "--org", "o", "--format", "json")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		[
+		  {
+		    "organization": "o",
+		    "workspace": "ws-a",
+		    "id": "var-2",
+		    "key": "region",
+		    "category": "terraform",
+		    "value": "us-east-1",
+		    "sensitive": false,
+		    "hcl": false,
+		    "source": "workspace"
+		  },
+		  {
+		    "organization": "o",
+		    "workspaces": [
+		      "ws-a",
+		      "ws-b"
+		    ],
+		    "varset": "aws",
+		    "id": "var-4",
+		    "key": "AWS_SECRET_KEY",
+		    "category": "env",
+		    "sensitive": true,
+		    "hcl": false,
+		    "source": "varset aws"
+		  }
+		]
+	`))
+}
+
+func testWorkspace(name string) string {
+	return text.Heredocf(`
+		{
+			"id": "%[1]s",
+			"type": "workspaces",
+			"attributes": {"name": "%[1]s"},
+			"relationships": {
+				"organization": {"data": {"id": "o", "type": "organizations"}}
+			}
+		}
+	`, name)
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams: ios,
+		TFEClient: func() (*tfc.Client, error) { return client, nil },
+	}
+
+	cmd := variables.NewCmdVariables(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
@@ -0,0 +1,160 @@
+package diff
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/term/color"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+	"github.com/zkhvan/tfc/pkg/tfstate"
+)
+
+var (
+	AddedStyle   = lipgloss.NewStyle().Foreground(color.Green)
+	RemovedStyle = lipgloss.NewStyle().Foreground(color.Red)
+	ChangedStyle = lipgloss.NewStyle().Foreground(color.Yellow)
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	WorkspaceID cmdutil.WorkspaceIdentifier
+	From        string
+	To          string
+}
+
+func NewCmdDiff(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "diff <a> <b>",
+		Short: "Compare two state versions",
+		Long: text.Heredoc(`
+			Compare two state versions at the resource address level.
+
+			Versions are identified by their ID or serial. Resource instances
+			that only exist in <b> are shown as added, those that only exist
+			in <a> as removed, and those whose attributes differ as changed.
+
+			If -W/--workspace is not specified and state.tf is present,
+			the organization and workspace will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Compare serial 41 with serial 42
+			$ tfc state diff 41 42
+
+			# Compare two state versions by ID
+			$ tfc state diff sv-abc123 sv-def456 -W myorg/myworkspace
+		`),
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceFlag(cmd, &opts.WorkspaceID, opts.TFEClient)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, args []string) {
+	opts.From = args[0]
+	opts.To = args[1]
+	cmdutil.CompleteWorkspaceIdentifierSilent(cmd, &opts.WorkspaceID, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := opts.WorkspaceID.Validate(); err != nil {
+		return fmt.Errorf("workspace required: use -W ORG/WORKSPACE or ensure state.tf exists")
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	ws, err := client.Workspaces.Read(ctx, opts.WorkspaceID.Org, opts.WorkspaceID.Workspace)
+	if err != nil {
+		return fmt.Errorf("failed to read workspace %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	from, err := opts.readState(ctx, client, ws, opts.From)
+	if err != nil {
+		return err
+	}
+
+	to, err := opts.readState(ctx, client, ws, opts.To)
+	if err != nil {
+		return err
+	}
+
+	diffs := tfstate.Diff(from, to)
+	if len(diffs) == 0 {
+		fmt.Fprintf(opts.IO.Out, "No resource differences between serial %d and %d\n", from.Serial, to.Serial)
+		return nil
+	}
+
+	var added, removed, changed int
+	for _, d := range diffs {
+		switch d.Change {
+		case tfstate.ChangeAdded:
+			added++
+			fmt.Fprintf(opts.IO.Out, "%s %s\n", AddedStyle.Render("+"), d.Address)
+		case tfstate.ChangeRemoved:
+			removed++
+			fmt.Fprintf(opts.IO.Out, "%s %s\n", RemovedStyle.Render("-"), d.Address)
+		case tfstate.ChangeChanged:
+			changed++
+			fmt.Fprintf(opts.IO.Out, "%s %s\n", ChangedStyle.Render("~"), d.Address)
+		}
+	}
+
+	fmt.Fprintf(
+		opts.IO.Out,
+		"\nSerial %d -> %d: %d added, %d changed, %d removed\n",
+		from.Serial, to.Serial, added, changed, removed,
+	)
+
+	return nil
+}
+
+func (opts *Options) readState(
+	ctx context.Context,
+	client *tfc.Client,
+	ws *tfc.Workspace,
+	ref string,
+) (*tfstate.State, error) {
+	sv, err := client.StateVersions.Resolve(ctx, opts.WorkspaceID.Org, ws, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := client.StateVersions.Download(ctx, sv)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download state version %s: %w", sv.ID, err)
+	}
+
+	state, err := tfstate.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("state version %s: %w", sv.ID, err)
+	}
+
+	return state, nil
+}
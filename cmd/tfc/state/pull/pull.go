@@ -0,0 +1,102 @@
+package pull
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	WorkspaceID cmdutil.WorkspaceIdentifier
+	Version     string
+}
+
+func NewCmdPull(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "pull",
+		Short: "Download a raw state file",
+		Long: text.Heredoc(`
+			Download a workspace's raw state file and write it to stdout.
+
+			By default the current state version is downloaded. Use --version
+			to download an older one, identified by its ID or serial.
+
+			If -W/--workspace is not specified and state.tf is present,
+			the organization and workspace will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Download the current state
+			$ tfc state pull > terraform.tfstate
+
+			# Download the state with serial 42
+			$ tfc state pull -W myorg/myworkspace --version 42
+
+			# Download a state version by ID
+			$ tfc state pull --version sv-abc123
+		`),
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceFlag(cmd, &opts.WorkspaceID, opts.TFEClient)
+
+	cmd.Flags().StringVar(&opts.Version, "version", "", "State version ID or serial (default: current)")
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, _ []string) {
+	cmdutil.CompleteWorkspaceIdentifierSilent(cmd, &opts.WorkspaceID, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := opts.WorkspaceID.Validate(); err != nil {
+		return fmt.Errorf("workspace required: use -W ORG/WORKSPACE or ensure state.tf exists")
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	ws, err := client.Workspaces.Read(ctx, opts.WorkspaceID.Org, opts.WorkspaceID.Workspace)
+	if err != nil {
+		return fmt.Errorf("failed to read workspace %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	sv, err := client.StateVersions.Resolve(ctx, opts.WorkspaceID.Org, ws, opts.Version)
+	if err != nil {
+		return err
+	}
+
+	state, err := client.StateVersions.Download(ctx, sv)
+	if err != nil {
+		return fmt.Errorf("failed to download state version %s: %w", sv.ID, err)
+	}
+
+	_, err = opts.IO.Out.Write(state)
+	return err
+}
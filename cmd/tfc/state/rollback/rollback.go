@@ -0,0 +1,159 @@
+package rollback
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+	"github.com/zkhvan/tfc/pkg/tfstate"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	WorkspaceID cmdutil.WorkspaceIdentifier
+	Version     string
+}
+
+func NewCmdRollback(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "rollback <version>",
+		Short: "Restore a previous state version",
+		Long: text.Heredoc(`
+			Restore a previous state version as the workspace's current state.
+
+			The version is identified by its ID or serial. The workspace is
+			locked for the duration of the rollback. The chosen state is
+			re-uploaded with a serial one greater than the current state, so
+			the history of state versions is preserved.
+
+			If -W/--workspace is not specified and state.tf is present,
+			the organization and workspace will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Roll back to the state with serial 41
+			$ tfc state rollback 41
+
+			# Roll back to a state version by ID
+			$ tfc state rollback sv-abc123 -W myorg/myworkspace
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceFlag(cmd, &opts.WorkspaceID, opts.TFEClient)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, args []string) {
+	opts.Version = args[0]
+	cmdutil.CompleteWorkspaceIdentifierSilent(cmd, &opts.WorkspaceID, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) (err error) {
+	if err := opts.WorkspaceID.Validate(); err != nil {
+		return fmt.Errorf("workspace required: use -W ORG/WORKSPACE or ensure state.tf exists")
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	ws, err := client.Workspaces.Read(ctx, opts.WorkspaceID.Org, opts.WorkspaceID.Workspace)
+	if err != nil {
+		return fmt.Errorf("failed to read workspace %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	if ws.Locked {
+		return fmt.Errorf("workspace %s is locked; unlock it before rolling back", opts.WorkspaceID.String())
+	}
+
+	target, err := client.StateVersions.Resolve(ctx, opts.WorkspaceID.Org, ws, opts.Version)
+	if err != nil {
+		return err
+	}
+
+	raw, err := client.StateVersions.Download(ctx, target)
+	if err != nil {
+		return fmt.Errorf("failed to download state version %s: %w", target.ID, err)
+	}
+
+	state, err := tfstate.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("state version %s: %w", target.ID, err)
+	}
+
+	reason := fmt.Sprintf("Rolling back state to serial %d via tfc", target.Serial)
+	if _, err := client.Workspaces.Lock(ctx, ws.ID, reason); err != nil {
+		return fmt.Errorf("failed to lock workspace: %w", err)
+	}
+	fmt.Fprintf(opts.IO.ErrOut, "Locked workspace %s\n", opts.WorkspaceID.String())
+
+	defer func() {
+		if _, unlockErr := client.Workspaces.Unlock(context.WithoutCancel(ctx), ws.ID); unlockErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to unlock workspace: %w", unlockErr))
+			return
+		}
+		fmt.Fprintf(opts.IO.ErrOut, "Unlocked workspace %s\n", opts.WorkspaceID.String())
+	}()
+
+	// Read the current state version while holding the lock so no other
+	// state can be written between picking the serial and uploading.
+	current, err := client.StateVersions.ReadCurrent(ctx, ws.ID)
+	if err != nil {
+		return fmt.Errorf("failed to read current state version: %w", err)
+	}
+
+	if current.ID == target.ID {
+		fmt.Fprintf(opts.IO.Out, "State version %s is already current\n", target.ID)
+		return nil
+	}
+
+	serial := current.Serial + 1
+	rewritten, err := tfstate.SetSerial(raw, serial)
+	if err != nil {
+		return err
+	}
+
+	sv, err := client.StateVersions.Upload(ctx, ws.ID, tfc.StateVersionUploadOptions{
+		State:   rewritten,
+		Serial:  serial,
+		Lineage: state.Lineage,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload state: %w", err)
+	}
+
+	fmt.Fprintf(
+		opts.IO.Out,
+		"Rolled back to serial %d as new state version %s (serial %d)\n",
+		target.Serial,
+		sv.ID,
+		serial,
+	)
+
+	return nil
+}
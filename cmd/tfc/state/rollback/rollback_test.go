@@ -0,0 +1,250 @@
+package rollback_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/zkhvan/tfc/cmd/tfc/state/rollback"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+	"github.com/zkhvan/tfc/pkg/tfstate"
+)
+
+func TestRollback_uploads_state_with_bumped_serial(t *testing.T) {
+	logger := tfetest.NewRequestLogger()
+	client, mux, teardown := tfetest.Setup(logger.Middleware)
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ws-123","type":"workspaces","attributes":{"name":"my-workspace"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/state-versions",
+		func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("filter[workspace][name]"); got != "my-workspace" {
+				t.Errorf("got workspace filter %q", got)
+			}
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "sv-current",
+							"type": "state-versions",
+							"attributes": {"serial": 7}
+						},
+						{
+							"id": "sv-old",
+							"type": "state-versions",
+							"attributes": {
+								"serial": 3,
+								"hosted-state-download-url": "/_archivist/sv-old"
+							}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /_archivist/sv-old",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"version":4,"serial":3,"lineage":"abc","resources":[]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"POST /api/v2/workspaces/{workspace_id}/actions/lock",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ws-123","type":"workspaces","attributes":{"locked":true}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"POST /api/v2/workspaces/{workspace_id}/actions/unlock",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ws-123","type":"workspaces","attributes":{"locked":false}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/current-state-version",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"sv-current","type":"state-versions","attributes":{"serial":7}}}`)
+		},
+	)
+
+	var (
+		gotSerial  int64
+		gotLineage string
+		gotState   *tfstate.State
+	)
+
+	mux.HandleFunc(
+		"POST /api/v2/workspaces/{workspace_id}/state-versions",
+		func(w http.ResponseWriter, r *http.Request) {
+			var payload struct {
+				Data struct {
+					Attributes struct {
+						Serial  int64  `json:"serial"`
+						Lineage string `json:"lineage"`
+						State   string `json:"state"`
+					} `json:"attributes"`
+				} `json:"data"`
+			}
+
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &payload); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			gotSerial = payload.Data.Attributes.Serial
+			gotLineage = payload.Data.Attributes.Lineage
+
+			raw, err := base64.StdEncoding.DecodeString(payload.Data.Attributes.State)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			gotState, err = tfstate.Parse(raw)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			fmt.Fprint(w, `{"data":{"id":"sv-new","type":"state-versions","attributes":{"serial":8}}}`)
+		},
+	)
+
+	result := runCommand(t, client, "-W", "myorg/my-workspace", "sv-old")
+
+	test.Buffer(t, result.ErrBuf, "Locked workspace myorg/my-workspace\nUnlocked workspace myorg/my-workspace\n")
+	test.Buffer(t, result.OutBuf, "Rolled back to serial 3 as new state version sv-new (serial 8)\n")
+
+	if gotSerial != 8 {
+		t.Errorf("uploaded serial got %d, want 8", gotSerial)
+	}
+	if gotLineage != "abc" {
+		t.Errorf("uploaded lineage got %q, want %q", gotLineage, "abc")
+	}
+	if gotState == nil || gotState.Serial != 8 {
+		t.Errorf("uploaded state serial was not rewritten: %+v", gotState)
+	}
+
+	last := logger.LastRequest()
+	if last == nil || last.Path != "/api/v2/workspaces/ws-123/actions/unlock" {
+		t.Errorf("expected the workspace to be unlocked last, got %v", last)
+	}
+}
+
+func TestRollback_refuses_state_version_of_another_workspace(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ws-123","type":"workspaces","attributes":{"name":"my-workspace"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/state-versions",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":[{"id":"sv-current","type":"state-versions","attributes":{"serial":7}}]}`)
+		},
+	)
+
+	var uploaded bool
+	mux.HandleFunc(
+		"POST /api/v2/workspaces/{workspace_id}/state-versions",
+		func(w http.ResponseWriter, _ *http.Request) {
+			uploaded = true
+			w.WriteHeader(http.StatusCreated)
+		},
+	)
+
+	result := runCommand(t, client, "-W", "myorg/my-workspace", "sv-other")
+
+	test.Buffer(t, result.ErrBuf, "state version sv-other not found in workspace myorg/my-workspace\n")
+	test.BufferEmpty(t, result.OutBuf)
+
+	if uploaded {
+		t.Error("uploaded the state of another workspace")
+	}
+}
+
+func TestRollback_refuses_locked_workspace(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": {
+						"id": "ws-123",
+						"type": "workspaces",
+						"attributes": {
+							"name": "my-workspace",
+							"locked": true
+						}
+					}
+				}
+			`)
+		},
+	)
+
+	result := runCommand(t, client, "-W", "myorg/my-workspace", "3")
+
+	test.Buffer(t, result.ErrBuf, "workspace myorg/my-workspace is locked; unlock it before rolling back\n")
+	test.BufferEmpty(t, result.OutBuf)
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+	}
+
+	cmd := rollback.NewCmdRollback(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
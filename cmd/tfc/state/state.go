@@ -0,0 +1,32 @@
+package state
+
+import (
+	"github.com/spf13/cobra"
+
+	diffCmd "github.com/zkhvan/tfc/cmd/tfc/state/diff"
+	pullCmd "github.com/zkhvan/tfc/cmd/tfc/state/pull"
+	rollbackCmd "github.com/zkhvan/tfc/cmd/tfc/state/rollback"
+	versionsCmd "github.com/zkhvan/tfc/cmd/tfc/state/versions"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+func NewCmdState(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state",
+		Short: "Manage a workspace's state versions",
+		Long: text.Heredoc(`
+			Manage a workspace's state versions.
+
+			Every successful apply creates a new state version. These commands
+			list, download, compare and restore previous versions.
+		`),
+	}
+
+	cmd.AddCommand(versionsCmd.NewCmdVersions(f))
+	cmd.AddCommand(pullCmd.NewCmdPull(f))
+	cmd.AddCommand(diffCmd.NewCmdDiff(f))
+	cmd.AddCommand(rollbackCmd.NewCmdRollback(f))
+
+	return cmd
+}
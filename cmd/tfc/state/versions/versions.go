@@ -0,0 +1,171 @@
+package versions
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/hashicorp/go-tfe"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/term/color"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+const (
+	ColumnID        string = "ID"
+	ColumnSerial    string = "SERIAL"
+	ColumnRun       string = "RUN"
+	ColumnCreatedBy string = "CREATED_BY"
+	ColumnCreatedAt string = "CREATED_AT"
+	ColumnStatus    string = "STATUS"
+	ColumnTFVersion string = "TF_VERSION"
+)
+
+var (
+	ColumnsDefault = []string{
+		ColumnSerial,
+		ColumnRun,
+		ColumnCreatedBy,
+		ColumnCreatedAt,
+	}
+	ColumnsAll = []string{
+		ColumnID,
+		ColumnSerial,
+		ColumnRun,
+		ColumnCreatedBy,
+		ColumnCreatedAt,
+		ColumnStatus,
+		ColumnTFVersion,
+	}
+)
+
+var (
+	TimeStyle = lipgloss.NewStyle().Foreground(color.LightBlack)
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	Clock           *cmdutil.Clock
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	WorkspaceID cmdutil.WorkspaceIdentifier
+	Limit       int
+	Columns     []string
+}
+
+func NewCmdVersions(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		Clock:           f.Clock,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "versions",
+		Short: "List a workspace's state versions",
+		Long: text.Heredoc(`
+			List a workspace's state versions, newest first.
+
+			If -W/--workspace is not specified and state.tf is present,
+			the organization and workspace will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# List the state versions of the workspace in state.tf
+			$ tfc state versions
+
+			# List the last 50 state versions of a workspace
+			$ tfc state versions -W myorg/myworkspace --limit 50
+		`),
+		Aliases:           []string{"ls"},
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceFlag(cmd, &opts.WorkspaceID, opts.TFEClient)
+
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "l", 20, "Limit the number of results.")
+	_ = cmdutil.FlagStringEnumSliceP(cmd, &opts.Columns, "columns", "c", ColumnsDefault, "Columns to show.", ColumnsAll)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, _ []string) {
+	cmdutil.CompleteWorkspaceIdentifierSilent(cmd, &opts.WorkspaceID, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := opts.WorkspaceID.Validate(); err != nil {
+		return fmt.Errorf("workspace required: use -W ORG/WORKSPACE or ensure state.tf exists")
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	versions, paging, err := client.StateVersions.List(
+		ctx,
+		opts.WorkspaceID.Org,
+		opts.WorkspaceID.Workspace,
+		&tfc.StateVersionListOptions{
+			ListOptions: tfc.ListOptions{Limit: opts.Limit},
+			Include:     []tfe.StateVersionIncludeOpt{tfe.SVrun, tfe.SVrunCreatedBy},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to list state versions for %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	if paging.ReachedLimit {
+		fmt.Fprintf(opts.IO.Out, "Showing top %d results\n\n", opts.Limit)
+	}
+
+	p := cmdutil.FieldPrinter(opts.IO, opts.Columns...)
+	for _, sv := range versions {
+		p.Write(opts.extractFields(sv))
+	}
+	p.Flush()
+
+	return nil
+}
+
+func (opts *Options) extractFields(sv *tfc.StateVersion) map[string]string {
+	renderTime := func(at time.Time) string {
+		rat := text.RelativeTimeAgo(opts.Clock.Now(), at)
+
+		return TimeStyle.Render(rat)
+	}
+
+	v := map[string]string{
+		ColumnID:        sv.ID,
+		ColumnSerial:    strconv.FormatInt(sv.Serial, 10),
+		ColumnCreatedAt: renderTime(sv.CreatedAt),
+		ColumnStatus:    string(sv.Status),
+		ColumnTFVersion: sv.TerraformVersion,
+	}
+
+	if sv.Run != nil {
+		v[ColumnRun] = sv.Run.ID
+
+		if sv.Run.CreatedBy != nil {
+			v[ColumnCreatedBy] = sv.Run.CreatedBy.Username
+		}
+	}
+
+	return v
+}
@@ -0,0 +1,326 @@
+package access
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/parallel"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+const (
+	ColumnType    string = "TYPE"
+	ColumnName    string = "NAME"
+	ColumnProject string = "PROJECT"
+	ColumnAccess  string = "ACCESS"
+	ColumnSource  string = "SOURCE"
+)
+
+var (
+	ColumnsDefault = []string{
+		ColumnType,
+		ColumnName,
+		ColumnAccess,
+		ColumnSource,
+	}
+	ColumnsAll = []string{
+		ColumnType,
+		ColumnName,
+		ColumnProject,
+		ColumnAccess,
+		ColumnSource,
+	}
+)
+
+const (
+	TypeProject   string = "project"
+	TypeWorkspace string = "workspace"
+
+	SourceDirect       string = "direct"
+	SourceOrganization string = "organization"
+
+	// NameAll is the name of a grant on every project or workspace.
+	NameAll string = "*"
+)
+
+// Grant is a project or workspace a team has access to.
+type Grant struct {
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Project string `json:"project,omitempty"`
+	Access  string `json:"access"`
+
+	// Source is "direct" for access granted on the project or workspace
+	// itself, "project NAME" for access to a workspace granted on its
+	// project, or "organization" for access to every project or workspace,
+	// named "*", granted by the organization permissions of the team.
+	Source string `json:"source"`
+}
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	Org         string
+	Team        string // Team name or ID
+	Concurrency int
+	Format      string
+	Columns     []string
+}
+
+func NewCmdAccess(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "access --team <NAME|ID>",
+		Short: "Show the projects and workspaces a team can access",
+		Long: text.Heredoc(`
+			Show every project and workspace a team has access to.
+
+			Access to a workspace is either granted on the workspace itself,
+			shown with a "direct" SOURCE, or on the project of the workspace.
+			Organization permissions that give the team access to every
+			project or workspace, such as manage-workspaces, are shown with an
+			"organization" SOURCE and a "*" NAME.
+
+			Every project and workspace of the organization is checked, use
+			--format json to keep the result for an access review.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Show the access of a team
+			$ tfc teams access --team developers --org myorg
+
+			# Export the access of a team
+			$ tfc teams access --team developers --org myorg --format json > developers.json
+		`),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+	cmdutil.AddFormatFlag(cmd, &opts.Format)
+
+	cmd.Flags().StringVar(&opts.Team, "team", "", "Name or ID of the team")
+	cmd.Flags().IntVar(&opts.Concurrency, "concurrency", parallel.DefaultConcurrency,
+		"Number of projects and workspaces to check concurrently.",
+	)
+	_ = cmdutil.FlagStringEnumSliceP(cmd, &opts.Columns, "columns", "c", ColumnsDefault, "Columns to show.", ColumnsAll)
+
+	_ = cmd.MarkFlagRequired("team")
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, _ []string) {
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if opts.Org == "" {
+		return cmdutil.ErrOrgRequired
+	}
+
+	if err := cmdutil.ValidateEnum("format", opts.Format, []string{cmdutil.FormatTable, cmdutil.FormatJSON}); err != nil {
+		return err
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	team, err := client.Teams.ReadByNameOrID(ctx, opts.Org, opts.Team)
+	if err != nil {
+		return fmt.Errorf("failed to read team %s: %w", opts.Team, err)
+	}
+
+	projects, _, err := client.Projects.List(ctx, opts.Org, &tfc.ProjectListOptions{
+		ListOptions: tfc.ListOptions{Limit: math.MaxInt},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list projects for %s: %w", opts.Org, err)
+	}
+
+	workspaces, _, err := client.Workspaces.List(ctx, opts.Org, &tfc.WorkspaceListOptions{
+		ListOptions: tfc.ListOptions{Limit: math.MaxInt},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list workspaces for %s: %w", opts.Org, err)
+	}
+
+	var errs []error
+
+	projectAccess, fetchErrs := parallel.Map(ctx, projects, opts.Concurrency,
+		func(ctx context.Context, p *tfc.Project) (*tfc.TeamProjectAccess, error) {
+			access, err := client.Projects.ListTeamAccess(ctx, p.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, a := range access {
+				if a.Team != nil && a.Team.ID == team.ID {
+					return a, nil
+				}
+			}
+
+			return nil, nil
+		},
+	)
+
+	var (
+		grants       []Grant
+		projectNames = make(map[string]string, len(projects))
+		projectGrant = make(map[string]string)
+	)
+	for i, p := range projects {
+		projectNames[p.ID] = p.Name
+
+		if fetchErrs[i] != nil {
+			errs = append(errs, fmt.Errorf("error listing team access for project %q: %w", p.Name, fetchErrs[i]))
+			continue
+		}
+
+		if a := projectAccess[i]; a != nil {
+			projectGrant[p.ID] = string(a.Access)
+			grants = append(grants, Grant{
+				Type:   TypeProject,
+				Name:   p.Name,
+				Access: string(a.Access),
+				Source: SourceDirect,
+			})
+		}
+	}
+
+	workspaceAccess, fetchErrs := parallel.Map(ctx, workspaces, opts.Concurrency,
+		func(ctx context.Context, ws *tfc.Workspace) (*tfc.TeamAccess, error) {
+			access, err := client.TeamAccess.ListForWorkspace(ctx, ws.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, a := range access {
+				if a.Team != nil && a.Team.ID == team.ID {
+					return a, nil
+				}
+			}
+
+			return nil, nil
+		},
+	)
+
+	for i, ws := range workspaces {
+		if fetchErrs[i] != nil {
+			errs = append(errs, fmt.Errorf("error listing team access for workspace %q: %w", ws.Name, fetchErrs[i]))
+			continue
+		}
+
+		project := ""
+		if ws.Project != nil {
+			project = projectNames[ws.Project.ID]
+		}
+
+		if a := workspaceAccess[i]; a != nil {
+			grants = append(grants, Grant{
+				Type:    TypeWorkspace,
+				Name:    ws.Name,
+				Project: project,
+				Access:  string(a.Access),
+				Source:  SourceDirect,
+			})
+		}
+
+		if ws.Project != nil {
+			if access, ok := projectGrant[ws.Project.ID]; ok {
+				grants = append(grants, Grant{
+					Type:    TypeWorkspace,
+					Name:    ws.Name,
+					Project: project,
+					Access:  access,
+					Source:  "project " + project,
+				})
+			}
+		}
+	}
+
+	grants = append(grants, organizationWide(team)...)
+
+	slices.SortStableFunc(grants, func(a, b Grant) int {
+		return cmp.Or(cmp.Compare(a.Type, b.Type), cmp.Compare(a.Name, b.Name))
+	})
+
+	if opts.Format == cmdutil.FormatJSON {
+		if grants == nil {
+			grants = []Grant{}
+		}
+		if err := cmdutil.PrintJSON(opts.IO, grants); err != nil {
+			return err
+		}
+		return errors.Join(errs...)
+	}
+
+	fp := cmdutil.FieldPrinter(opts.IO, opts.Columns...)
+	for _, g := range grants {
+		fp.Write(map[string]string{
+			ColumnType:    g.Type,
+			ColumnName:    g.Name,
+			ColumnProject: g.Project,
+			ColumnAccess:  g.Access,
+			ColumnSource:  g.Source,
+		})
+	}
+	fp.Flush()
+
+	return errors.Join(errs...)
+}
+
+// organizationWide returns the grants on every project or workspace given by
+// the organization permissions of a team.
+func organizationWide(team *tfc.Team) []Grant {
+	a := team.OrganizationAccess
+	if a == nil {
+		return nil
+	}
+
+	grant := func(typ, access string) Grant {
+		return Grant{Type: typ, Name: NameAll, Access: access, Source: SourceOrganization}
+	}
+
+	var grants []Grant
+	switch {
+	case a.ManageProjects:
+		grants = append(grants, grant(TypeProject, "manage"))
+	case a.ReadProjects:
+		grants = append(grants, grant(TypeProject, "read"))
+	}
+
+	switch {
+	case a.ManageWorkspaces:
+		grants = append(grants, grant(TypeWorkspace, "manage"))
+	case a.ReadWorkspaces:
+		grants = append(grants, grant(TypeWorkspace, "read"))
+	}
+
+	return grants
+}
@@ -0,0 +1,353 @@
+package access_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/zkhvan/tfc/cmd/tfc/team/access"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+func TestAccess(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/teams",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "team-1", "type": "teams", "attributes": {"name": "developers", "organization-access": {}}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/projects",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "prj-apps", "type": "projects", "attributes": {"name": "apps"}},
+				{"id": "prj-platform", "type": "projects", "attributes": {"name": "platform"}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/team-projects",
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("filter[project][id]") != "prj-apps" {
+				fmt.Fprint(w, `{"data": [
+					{"id": "tprj-2", "type": "team-projects", "attributes": {"access": "admin"},
+						"relationships": {"team": {"data": {"id": "team-2", "type": "teams"}}}}
+				]}`)
+				return
+			}
+
+			fmt.Fprint(w, `{"data": [
+				{"id": "tprj-1", "type": "team-projects", "attributes": {"access": "write"},
+					"relationships": {"team": {"data": {"id": "team-1", "type": "teams"}}}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "ws-api", "type": "workspaces", "attributes": {"name": "api"},
+					"relationships": {"project": {"data": {"id": "prj-apps", "type": "projects"}}}},
+				{"id": "ws-network", "type": "workspaces", "attributes": {"name": "network"},
+					"relationships": {"project": {"data": {"id": "prj-platform", "type": "projects"}}}},
+				{"id": "ws-dns", "type": "workspaces", "attributes": {"name": "dns"},
+					"relationships": {"project": {"data": {"id": "prj-platform", "type": "projects"}}}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/team-workspaces",
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Query().Get("filter[workspace][id]") {
+			case "ws-api":
+				fmt.Fprint(w, `{"data": [
+					{"id": "tws-1", "type": "team-workspaces", "attributes": {"access": "admin"},
+						"relationships": {"team": {"data": {"id": "team-1", "type": "teams"}}}}
+				]}`)
+			case "ws-dns":
+				fmt.Fprint(w, `{"data": [
+					{"id": "tws-2", "type": "team-workspaces", "attributes": {"access": "read"},
+						"relationships": {"team": {"data": {"id": "team-1", "type": "teams"}}}}
+				]}`)
+			default:
+				fmt.Fprint(w, `{"data": []}`)
+			}
+		},
+	)
+
+	result := runCommand(t, client, "--team", "developers", "--org", "myorg")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		TYPE       NAME  ACCESS  SOURCE
+		project    apps  write   direct
+		workspace  api   admin   direct
+		workspace  api   write   project apps
+		workspace  dns   read    direct
+	`))
+}
+
+func TestAccess_organization_access(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/teams",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{
+					"id": "team-1",
+					"type": "teams",
+					"attributes": {
+						"name": "developers",
+						"organization-access": {"manage-projects": true, "read-workspaces": true}
+					}
+				}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/projects",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "prj-apps", "type": "projects", "attributes": {"name": "apps"}},
+				{"id": "prj-platform", "type": "projects", "attributes": {"name": "platform"}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/team-projects",
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("filter[project][id]") != "prj-apps" {
+				fmt.Fprint(w, `{"data": [
+					{"id": "tprj-2", "type": "team-projects", "attributes": {"access": "admin"},
+						"relationships": {"team": {"data": {"id": "team-2", "type": "teams"}}}}
+				]}`)
+				return
+			}
+
+			fmt.Fprint(w, `{"data": [
+				{"id": "tprj-1", "type": "team-projects", "attributes": {"access": "write"},
+					"relationships": {"team": {"data": {"id": "team-1", "type": "teams"}}}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "ws-api", "type": "workspaces", "attributes": {"name": "api"},
+					"relationships": {"project": {"data": {"id": "prj-apps", "type": "projects"}}}},
+				{"id": "ws-network", "type": "workspaces", "attributes": {"name": "network"},
+					"relationships": {"project": {"data": {"id": "prj-platform", "type": "projects"}}}},
+				{"id": "ws-dns", "type": "workspaces", "attributes": {"name": "dns"},
+					"relationships": {"project": {"data": {"id": "prj-platform", "type": "projects"}}}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/team-workspaces",
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Query().Get("filter[workspace][id]") {
+			case "ws-api":
+				fmt.Fprint(w, `{"data": [
+					{"id": "tws-1", "type": "team-workspaces", "attributes": {"access": "admin"},
+						"relationships": {"team": {"data": {"id": "team-1", "type": "teams"}}}}
+				]}`)
+			case "ws-dns":
+				fmt.Fprint(w, `{"data": [
+					{"id": "tws-2", "type": "team-workspaces", "attributes": {"access": "read"},
+						"relationships": {"team": {"data": {"id": "team-1", "type": "teams"}}}}
+				]}`)
+			default:
+				fmt.Fprint(w, `{"data": []}`)
+			}
+		},
+	)
+
+	result := runCommand(t, client, "--team", "developers", "--org", "myorg")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		TYPE       NAME  ACCESS  SOURCE
+		project    *     manage  organization
+		project    apps  write   direct
+		workspace  *     read    organization
+		workspace  api   admin   direct
+		workspace  api   write   project apps
+		workspace  dns   read    direct
+	`))
+}
+
+func TestAccess_json(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/teams",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{
+					"id": "team-1",
+					"type": "teams",
+					"attributes": {"name": "developers", "organization-access": {"read-workspaces": true}}
+				}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/projects",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "prj-apps", "type": "projects", "attributes": {"name": "apps"}},
+				{"id": "prj-platform", "type": "projects", "attributes": {"name": "platform"}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/team-projects",
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("filter[project][id]") != "prj-apps" {
+				fmt.Fprint(w, `{"data": [
+					{"id": "tprj-2", "type": "team-projects", "attributes": {"access": "admin"},
+						"relationships": {"team": {"data": {"id": "team-2", "type": "teams"}}}}
+				]}`)
+				return
+			}
+
+			fmt.Fprint(w, `{"data": [
+				{"id": "tprj-1", "type": "team-projects", "attributes": {"access": "write"},
+					"relationships": {"team": {"data": {"id": "team-1", "type": "teams"}}}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "ws-api", "type": "workspaces", "attributes": {"name": "api"},
+					"relationships": {"project": {"data": {"id": "prj-apps", "type": "projects"}}}},
+				{"id": "ws-network", "type": "workspaces", "attributes": {"name": "network"},
+					"relationships": {"project": {"data": {"id": "prj-platform", "type": "projects"}}}},
+				{"id": "ws-dns", "type": "workspaces", "attributes": {"name": "dns"},
+					"relationships": {"project": {"data": {"id": "prj-platform", "type": "projects"}}}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/team-workspaces",
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Query().Get("filter[workspace][id]") {
+			case "ws-api":
+				fmt.Fprint(w, `{"data": [
+					{"id": "tws-1", "type": "team-workspaces", "attributes": {"access": "admin"},
+						"relationships": {"team": {"data": {"id": "team-1", "type": "teams"}}}}
+				]}`)
+			case "ws-dns":
+				fmt.Fprint(w, `{"data": [
+					{"id": "tws-2", "type": "team-workspaces", "attributes": {"access": "read"},
+						"relationships": {"team": {"data": {"id": "team-1", "type": "teams"}}}}
+				]}`)
+			default:
+				fmt.Fprint(w, `{"data": []}`)
+			}
+		},
+	)
+
+	result := runCommand(t, client, "--team", "developers", "--org", "myorg", "--format", "json")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		[
+		  {
+		    "type": "project",
+		    "name": "apps",
+		    "access": "write",
+		    "source": "direct"
+		  },
+		  {
+		    "type": "workspace",
+		    "name": "*",
+		    "access": "read",
+		    "source": "organization"
+		  },
+		  {
+		    "type": "workspace",
+		    "name": "api",
+		    "project": "apps",
+		    "access": "admin",
+		    "source": "direct"
+		  },
+		  {
+		    "type": "workspace",
+		    "name": "api",
+		    "project": "apps",
+		    "access": "write",
+		    "source": "project apps"
+		  },
+		  {
+		    "type": "workspace",
+		    "name": "dns",
+		    "project": "platform",
+		    "access": "read",
+		    "source": "direct"
+		  }
+		]
+	`))
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+	}
+
+	cmd := access.NewCmdAccess(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
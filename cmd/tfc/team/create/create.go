@@ -0,0 +1,111 @@
+package create
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/ptr"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+var Visibilities = []string{"secret", "organization"}
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	Org        string
+	Name       string
+	Visibility string
+	SSOTeamID  string
+}
+
+func NewCmdCreate(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create <NAME>",
+		Short: "Create a team",
+		Long: text.Heredoc(`
+			Create a team.
+
+			A secret team is only visible to its members and to the owners of
+			the organization, an organization team is visible to every member
+			of the organization.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Create a team
+			$ tfc teams create developers --org myorg
+
+			# Create a team visible to the whole organization
+			$ tfc teams create platform --org myorg --visibility organization
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+
+	_ = cmdutil.FlagStringEnum(cmd, &opts.Visibility, "visibility", "secret", "Team visibility", Visibilities)
+	cmd.Flags().StringVar(&opts.SSOTeamID, "sso-team-id", "", "ID of the team in the SSO identity provider")
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, args []string) {
+	opts.Name = args[0]
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if opts.Org == "" {
+		return cmdutil.ErrOrgRequired
+	}
+
+	if err := cmdutil.ValidateEnum("visibility", opts.Visibility, Visibilities); err != nil {
+		return err
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	o := tfe.TeamCreateOptions{
+		Name:       ptr.String(opts.Name),
+		Visibility: ptr.String(opts.Visibility),
+	}
+	if opts.SSOTeamID != "" {
+		o.SSOTeamID = ptr.String(opts.SSOTeamID)
+	}
+
+	team, err := client.Teams.Create(ctx, opts.Org, o)
+	if err != nil {
+		return fmt.Errorf("failed to create team %s: %w", opts.Name, err)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Created team %s (%s)\n", team.Name, team.ID)
+
+	return nil
+}
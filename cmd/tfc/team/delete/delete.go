@@ -0,0 +1,110 @@
+package delete
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+	Prompter        func() *cmdutil.Prompter
+
+	Org        string
+	Identifier string // Team name or ID
+	Yes        bool
+}
+
+func NewCmdDelete(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+		Prompter:        f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete <NAME|ID>",
+		Short: "Delete a team",
+		Long: text.Heredoc(`
+			Delete a team.
+
+			The members of the team lose the access granted to the team on
+			the organization, its projects and its workspaces.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Delete a team by name
+			$ tfc teams delete contractors --org myorg
+
+			# Delete a team by ID without confirmation
+			$ tfc teams delete team-abc123 --yes
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+
+	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Delete the team without asking for confirmation")
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, args []string) {
+	opts.Identifier = args[0]
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	team, err := client.Teams.ReadByNameOrID(ctx, opts.Org, opts.Identifier)
+	if err != nil {
+		return fmt.Errorf("failed to read team %s: %w", opts.Identifier, err)
+	}
+
+	if !opts.Yes {
+		prompter := opts.Prompter()
+		if !prompter.CanPrompt() {
+			return fmt.Errorf("confirmation required: use --yes to delete the team without prompting")
+		}
+
+		ok, err := prompter.Confirm(fmt.Sprintf("Delete team %s (%s)?", team.Name, team.ID))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Fprintln(opts.IO.Out, "Delete cancelled")
+			return nil
+		}
+	}
+
+	if err := client.Teams.Delete(ctx, team.ID); err != nil {
+		return fmt.Errorf("failed to delete team %s: %w", team.Name, err)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Deleted team %s (%s)\n", team.Name, team.ID)
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package list
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+const (
+	ColumnID         string = "ID"
+	ColumnName       string = "NAME"
+	ColumnMembers    string = "MEMBERS"
+	ColumnVisibility string = "VISIBILITY"
+	ColumnSSOTeamID  string = "SSO_TEAM_ID"
+)
+
+var (
+	ColumnsDefault = []string{
+		ColumnName,
+		ColumnMembers,
+		ColumnVisibility,
+	}
+	ColumnsAll = []string{
+		ColumnID,
+		ColumnName,
+		ColumnMembers,
+		ColumnVisibility,
+		ColumnSSOTeamID,
+	}
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	Org     string
+	Name    string
+	Limit   int
+	Columns []string
+}
+
+func NewCmdList(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List teams",
+		Aliases: []string{"ls"},
+		Long: text.Heredoc(`
+			List the teams of an organization.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+	cmd.Flags().StringVarP(&opts.Name, "name", "n", "", "Search by the team name.")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "l", 20, "Limit the number of results.")
+	_ = cmdutil.FlagStringEnumSliceP(cmd, &opts.Columns, "columns", "c", ColumnsDefault, "Columns to show.", ColumnsAll)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, _ []string) {
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if opts.Org == "" {
+		return cmdutil.ErrOrgRequired
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	teams, pagination, err := client.Teams.List(ctx, opts.Org, &tfc.TeamListOptions{
+		ListOptions: tfc.ListOptions{Limit: opts.Limit},
+		Query:       opts.Name,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list teams for %s: %w", opts.Org, err)
+	}
+
+	if pagination.ReachedLimit {
+		fmt.Fprintf(opts.IO.Out, "Showing top %d results\n\n", opts.Limit)
+	}
+
+	p := cmdutil.FieldPrinter(opts.IO, opts.Columns...)
+	for _, t := range teams {
+		p.Write(extractFields(t))
+	}
+	p.Flush()
+
+	return nil
+}
+
+func extractFields(t *tfc.Team) map[string]string {
+	return map[string]string{
+		ColumnID:         t.ID,
+		ColumnName:       t.Name,
+		ColumnMembers:    strconv.Itoa(t.UserCount),
+		ColumnVisibility: t.Visibility,
+		ColumnSSOTeamID:  t.SSOTeamID,
+	}
+}
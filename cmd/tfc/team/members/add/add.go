@@ -0,0 +1,88 @@
+package add
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	Org       string
+	Team      string // Team name or ID
+	Usernames []string
+}
+
+func NewCmdAdd(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "add <TEAM> <USERNAME>...",
+		Short: "Add users to a team",
+		Long: text.Heredoc(`
+			Add users to a team by their usernames.
+
+			The users must already be members of the organization.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Add users to a team
+			$ tfc teams members add developers alice bob --org myorg
+		`),
+		Args:              cobra.MinimumNArgs(2),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, args []string) {
+	opts.Team = args[0]
+	opts.Usernames = args[1:]
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	team, err := client.Teams.ReadByNameOrID(ctx, opts.Org, opts.Team)
+	if err != nil {
+		return fmt.Errorf("failed to read team %s: %w", opts.Team, err)
+	}
+
+	if err := client.Teams.AddMembers(ctx, team.ID, opts.Usernames...); err != nil {
+		return fmt.Errorf("failed to add members to team %s: %w", team.Name, err)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Added %s to team %s\n", strings.Join(opts.Usernames, ", "), team.Name)
+
+	return nil
+}
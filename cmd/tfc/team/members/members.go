@@ -0,0 +1,27 @@
+package members
+
+import (
+	"github.com/spf13/cobra"
+
+	addCmd "github.com/zkhvan/tfc/cmd/tfc/team/members/add"
+	removeCmd "github.com/zkhvan/tfc/cmd/tfc/team/members/remove"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+func NewCmdMembers(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "members",
+		Short: "Manage a team's members",
+		Long: text.Heredoc(`
+			Manage a team's members.
+
+			The members of a team are listed by "tfc teams view".
+		`),
+	}
+
+	cmd.AddCommand(addCmd.NewCmdAdd(f))
+	cmd.AddCommand(removeCmd.NewCmdRemove(f))
+
+	return cmd
+}
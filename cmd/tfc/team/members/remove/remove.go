@@ -0,0 +1,89 @@
+package remove
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	Org       string
+	Team      string // Team name or ID
+	Usernames []string
+}
+
+func NewCmdRemove(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "remove <TEAM> <USERNAME>...",
+		Short:   "Remove users from a team",
+		Aliases: []string{"rm"},
+		Long: text.Heredoc(`
+			Remove users from a team by their usernames.
+
+			The users remain members of the organization.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Remove users from a team
+			$ tfc teams members remove developers alice bob --org myorg
+		`),
+		Args:              cobra.MinimumNArgs(2),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, args []string) {
+	opts.Team = args[0]
+	opts.Usernames = args[1:]
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	team, err := client.Teams.ReadByNameOrID(ctx, opts.Org, opts.Team)
+	if err != nil {
+		return fmt.Errorf("failed to read team %s: %w", opts.Team, err)
+	}
+
+	if err := client.Teams.RemoveMembers(ctx, team.ID, opts.Usernames...); err != nil {
+		return fmt.Errorf("failed to remove members from team %s: %w", team.Name, err)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Removed %s from team %s\n", strings.Join(opts.Usernames, ", "), team.Name)
+
+	return nil
+}
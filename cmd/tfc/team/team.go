@@ -0,0 +1,37 @@
+package team
+
+import (
+	"github.com/spf13/cobra"
+
+	accessCmd "github.com/zkhvan/tfc/cmd/tfc/team/access"
+	createCmd "github.com/zkhvan/tfc/cmd/tfc/team/create"
+	deleteCmd "github.com/zkhvan/tfc/cmd/tfc/team/delete"
+	listCmd "github.com/zkhvan/tfc/cmd/tfc/team/list"
+	membersCmd "github.com/zkhvan/tfc/cmd/tfc/team/members"
+	viewCmd "github.com/zkhvan/tfc/cmd/tfc/team/view"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+func NewCmdTeam(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "teams",
+		Aliases: []string{"team"},
+		Short:   "Manage teams",
+		Long: text.Heredoc(`
+			Manage the teams of an organization.
+
+			Teams group users, and are granted access to the organization, its
+			projects and its workspaces.
+		`),
+	}
+
+	cmd.AddCommand(listCmd.NewCmdList(f))
+	cmd.AddCommand(viewCmd.NewCmdView(f))
+	cmd.AddCommand(createCmd.NewCmdCreate(f))
+	cmd.AddCommand(deleteCmd.NewCmdDelete(f))
+	cmd.AddCommand(membersCmd.NewCmdMembers(f))
+	cmd.AddCommand(accessCmd.NewCmdAccess(f))
+
+	return cmd
+}
@@ -0,0 +1,161 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/hashicorp/go-tfe"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	Org        string
+	Identifier string // Team name or ID
+}
+
+func NewCmdView(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view <NAME|ID>",
+		Short: "View team details",
+		Long: text.Heredoc(`
+			View detailed information about a team.
+
+			Displays the organization permissions of the team and its members.
+			Use "tfc teams access" to see the workspaces and projects the
+			team has access to.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# View a team by name
+			$ tfc teams view developers --org myorg
+
+			# View a team by ID
+			$ tfc teams view team-abc123
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, args []string) {
+	opts.Identifier = args[0]
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	team, err := client.Teams.ReadByNameOrID(ctx, opts.Org, opts.Identifier)
+	if err != nil {
+		return fmt.Errorf("failed to read team %s: %w", opts.Identifier, err)
+	}
+
+	members, err := client.Teams.ListMembers(ctx, team.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list members of team %s: %w", team.Name, err)
+	}
+
+	opts.displayTeam(team, members)
+
+	return nil
+}
+
+func (opts *Options) displayTeam(team *tfc.Team, members []*tfc.User) {
+	out := opts.IO.Out
+
+	faintStyle := lipgloss.NewStyle().Faint(true)
+	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("6"))
+
+	// Identity Section
+	fmt.Fprintf(out, "%s\n", headerStyle.Render("IDENTITY"))
+	fmt.Fprintf(out, "  Name:                 %s\n", team.Name)
+	fmt.Fprintf(out, "  ID:                   %s\n", faintStyle.Render(team.ID))
+	fmt.Fprintf(out, "  Visibility:           %s\n", team.Visibility)
+
+	if team.SSOTeamID != "" {
+		fmt.Fprintf(out, "  SSO Team ID:          %s\n", team.SSOTeamID)
+	}
+
+	// Organization Access Section
+	fmt.Fprintf(out, "\n%s\n", headerStyle.Render("ORGANIZATION ACCESS"))
+	cmdutil.WriteNames(out, organizationAccess(team.OrganizationAccess))
+
+	// Members Section
+	var usernames []string
+	for _, u := range members {
+		usernames = append(usernames, u.Username)
+	}
+
+	fmt.Fprintf(out, "\n%s\n", headerStyle.Render("MEMBERS"))
+	cmdutil.WriteNames(out, usernames)
+}
+
+// organizationAccess returns the names of the organization permissions
+// granted to a team.
+func organizationAccess(a *tfe.OrganizationAccess) []string {
+	if a == nil {
+		return nil
+	}
+
+	permissions := []struct {
+		name    string
+		granted bool
+	}{
+		{"access-secret-teams", a.AccessSecretTeams},
+		{"manage-agent-pools", a.ManageAgentPools},
+		{"manage-membership", a.ManageMembership},
+		{"manage-modules", a.ManageModules},
+		{"manage-organization-access", a.ManageOrganizationAccess},
+		{"manage-policies", a.ManagePolicies},
+		{"manage-policy-overrides", a.ManagePolicyOverrides},
+		{"manage-projects", a.ManageProjects},
+		{"manage-providers", a.ManageProviders},
+		{"manage-run-tasks", a.ManageRunTasks},
+		{"manage-teams", a.ManageTeams},
+		{"manage-vcs-settings", a.ManageVCSSettings},
+		{"manage-workspaces", a.ManageWorkspaces},
+		{"read-projects", a.ReadProjects},
+		{"read-workspaces", a.ReadWorkspaces},
+	}
+
+	var names []string
+	for _, p := range permissions {
+		if p.granted {
+			names = append(names, p.name)
+		}
+	}
+
+	return names
+}
@@ -0,0 +1,179 @@
+package create
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+	Clock           *cmdutil.Clock
+	Prompter        func() *cmdutil.Prompter
+
+	Org         string
+	Team        string
+	OrgTokens   bool
+	Description string
+	ExpiresIn   string
+	Yes         bool
+}
+
+func NewCmdCreate(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+		Clock:           f.Clock,
+		Prompter:        f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create an API token",
+		Long: text.Heredoc(`
+			Create an API token for the authenticated user, for a team with
+			--team, or for an organization with --org.
+
+			The token is printed to standard output. Store it right away, it
+			can't be shown again.
+
+			An organization has a single token, creating one replaces the
+			existing token, which stops working right away. Replacing it asks
+			for confirmation unless --yes is set.
+
+			With --team, if --org is not specified and state.tf is present,
+			the organization will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Create a token for yourself that expires in 30 days
+			$ tfc tokens create --description laptop --expires-in 30d
+
+			# Create a token for a team
+			$ tfc tokens create --team ci --org myorg --description github-actions
+
+			# Replace the token of an organization without confirmation
+			$ tfc tokens create --org myorg --yes
+		`),
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+
+	cmd.Flags().StringVarP(&opts.Team, "team", "t", "", "Create a token for a team, by name or ID")
+	cmd.Flags().StringVarP(&opts.Description, "description", "d", "", "Description of the token")
+	cmd.Flags().StringVar(&opts.ExpiresIn, "expires-in", "", "Expire the token after a duration, such as 12h, 30d or 2w")
+	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Replace an organization's token without asking for confirmation")
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, _ []string) {
+	opts.OrgTokens = opts.Team == "" && cmd.Flags().Changed("org")
+	if opts.Team != "" {
+		cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+	}
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if (opts.OrgTokens || opts.Team != "") && opts.Org == "" {
+		return cmdutil.ErrOrgRequired
+	}
+
+	if opts.OrgTokens && opts.Description != "" {
+		return fmt.Errorf("--description can't be used with organization tokens")
+	}
+
+	o := tfc.TokenCreateOptions{Description: opts.Description}
+	if opts.ExpiresIn != "" {
+		d, err := tfc.ParseTokenExpiry(opts.ExpiresIn)
+		if err != nil {
+			return err
+		}
+		at := opts.Clock.Now().Add(d)
+		o.ExpiredAt = &at
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	var (
+		token *tfc.Token
+		owner string
+	)
+	switch {
+	case opts.Team != "":
+		team, err := client.Teams.ReadByNameOrID(ctx, opts.Org, opts.Team)
+		if err != nil {
+			return fmt.Errorf("failed to read team %s: %w", opts.Team, err)
+		}
+
+		owner = "team " + team.Name
+		token, err = client.Tokens.CreateForTeam(ctx, team.ID, o)
+		if err != nil {
+			return fmt.Errorf("failed to create a token for %s: %w", owner, err)
+		}
+	case opts.OrgTokens:
+		owner = "organization " + opts.Org
+
+		existing, err := client.Tokens.ListForOrganization(ctx, opts.Org)
+		if err != nil {
+			return fmt.Errorf("failed to read the token of %s: %w", owner, err)
+		}
+		if len(existing) > 0 && !opts.Yes {
+			ok, err := opts.confirmReplace(owner)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				fmt.Fprintln(opts.IO.Out, "Create cancelled")
+				return nil
+			}
+		}
+
+		token, err = client.Tokens.CreateForOrganization(ctx, opts.Org, o)
+		if err != nil {
+			return fmt.Errorf("failed to create a token for %s: %w", owner, err)
+		}
+	default:
+		owner = "your user"
+		token, err = client.Tokens.CreateForCurrentUser(ctx, o)
+		if err != nil {
+			return fmt.Errorf("failed to create a token: %w", err)
+		}
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "Created token %s for %s, it won't be shown again\n", token.ID, owner)
+	fmt.Fprintln(opts.IO.Out, token.Token)
+
+	return nil
+}
+
+// confirmReplace asks whether to replace the existing token of an
+// organization.
+func (opts *Options) confirmReplace(owner string) (bool, error) {
+	prompter := opts.Prompter()
+	if !prompter.CanPrompt() {
+		return false, fmt.Errorf("confirmation required: use --yes to replace the token of %s without prompting", owner)
+	}
+
+	return prompter.Confirm(fmt.Sprintf("Replace the token of %s? The existing token stops working.", owner))
+}
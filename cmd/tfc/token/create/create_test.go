@@ -0,0 +1,157 @@
+package create_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/zkhvan/tfc/cmd/tfc/token/create"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/clock"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+var referenceTime = time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+func TestCreate(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/account/details",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "user-1", "type": "users", "attributes": {"username": "alice"}}}`)
+		},
+	)
+
+	var body string
+	mux.HandleFunc(
+		"POST /api/v2/users/user-1/authentication-tokens",
+		func(w http.ResponseWriter, r *http.Request) {
+			b, _ := io.ReadAll(r.Body)
+			body = string(b)
+
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"data": {"id": "at-1", "type": "authentication-tokens", "attributes": {"token": "secret"}}}`)
+		},
+	)
+
+	result := runCommand(t, client, "--description", "laptop", "--expires-in", "30d")
+
+	test.Buffer(t, result.OutBuf, "secret\n")
+	test.Buffer(t, result.ErrBuf, "Created token at-1 for your user, it won't be shown again\n")
+
+	want := `{"data":{"type":"","attributes":{"description":"laptop","expired-at":"2000-01-31T12:00:00Z"}}}` + "\n"
+	if body != want {
+		t.Errorf("got body %s, want %s", body, want)
+	}
+}
+
+func TestCreate_organization(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/authentication-token",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "at-old", "type": "authentication-tokens"}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"POST /api/v2/organizations/myorg/authentication-token",
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"data": {"id": "at-new", "type": "authentication-tokens", "attributes": {"token": "secret"}}}`)
+		},
+	)
+
+	result := runCommand(t, client, "--org", "myorg", "--yes")
+
+	test.Buffer(t, result.OutBuf, "secret\n")
+	test.Buffer(t, result.ErrBuf, "Created token at-new for organization myorg, it won't be shown again\n")
+}
+
+func TestCreate_organization_requires_confirmation(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/authentication-token",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "at-old", "type": "authentication-tokens"}}`)
+		},
+	)
+
+	created := false
+	mux.HandleFunc(
+		"POST /api/v2/organizations/myorg/authentication-token",
+		func(w http.ResponseWriter, _ *http.Request) {
+			created = true
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"data": {"id": "at-new", "type": "authentication-tokens", "attributes": {"token": "secret"}}}`)
+		},
+	)
+
+	result := runCommand(t, client, "--org", "myorg")
+
+	test.BufferEmpty(t, result.OutBuf)
+	test.Buffer(t, result.ErrBuf,
+		"confirmation required: use --yes to replace the token of organization myorg without prompting\n",
+	)
+
+	if created {
+		t.Error("replaced the token of the organization without confirmation")
+	}
+}
+
+func TestCreate_invalid_expiry(t *testing.T) {
+	client, _, teardown := tfetest.Setup()
+	defer teardown()
+
+	result := runCommand(t, client, "--expires-in", "soon")
+
+	test.BufferEmpty(t, result.OutBuf)
+	test.Buffer(t, result.ErrBuf, "invalid expiry \"soon\": use a duration such as 12h, 30d or 2w\n")
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+		Clock:           cmdutil.NewClock(clock.FrozenClock(referenceTime)),
+		Prompter:        func() *cmdutil.Prompter { return cmdutil.NewPrompter(ios) },
+	}
+
+	cmd := create.NewCmdCreate(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
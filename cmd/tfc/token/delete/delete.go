@@ -0,0 +1,156 @@
+package delete
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+	Prompter        func() *cmdutil.Prompter
+
+	Org       string
+	Team      string
+	OrgTokens bool
+	ID        string
+	Yes       bool
+}
+
+func NewCmdDelete(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+		Prompter:        f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete [<ID>]",
+		Short: "Delete an API token",
+		Long: text.Heredoc(`
+			Delete an API token of the authenticated user, of a team with
+			--team, or the token of an organization with --org.
+
+			Anything still using the token loses access right away.
+		`),
+		Example: text.Heredoc(`
+			# Delete one of your tokens
+			$ tfc tokens delete at-abc123
+
+			# Delete a token of a team
+			$ tfc tokens delete at-abc123 --team ci --org myorg
+
+			# Delete the token of an organization without confirmation
+			$ tfc tokens delete --org myorg --yes
+		`),
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+
+	cmd.Flags().StringVarP(&opts.Team, "team", "t", "", "Delete a token of a team, by name or ID")
+	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Delete the token without asking for confirmation")
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, args []string) {
+	if len(args) > 0 {
+		opts.ID = args[0]
+	}
+	opts.OrgTokens = opts.Team == "" && cmd.Flags().Changed("org")
+	if opts.Team != "" {
+		cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+	}
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if (opts.OrgTokens || opts.Team != "") && opts.Org == "" {
+		return cmdutil.ErrOrgRequired
+	}
+
+	switch {
+	case opts.OrgTokens && opts.ID != "":
+		return fmt.Errorf("an organization has a single token: don't pass a token ID with --org")
+	case !opts.OrgTokens && opts.ID == "":
+		return fmt.Errorf("token ID required: use `tfc tokens list` to find it")
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	var (
+		team  *tfc.Team
+		label = "token " + opts.ID
+	)
+	switch {
+	case opts.Team != "":
+		team, err = client.Teams.ReadByNameOrID(ctx, opts.Org, opts.Team)
+		if err != nil {
+			return fmt.Errorf("failed to read team %s: %w", opts.Team, err)
+		}
+		label = fmt.Sprintf("token %s of team %s", opts.ID, team.Name)
+
+		tokens, err := client.Tokens.ListForTeam(ctx, opts.Org, team.ID)
+		if err != nil {
+			return fmt.Errorf("failed to list tokens for team %s: %w", team.Name, err)
+		}
+		if !slices.ContainsFunc(tokens, func(t *tfc.Token) bool { return t.ID == opts.ID }) {
+			return fmt.Errorf("team %s has no token %s", team.Name, opts.ID)
+		}
+	case opts.OrgTokens:
+		label = "the token of organization " + opts.Org
+	}
+
+	if !opts.Yes {
+		prompter := opts.Prompter()
+		if !prompter.CanPrompt() {
+			return fmt.Errorf("confirmation required: use --yes to delete the token without prompting")
+		}
+
+		ok, err := prompter.Confirm(fmt.Sprintf("Delete %s?", label))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Fprintln(opts.IO.Out, "Delete cancelled")
+			return nil
+		}
+	}
+
+	switch {
+	case team != nil:
+		err = client.Tokens.DeleteForTeam(ctx, opts.ID)
+	case opts.OrgTokens:
+		err = client.Tokens.DeleteForOrganization(ctx, opts.Org)
+	default:
+		err = client.Tokens.DeleteForUser(ctx, opts.ID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete %s: %w", label, err)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Deleted %s\n", label)
+
+	return nil
+}
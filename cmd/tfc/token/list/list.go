@@ -0,0 +1,187 @@
+package list
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+const (
+	ColumnID          string = "ID"
+	ColumnDescription string = "DESCRIPTION"
+	ColumnCreated     string = "CREATED"
+	ColumnLastUsed    string = "LAST_USED"
+	ColumnExpires     string = "EXPIRES"
+)
+
+var (
+	ColumnsDefault = []string{
+		ColumnID,
+		ColumnDescription,
+		ColumnCreated,
+		ColumnLastUsed,
+		ColumnExpires,
+	}
+	ColumnsAll = ColumnsDefault
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+	Clock           *cmdutil.Clock
+
+	Org       string
+	Team      string
+	OrgTokens bool
+	Columns   []string
+	Format    string
+}
+
+func NewCmdList(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+		Clock:           f.Clock,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List API tokens",
+		Aliases: []string{"ls"},
+		Long: text.Heredoc(`
+			List the API tokens of the authenticated user, of a team with
+			--team, or of an organization with --org.
+
+			The secret value of a token is only shown when it's created.
+
+			With --team, if --org is not specified and state.tf is present,
+			the organization will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# List your tokens
+			$ tfc tokens list
+
+			# List the tokens of a team
+			$ tfc tokens list --team ci --org myorg
+
+			# Show the token of an organization
+			$ tfc tokens list --org myorg
+		`),
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+	cmdutil.AddFormatFlag(cmd, &opts.Format)
+
+	cmd.Flags().StringVarP(&opts.Team, "team", "t", "", "List the tokens of a team, by name or ID")
+	_ = cmdutil.FlagStringEnumSliceP(cmd, &opts.Columns, "columns", "c", ColumnsDefault, "Columns to show.", ColumnsAll)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, _ []string) {
+	opts.OrgTokens = opts.Team == "" && cmd.Flags().Changed("org")
+	if opts.Team != "" {
+		cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+	}
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := cmdutil.ValidateEnum("format", opts.Format, []string{cmdutil.FormatTable, cmdutil.FormatJSON}); err != nil {
+		return err
+	}
+
+	if (opts.OrgTokens || opts.Team != "") && opts.Org == "" {
+		return cmdutil.ErrOrgRequired
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	var tokens []*tfc.Token
+	switch {
+	case opts.Team != "":
+		team, err := client.Teams.ReadByNameOrID(ctx, opts.Org, opts.Team)
+		if err != nil {
+			return fmt.Errorf("failed to read team %s: %w", opts.Team, err)
+		}
+
+		tokens, err = client.Tokens.ListForTeam(ctx, opts.Org, team.ID)
+		if err != nil {
+			return fmt.Errorf("failed to list tokens for team %s: %w", team.Name, err)
+		}
+	case opts.OrgTokens:
+		tokens, err = client.Tokens.ListForOrganization(ctx, opts.Org)
+		if err != nil {
+			return fmt.Errorf("failed to read the token of %s: %w", opts.Org, err)
+		}
+	default:
+		tokens, err = client.Tokens.ListForCurrentUser(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list tokens: %w", err)
+		}
+	}
+
+	if opts.Format == cmdutil.FormatJSON {
+		if tokens == nil {
+			tokens = []*tfc.Token{}
+		}
+		return cmdutil.PrintJSON(opts.IO, tokens)
+	}
+
+	p := cmdutil.FieldPrinter(opts.IO, opts.Columns...)
+	for _, t := range tokens {
+		p.Write(opts.extractFields(t))
+	}
+	p.Flush()
+
+	return nil
+}
+
+func (opts *Options) extractFields(t *tfc.Token) map[string]string {
+	now := opts.Clock.Now()
+
+	lastUsed := "never"
+	if !t.LastUsedAt.IsZero() {
+		lastUsed = text.RelativeTimeAgo(now, t.LastUsedAt)
+	}
+
+	return map[string]string{
+		ColumnID:          t.ID,
+		ColumnDescription: t.Description,
+		ColumnCreated:     text.RelativeTimeAgo(now, t.CreatedAt),
+		ColumnLastUsed:    lastUsed,
+		ColumnExpires:     formatExpiry(now, t.ExpiredAt),
+	}
+}
+
+// formatExpiry formats when a token expires.
+func formatExpiry(now, at time.Time) string {
+	switch {
+	case at.IsZero():
+		return "never"
+	case !at.After(now):
+		return at.Format(time.DateOnly) + " (expired)"
+	default:
+		return at.Format(time.DateOnly)
+	}
+}
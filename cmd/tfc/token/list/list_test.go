@@ -0,0 +1,150 @@
+package list_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/zkhvan/tfc/cmd/tfc/token/list"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/clock"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+var referenceTime = time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+func TestList(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/account/details",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "user-1", "type": "users", "attributes": {"username": "alice"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/users/user-1/authentication-tokens",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "at-1",
+							"type": "authentication-tokens",
+							"attributes": {
+								"description": "laptop",
+								"created-at": "1999-12-01T12:00:00Z",
+								"last-used-at": "2000-01-01T10:00:00Z",
+								"expired-at": "2000-02-01T00:00:00Z"
+							}
+						},
+						{
+							"id": "at-2",
+							"type": "authentication-tokens",
+							"attributes": {
+								"description": "old",
+								"created-at": "1998-12-01T12:00:00Z",
+								"expired-at": "1999-06-01T00:00:00Z"
+							}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	result := runCommand(t, client)
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		ID    DESCRIPTION  CREATED            LAST_USED          EXPIRES
+		at-1  laptop       about 1 month ago  about 2 hours ago  2000-02-01
+		at-2  old          about 1 year ago   never              1999-06-01 (expired)
+	`))
+}
+
+func TestList_team(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/teams/team-1",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "team-1", "type": "teams", "attributes": {"name": "ci"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/team-tokens",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "at-1",
+							"type": "authentication-tokens",
+							"attributes": {"description": "github", "created-at": "1999-12-31T12:00:00Z"},
+							"relationships": {"team": {"data": {"id": "team-1", "type": "teams"}}}
+						},
+						{
+							"id": "at-2",
+							"type": "authentication-tokens",
+							"attributes": {"description": "other team", "created-at": "1999-12-31T12:00:00Z"},
+							"relationships": {"team": {"data": {"id": "team-2", "type": "teams"}}}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	result := runCommand(t, client, "--team", "team-1", "--org", "myorg")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		ID    DESCRIPTION  CREATED          LAST_USED  EXPIRES
+		at-1  github       about 1 day ago  never      never
+	`))
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+		Clock:           cmdutil.NewClock(clock.FrozenClock(referenceTime)),
+	}
+
+	cmd := list.NewCmdList(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
@@ -0,0 +1,224 @@
+package rotate
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/credentials"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type Options struct {
+	IO                 *iolib.IOStreams
+	TFEClient          func() (*tfc.Client, error)
+	TFEClientWithToken func(token string) (*tfc.Client, error)
+	TerraformConfig    func() *tfconfig.TerraformConfig
+	Clock              *cmdutil.Clock
+
+	Org               string
+	Team              string
+	TokenID           string
+	Description       string
+	ExpiresIn         string
+	UpdateCredentials bool
+	Hostname          string
+}
+
+func NewCmdRotate(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:                 f.IOStreams,
+		TFEClient:          f.TFEClient,
+		TFEClientWithToken: f.TFEClientWithToken,
+		TerraformConfig:    f.TerraformConfig,
+		Clock:              f.Clock,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "rotate --team <TEAM>",
+		Short: "Replace the token of a team with a new one",
+		Long: text.Heredoc(`
+			Replace the token of a team with a new one.
+
+			A new token is created and checked against the API. With
+			--update-credentials it's then written to the Terraform
+			credentials file (credentials.tfrc.json) for the host, otherwise
+			it's printed to standard output. The old token is only revoked
+			once all of this succeeded, so a failed rotation leaves the old
+			token working.
+
+			The new token keeps the description of the old one unless
+			--description is specified. A legacy token without a description
+			is replaced by a token described as "Rotated from ID", since a
+			new legacy token would revoke the old one immediately. When the
+			team has several tokens, choose the one to rotate with --token.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Rotate the token of a team and print the new one
+			$ tfc tokens rotate --team ci --org myorg
+
+			# Rotate the token used by this machine
+			$ tfc tokens rotate --team ci --org myorg --update-credentials
+
+			# Rotate one of the tokens of a team, expiring in 90 days
+			$ tfc tokens rotate --team ci --org myorg --token at-abc123 --expires-in 90d
+		`),
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+
+	cmd.Flags().StringVarP(&opts.Team, "team", "t", "", "Team whose token is rotated, by name or ID")
+	cmd.Flags().StringVar(&opts.TokenID, "token", "", "ID of the token to rotate, when the team has several")
+	cmd.Flags().StringVarP(&opts.Description, "description", "d", "", "Description of the new token")
+	cmd.Flags().StringVar(&opts.ExpiresIn, "expires-in", "",
+		"Expire the new token after a duration, such as 12h, 30d or 2w",
+	)
+	cmd.Flags().BoolVar(&opts.UpdateCredentials, "update-credentials", false,
+		"Write the new token to the Terraform credentials file instead of printing it",
+	)
+	cmd.Flags().StringVar(&opts.Hostname, "hostname", "",
+		"Host of the credentials to update (default: $TFE_HOSTNAME or app.terraform.io)",
+	)
+
+	_ = cmd.MarkFlagRequired("team")
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, _ []string) {
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+
+	if opts.Hostname == "" {
+		opts.Hostname = os.Getenv("TFE_HOSTNAME")
+	}
+	if opts.Hostname == "" {
+		opts.Hostname = "app.terraform.io"
+	}
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if opts.Org == "" {
+		return cmdutil.ErrOrgRequired
+	}
+
+	o := tfc.TokenCreateOptions{Description: opts.Description}
+	if opts.ExpiresIn != "" {
+		d, err := tfc.ParseTokenExpiry(opts.ExpiresIn)
+		if err != nil {
+			return err
+		}
+		at := opts.Clock.Now().Add(d)
+		o.ExpiredAt = &at
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	team, err := client.Teams.ReadByNameOrID(ctx, opts.Org, opts.Team)
+	if err != nil {
+		return fmt.Errorf("failed to read team %s: %w", opts.Team, err)
+	}
+
+	tokens, err := client.Tokens.ListForTeam(ctx, opts.Org, team.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list tokens for team %s: %w", team.Name, err)
+	}
+
+	old, err := opts.findToken(team, tokens)
+	if err != nil {
+		return err
+	}
+
+	if o.Description == "" {
+		o.Description = old.Description
+	}
+
+	// A team token created without a description is a legacy token, which
+	// replaces the legacy token of the team on creation. That would revoke
+	// the old token before the new one is verified.
+	if o.Description == "" {
+		o.Description = fmt.Sprintf("Rotated from %s", old.ID)
+	}
+
+	token, err := client.Tokens.CreateForTeam(ctx, team.ID, o)
+	if err != nil {
+		return fmt.Errorf("failed to create a token for team %s: %w", team.Name, err)
+	}
+	fmt.Fprintf(opts.IO.ErrOut, "Created token %s for team %s\n", token.ID, team.Name)
+
+	if err := opts.verify(ctx, token); err != nil {
+		return fmt.Errorf("failed to verify the new token %s, the old token %s was kept: %w", token.ID, old.ID, err)
+	}
+	fmt.Fprintf(opts.IO.ErrOut, "Verified token %s\n", token.ID)
+
+	if opts.UpdateCredentials {
+		if err := credentials.SetTokenForHost(opts.Hostname, token.Token); err != nil {
+			// Print the token so the verified token isn't lost.
+			fmt.Fprintln(opts.IO.Out, token.Token)
+			return fmt.Errorf("failed to update the credentials for %s, the old token %s was kept: %w",
+				opts.Hostname, old.ID, err)
+		}
+		fmt.Fprintf(opts.IO.ErrOut, "Updated the credentials for %s\n", opts.Hostname)
+	} else {
+		fmt.Fprintln(opts.IO.Out, token.Token)
+	}
+
+	if err := client.Tokens.DeleteForTeam(ctx, old.ID); err != nil {
+		return fmt.Errorf("failed to revoke the old token %s: %w", old.ID, err)
+	}
+	fmt.Fprintf(opts.IO.ErrOut, "Revoked token %s\n", old.ID)
+
+	return nil
+}
+
+// findToken returns the token of the team to rotate.
+func (opts *Options) findToken(team *tfc.Team, tokens []*tfc.Token) (*tfc.Token, error) {
+	if opts.TokenID != "" {
+		for _, t := range tokens {
+			if t.ID == opts.TokenID {
+				return t, nil
+			}
+		}
+		return nil, fmt.Errorf("team %s has no token %s", team.Name, opts.TokenID)
+	}
+
+	switch len(tokens) {
+	case 0:
+		return nil, fmt.Errorf("team %s has no token to rotate: use `tfc tokens create --team %s` to create one",
+			team.Name, team.Name)
+	case 1:
+		return tokens[0], nil
+	default:
+		return nil, fmt.Errorf("team %s has %d tokens: use --token to choose the one to rotate",
+			team.Name, len(tokens))
+	}
+}
+
+// verify checks that the API accepts the token.
+func (opts *Options) verify(ctx context.Context, token *tfc.Token) error {
+	client, err := opts.TFEClientWithToken(token.Token)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Users.ReadCurrent(ctx)
+	return err
+}
@@ -0,0 +1,351 @@
+package rotate_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+
+	"github.com/zkhvan/tfc/cmd/tfc/token/rotate"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/credentials"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+func TestRotate_update_credentials(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the credentials file is in AppData on Windows")
+	}
+	t.Setenv("HOME", t.TempDir())
+
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	var events []string
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/teams",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [{"id": "team-1", "type": "teams", "attributes": {"name": "ci"}}]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/team-tokens",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "at-old",
+							"type": "authentication-tokens",
+							"attributes": {"description": "github"},
+							"relationships": {"team": {"data": {"id": "team-1", "type": "teams"}}}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc(
+		"POST /api/v2/teams/team-1/authentication-tokens",
+		func(w http.ResponseWriter, r *http.Request) {
+			b, _ := io.ReadAll(r.Body)
+			if !strings.Contains(string(b), `"description":"github"`) {
+				t.Errorf("got body %s", b)
+			}
+
+			events = append(events, "create")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"data": {"id": "at-new", "type": "authentication-tokens", "attributes": {"token": "secret"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"DELETE /api/v2/authentication-tokens/at-old",
+		func(w http.ResponseWriter, _ *http.Request) {
+			events = append(events, "delete")
+			w.WriteHeader(http.StatusNoContent)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/account/details",
+		func(w http.ResponseWriter, r *http.Request) {
+			if got := r.Header.Get("Authorization"); got != "Bearer secret" {
+				t.Errorf("got authorization %q, want the new token", got)
+			}
+
+			events = append(events, "verify")
+			fmt.Fprint(w, `{"data": {"id": "user-1", "type": "users", "attributes": {"username": "api-team_1"}}}`)
+		},
+	)
+
+	result := runCommand(t, client,
+		"--team", "ci", "--org", "myorg", "--update-credentials", "--hostname", "tfe.example.com",
+	)
+
+	test.BufferEmpty(t, result.OutBuf)
+	test.Buffer(t, result.ErrBuf, text.Heredoc(`
+		Created token at-new for team ci
+		Verified token at-new
+		Updated the credentials for tfe.example.com
+		Revoked token at-old
+	`))
+	test.StringSlice(t, events, []string{"create", "verify", "delete"})
+
+	token, err := credentials.GetTokenForHost("tfe.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "secret" {
+		t.Errorf("got token %q in the credentials file, want %q", token, "secret")
+	}
+}
+
+func TestRotate_verification_failed(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	var events []string
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/teams",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [{"id": "team-1", "type": "teams", "attributes": {"name": "ci"}}]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/team-tokens",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "at-old",
+							"type": "authentication-tokens",
+							"attributes": {"description": "github"},
+							"relationships": {"team": {"data": {"id": "team-1", "type": "teams"}}}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc(
+		"POST /api/v2/teams/team-1/authentication-tokens",
+		func(w http.ResponseWriter, r *http.Request) {
+			b, _ := io.ReadAll(r.Body)
+			if !strings.Contains(string(b), `"description":"github"`) {
+				t.Errorf("got body %s", b)
+			}
+
+			events = append(events, "create")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"data": {"id": "at-new", "type": "authentication-tokens", "attributes": {"token": "secret"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"DELETE /api/v2/authentication-tokens/at-old",
+		func(w http.ResponseWriter, _ *http.Request) {
+			events = append(events, "delete")
+			w.WriteHeader(http.StatusNoContent)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/account/details",
+		func(w http.ResponseWriter, _ *http.Request) {
+			events = append(events, "verify")
+			w.WriteHeader(http.StatusUnauthorized)
+		},
+	)
+
+	result := runCommand(t, client, "--team", "ci", "--org", "myorg", "--update-credentials")
+
+	test.BufferEmpty(t, result.OutBuf)
+	test.Buffer(t, result.ErrBuf, text.Heredoc(`
+		Created token at-new for team ci
+		failed to verify the new token at-new, the old token at-old was kept: unauthorized
+	`))
+	test.StringSlice(t, events, []string{"create", "verify"})
+
+	if _, err := os.Stat(filepath.Join(home, ".terraform.d")); !os.IsNotExist(err) {
+		t.Errorf("got the credentials written before the token was verified")
+	}
+}
+
+func TestRotate_legacy_token(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	var events []string
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/teams",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [{"id": "team-1", "type": "teams", "attributes": {"name": "ci"}}]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/team-tokens",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "at-old",
+							"type": "authentication-tokens",
+							"attributes": {"description": null},
+							"relationships": {"team": {"data": {"id": "team-1", "type": "teams"}}}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc(
+		"POST /api/v2/teams/team-1/authentication-tokens",
+		func(w http.ResponseWriter, r *http.Request) {
+			b, _ := io.ReadAll(r.Body)
+			if !strings.Contains(string(b), `"description":"Rotated from at-old"`) {
+				t.Errorf("got body %s", b)
+			}
+
+			events = append(events, "create")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"data": {"id": "at-new", "type": "authentication-tokens", "attributes": {"token": "secret"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"DELETE /api/v2/authentication-tokens/at-old",
+		func(w http.ResponseWriter, _ *http.Request) {
+			events = append(events, "delete")
+			w.WriteHeader(http.StatusNoContent)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/account/details",
+		func(w http.ResponseWriter, _ *http.Request) {
+			events = append(events, "verify")
+			fmt.Fprint(w, `{"data": {"id": "user-1", "type": "users", "attributes": {"username": "api-team_1"}}}`)
+		},
+	)
+
+	result := runCommand(t, client, "--team", "ci", "--org", "myorg")
+
+	test.Buffer(t, result.OutBuf, "secret\n")
+	test.Buffer(t, result.ErrBuf, text.Heredoc(`
+		Created token at-new for team ci
+		Verified token at-new
+		Revoked token at-old
+	`))
+	test.StringSlice(t, events, []string{"create", "verify", "delete"})
+}
+
+func TestRotate_several_tokens(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/teams/team-1",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "team-1", "type": "teams", "attributes": {"name": "ci"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/team-tokens",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "at-1",
+							"type": "authentication-tokens",
+							"relationships": {"team": {"data": {"id": "team-1", "type": "teams"}}}
+						},
+						{
+							"id": "at-2",
+							"type": "authentication-tokens",
+							"relationships": {"team": {"data": {"id": "team-1", "type": "teams"}}}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	result := runCommand(t, client, "--team", "team-1", "--org", "myorg")
+
+	test.BufferEmpty(t, result.OutBuf)
+	test.Buffer(t, result.ErrBuf, "team ci has 2 tokens: use --token to choose the one to rotate\n")
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	baseURL := client.BaseURL()
+
+	f := &cmdutil.Factory{
+		IOStreams: ios,
+		TFEClient: func() (*tfc.Client, error) { return client, nil },
+		TFEClientWithToken: func(token string) (*tfc.Client, error) {
+			c, err := tfe.NewClient(&tfe.Config{
+				Address: baseURL.Scheme + "://" + baseURL.Host,
+				Token:   token,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return tfc.NewClient(c), nil
+		},
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+		Clock:           cmdutil.NewClock(nil),
+	}
+
+	cmd := rotate.NewCmdRotate(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
@@ -0,0 +1,34 @@
+package token
+
+import (
+	"github.com/spf13/cobra"
+
+	createCmd "github.com/zkhvan/tfc/cmd/tfc/token/create"
+	deleteCmd "github.com/zkhvan/tfc/cmd/tfc/token/delete"
+	listCmd "github.com/zkhvan/tfc/cmd/tfc/token/list"
+	rotateCmd "github.com/zkhvan/tfc/cmd/tfc/token/rotate"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+func NewCmdToken(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "tokens",
+		Aliases: []string{"token"},
+		Short:   "Manage API tokens",
+		Long: text.Heredoc(`
+			Manage API tokens.
+
+			By default the commands work on the tokens of the authenticated
+			user. Use --team for the tokens of a team, and --org alone for
+			the token of an organization.
+		`),
+	}
+
+	cmd.AddCommand(listCmd.NewCmdList(f))
+	cmd.AddCommand(createCmd.NewCmdCreate(f))
+	cmd.AddCommand(deleteCmd.NewCmdDelete(f))
+	cmd.AddCommand(rotateCmd.NewCmdRotate(f))
+
+	return cmd
+}
@@ -0,0 +1,122 @@
+package apply_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/zkhvan/tfc/cmd/tfc/varset/apply"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+func TestApply(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/varsets/varset-1",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"varset-1","type":"varsets","attributes":{"name":"aws-credentials"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"data":{"id":"ws-%s","type":"workspaces","attributes":{"name":%q}}}`,
+				r.PathValue("workspace"), r.PathValue("workspace"),
+			)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/projects",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":[{"id":"prj-1","type":"projects","attributes":{"name":"platform"}}]}`)
+		},
+	)
+
+	var requests []string
+	record := func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Data []struct {
+				ID string `json:"id"`
+			} `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			requests = append(requests, r.Method+" "+r.URL.Path+" invalid request: "+err.Error())
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var ids []string
+		for _, d := range body.Data {
+			ids = append(ids, d.ID)
+		}
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+strings.Join(ids, ","))
+		w.WriteHeader(http.StatusNoContent)
+	}
+	mux.HandleFunc("POST /api/v2/varsets/varset-1/relationships/workspaces", record)
+	mux.HandleFunc("POST /api/v2/varsets/varset-1/relationships/projects", record)
+
+	result := runCommand(t, client, "varset-1", "--org", "myorg", "-w", "app-dev,app-prod", "--project", "platform")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf,
+		"Applied variable set aws-credentials to workspace app-dev, workspace app-prod, project platform\n",
+	)
+
+	test.StringSlice(t, requests, []string{
+		"POST /api/v2/varsets/varset-1/relationships/workspaces ws-app-dev,ws-app-prod",
+		"POST /api/v2/varsets/varset-1/relationships/projects prj-1",
+	})
+}
+
+func TestApply_target_required(t *testing.T) {
+	client, _, teardown := tfetest.Setup()
+	defer teardown()
+
+	result := runCommand(t, client, "varset-1", "--org", "myorg")
+
+	test.Buffer(t, result.ErrBuf, "at least one of the flags in the group [workspace project] is required\n")
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+	}
+
+	cmd := apply.NewCmdApply(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
@@ -0,0 +1,104 @@
+package create
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/ptr"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	Org         string
+	Name        string
+	Description string
+	Global      bool
+	Priority    bool
+}
+
+func NewCmdCreate(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create <NAME>",
+		Short: "Create a variable set",
+		Long: text.Heredoc(`
+			Create a variable set.
+
+			A --global variable set is applied to every workspace of the
+			organization. Variables of a --priority variable set override
+			the variables of the workspaces it's applied to.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Create a variable set
+			$ tfc varsets create aws-credentials --org myorg --description "AWS credentials"
+
+			# Create a global variable set
+			$ tfc varsets create defaults --org myorg --global
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+
+	cmd.Flags().StringVarP(&opts.Description, "description", "d", "", "Variable set description")
+	cmd.Flags().BoolVar(&opts.Global, "global", false, "Apply the variable set to every workspace")
+	cmd.Flags().BoolVar(&opts.Priority, "priority", false, "Override the variables of the workspaces")
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, args []string) {
+	opts.Name = args[0]
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if opts.Org == "" {
+		return cmdutil.ErrOrgRequired
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	vs, err := client.VariableSets.Create(ctx, opts.Org, tfe.VariableSetCreateOptions{
+		Name:        ptr.String(opts.Name),
+		Description: ptr.String(opts.Description),
+		Global:      ptr.Bool(opts.Global),
+		Priority:    ptr.Bool(opts.Priority),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create variable set %s: %w", opts.Name, err)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Created variable set %s (%s)\n", vs.Name, vs.ID)
+
+	return nil
+}
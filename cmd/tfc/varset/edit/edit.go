@@ -0,0 +1,129 @@
+package edit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	Org         string
+	Identifier  string // Variable set name or ID
+	Name        *string
+	Description *string
+	Global      *bool
+	Priority    *bool
+}
+
+func NewCmdEdit(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	var (
+		name        string
+		description string
+		global      bool
+		priority    bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "edit <NAME|ID>",
+		Short: "Edit a variable set",
+		Long: text.Heredoc(`
+			Edit the settings of a variable set.
+
+			Only the settings of the given flags are changed.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Rename a variable set
+			$ tfc varsets edit aws-credentials --org myorg --name aws-prod-credentials
+
+			# Stop applying a variable set to every workspace
+			$ tfc varsets edit varset-abc123 --global=false
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			if flags.Changed("name") {
+				opts.Name = &name
+			}
+			if flags.Changed("description") {
+				opts.Description = &description
+			}
+			if flags.Changed("global") {
+				opts.Global = &global
+			}
+			if flags.Changed("priority") {
+				opts.Priority = &priority
+			}
+
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+
+	cmd.Flags().StringVarP(&name, "name", "n", "", "New variable set name")
+	cmd.Flags().StringVarP(&description, "description", "d", "", "Variable set description")
+	cmd.Flags().BoolVar(&global, "global", false, "Apply the variable set to every workspace")
+	cmd.Flags().BoolVar(&priority, "priority", false, "Override the variables of the workspaces")
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, args []string) {
+	opts.Identifier = args[0]
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if opts.Name == nil && opts.Description == nil && opts.Global == nil && opts.Priority == nil {
+		return fmt.Errorf("nothing to edit: use --name, --description, --global or --priority")
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	vs, err := client.VariableSets.ReadByNameOrID(ctx, opts.Org, opts.Identifier)
+	if err != nil {
+		return fmt.Errorf("failed to read variable set %s: %w", opts.Identifier, err)
+	}
+
+	vs, err = client.VariableSets.Update(ctx, vs.ID, tfe.VariableSetUpdateOptions{
+		Name:        opts.Name,
+		Description: opts.Description,
+		Global:      opts.Global,
+		Priority:    opts.Priority,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update variable set %s: %w", opts.Identifier, err)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Updated variable set %s (%s)\n", vs.Name, vs.ID)
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package list
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+const (
+	ColumnID          string = "ID"
+	ColumnName        string = "NAME"
+	ColumnDescription string = "DESCRIPTION"
+	ColumnGlobal      string = "GLOBAL"
+	ColumnPriority    string = "PRIORITY"
+	ColumnWorkspaces  string = "WORKSPACES"
+	ColumnProjects    string = "PROJECTS"
+	ColumnVariables   string = "VARIABLES"
+)
+
+var (
+	ColumnsDefault = []string{
+		ColumnName,
+		ColumnGlobal,
+		ColumnPriority,
+		ColumnWorkspaces,
+		ColumnProjects,
+	}
+	ColumnsAll = []string{
+		ColumnID,
+		ColumnName,
+		ColumnDescription,
+		ColumnGlobal,
+		ColumnPriority,
+		ColumnWorkspaces,
+		ColumnProjects,
+		ColumnVariables,
+	}
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	Org     string
+	Name    string
+	Limit   int
+	Columns []string
+}
+
+func NewCmdList(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List variable sets",
+		Aliases: []string{"ls"},
+		Long: text.Heredoc(`
+			List the variable sets of an organization.
+
+			WORKSPACES and PROJECTS are the number of workspaces and projects
+			the variable set is applied to.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+	cmd.Flags().StringVarP(&opts.Name, "name", "n", "", "Search by the variable set name.")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "l", 20, "Limit the number of results.")
+	_ = cmdutil.FlagStringEnumSliceP(cmd, &opts.Columns, "columns", "c", ColumnsDefault, "Columns to show.", ColumnsAll)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, _ []string) {
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if opts.Org == "" {
+		return cmdutil.ErrOrgRequired
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	sets, pagination, err := client.VariableSets.List(ctx, opts.Org, &tfc.VariableSetListOptions{
+		ListOptions: tfc.ListOptions{Limit: opts.Limit},
+		Query:       opts.Name,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list variable sets for %s: %w", opts.Org, err)
+	}
+
+	if pagination.ReachedLimit {
+		fmt.Fprintf(opts.IO.Out, "Showing top %d results\n\n", opts.Limit)
+	}
+
+	p := cmdutil.FieldPrinter(opts.IO, opts.Columns...)
+	for _, vs := range sets {
+		p.Write(extractFields(vs))
+	}
+	p.Flush()
+
+	return nil
+}
+
+func extractFields(vs *tfe.VariableSet) map[string]string {
+	global := strconv.FormatBool(vs.Global)
+
+	// Global variable sets apply to every workspace, the relationship is
+	// empty.
+	workspaces := strconv.Itoa(len(vs.Workspaces))
+	if vs.Global {
+		workspaces = "all"
+	}
+
+	return map[string]string{
+		ColumnID:          vs.ID,
+		ColumnName:        vs.Name,
+		ColumnDescription: vs.Description,
+		ColumnGlobal:      global,
+		ColumnPriority:    strconv.FormatBool(vs.Priority),
+		ColumnWorkspaces:  workspaces,
+		ColumnProjects:    strconv.Itoa(len(vs.Projects)),
+		ColumnVariables:   strconv.Itoa(len(vs.Variables)),
+	}
+}
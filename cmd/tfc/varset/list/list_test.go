@@ -0,0 +1,106 @@
+package list_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/zkhvan/tfc/cmd/tfc/varset/list"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+func TestList(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/varsets",
+		func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("q"); got != "aws" {
+				t.Errorf("got query %q, want %q", got, "aws")
+			}
+
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "varset-1",
+							"type": "varsets",
+							"attributes": {"name": "aws-credentials", "priority": true},
+							"relationships": {
+								"workspaces": {"data": [{"id": "ws-1", "type": "workspaces"}, {"id": "ws-2", "type": "workspaces"}]},
+								"projects": {"data": [{"id": "prj-1", "type": "projects"}]}
+							}
+						},
+						{
+							"id": "varset-2",
+							"type": "varsets",
+							"attributes": {"name": "aws-defaults", "global": true},
+							"relationships": {
+								"workspaces": {"data": []},
+								"projects": {"data": []}
+							}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	result := runCommand(t, client, "--org", "myorg", "--name", "aws")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		NAME             GLOBAL  PRIORITY  WORKSPACES  PROJECTS
+		aws-credentials  false   true      2           1
+		aws-defaults     true    false     all         0
+	`))
+}
+
+func TestList_org_required(t *testing.T) {
+	client, _, teardown := tfetest.Setup()
+	defer teardown()
+
+	result := runCommand(t, client)
+
+	test.Buffer(t, result.ErrBuf, "organization required: use --org or ensure state.tf exists\n")
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+	}
+
+	cmd := list.NewCmdList(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
@@ -0,0 +1,110 @@
+package remove
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	Org        string
+	Identifier string // Variable set name or ID
+	Workspaces []string
+	Projects   []string
+}
+
+func NewCmdRemove(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "remove <NAME|ID> {--workspace <NAME> | --project <NAME>}...",
+		Short:   "Remove a variable set from workspaces and projects",
+		Aliases: []string{"rm"},
+		Long: text.Heredoc(`
+			Remove a variable set from workspaces and projects.
+
+			Workspaces and projects are identified by their names in the
+			organization, projects can also be identified by their ID.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Remove a variable set from a workspace
+			$ tfc varsets remove aws-credentials --org myorg -w app-staging
+
+			# Remove a variable set from a project
+			$ tfc varsets remove aws-credentials --org myorg --project platform
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceProjectFlags(cmd, &opts.Workspaces, &opts.Projects)
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, args []string) {
+	opts.Identifier = args[0]
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if opts.Org == "" {
+		return cmdutil.ErrOrgRequired
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	vs, err := client.VariableSets.ReadByNameOrID(ctx, opts.Org, opts.Identifier)
+	if err != nil {
+		return fmt.Errorf("failed to read variable set %s: %w", opts.Identifier, err)
+	}
+
+	targets, err := cmdutil.ResolveWorkspaceProjectTargets(ctx, client, opts.Org, opts.Workspaces, opts.Projects)
+	if err != nil {
+		return err
+	}
+
+	if len(targets.WorkspaceIDs) > 0 {
+		if err := client.VariableSets.RemoveFromWorkspaces(ctx, vs.ID, targets.WorkspaceIDs...); err != nil {
+			return fmt.Errorf("failed to remove variable set %s from workspaces: %w", vs.Name, err)
+		}
+	}
+
+	if len(targets.ProjectIDs) > 0 {
+		if err := client.VariableSets.RemoveFromProjects(ctx, vs.ID, targets.ProjectIDs...); err != nil {
+			return fmt.Errorf("failed to remove variable set %s from projects: %w", vs.Name, err)
+		}
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Removed variable set %s from %s\n", vs.Name, targets)
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package delete
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	Org        string
+	VarSet     string // Variable set name or ID
+	Identifier string // Variable name or ID
+}
+
+func NewCmdDelete(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete <VARSET> <NAME|ID>",
+		Short: "Delete a variable set's variable",
+		Long: text.Heredoc(`
+			Delete a variable from a variable set.
+
+			The variable set can be identified by either its name or ID, the
+			variable by either its name or ID.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Delete a variable by name
+			$ tfc varsets variables delete defaults region --org myorg
+
+			# Delete a variable by ID
+			$ tfc varsets variables delete varset-abc123 var-abc123
+		`),
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, args []string) {
+	opts.VarSet = args[0]
+	opts.Identifier = args[1]
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	vs, err := client.VariableSets.ReadByNameOrID(ctx, opts.Org, opts.VarSet)
+	if err != nil {
+		return fmt.Errorf("failed to read variable set %s: %w", opts.VarSet, err)
+	}
+
+	vars, err := client.VariableSets.ListVariables(ctx, vs.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list variables for %s: %w", vs.Name, err)
+	}
+
+	var targetVars []*tfc.VariableSetVariable
+	for _, v := range vars {
+		if v.ID == opts.Identifier || v.Key == opts.Identifier {
+			targetVars = append(targetVars, v)
+		}
+	}
+
+	if len(targetVars) == 0 {
+		return fmt.Errorf("variable %q not found", opts.Identifier)
+	}
+
+	// The same key can be used by a terraform and an env variable.
+	if len(targetVars) > 1 {
+		return fmt.Errorf("variable %q is ambiguous: use the ID of the variable", opts.Identifier)
+	}
+
+	if err := client.VariableSets.DeleteVariable(ctx, vs.ID, targetVars[0].ID); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Variable %q deleted successfully\n", targetVars[0].Key)
+
+	return nil
+}
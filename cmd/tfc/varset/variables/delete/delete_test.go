@@ -0,0 +1,176 @@
+package delete_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/zkhvan/tfc/cmd/tfc/varset/variables/delete"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+func TestDelete_by_name(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/varsets",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":[{"id":"varset-1","type":"varsets","attributes":{"name":"defaults"}}]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/varsets/varset-1/relationships/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{"id": "var-1", "type": "vars", "attributes": {"key": "region", "category": "env"}},
+						{"id": "var-2", "type": "vars", "attributes": {"key": "region", "category": "terraform"}},
+						{"id": "var-3", "type": "vars", "attributes": {"key": "replicas", "category": "terraform"}}
+					]
+				}
+			`)
+		},
+	)
+
+	var requests []string
+	mux.HandleFunc(
+		"DELETE /api/v2/varsets/varset-1/relationships/vars/{var_id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		},
+	)
+
+	result := runCommand(t, client, "defaults", "replicas", "--org", "myorg")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, "Variable \"replicas\" deleted successfully\n")
+	test.StringSlice(t, requests, []string{
+		"DELETE /api/v2/varsets/varset-1/relationships/vars/var-3",
+	})
+}
+
+func TestDelete_ambiguous_name(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/varsets",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":[{"id":"varset-1","type":"varsets","attributes":{"name":"defaults"}}]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/varsets/varset-1/relationships/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{"id": "var-1", "type": "vars", "attributes": {"key": "region", "category": "env"}},
+						{"id": "var-2", "type": "vars", "attributes": {"key": "region", "category": "terraform"}},
+						{"id": "var-3", "type": "vars", "attributes": {"key": "replicas", "category": "terraform"}}
+					]
+				}
+			`)
+		},
+	)
+
+	var requests []string
+	mux.HandleFunc(
+		"DELETE /api/v2/varsets/varset-1/relationships/vars/{var_id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		},
+	)
+
+	result := runCommand(t, client, "defaults", "region", "--org", "myorg")
+
+	test.Buffer(t, result.ErrBuf, "variable \"region\" is ambiguous: use the ID of the variable\n")
+	test.StringSlice(t, requests, nil)
+}
+
+func TestDelete_by_id(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/varsets",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":[{"id":"varset-1","type":"varsets","attributes":{"name":"defaults"}}]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/varsets/varset-1/relationships/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{"id": "var-1", "type": "vars", "attributes": {"key": "region", "category": "env"}},
+						{"id": "var-2", "type": "vars", "attributes": {"key": "region", "category": "terraform"}},
+						{"id": "var-3", "type": "vars", "attributes": {"key": "replicas", "category": "terraform"}}
+					]
+				}
+			`)
+		},
+	)
+
+	var requests []string
+	mux.HandleFunc(
+		"DELETE /api/v2/varsets/varset-1/relationships/vars/{var_id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		},
+	)
+
+	result := runCommand(t, client, "defaults", "var-1", "--org", "myorg")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.StringSlice(t, requests, []string{
+		"DELETE /api/v2/varsets/varset-1/relationships/vars/var-1",
+	})
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+	}
+
+	cmd := delete.NewCmdDelete(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
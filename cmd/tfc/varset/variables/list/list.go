@@ -0,0 +1,130 @@
+package list
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+const (
+	ColumnID          string = "ID"
+	ColumnKey         string = "KEY"
+	ColumnValue       string = "VALUE"
+	ColumnDescription string = "DESCRIPTION"
+	ColumnSensitive   string = "SENSITIVE"
+	ColumnCategory    string = "CATEGORY"
+	ColumnHCL         string = "HCL"
+)
+
+var (
+	ColumnsDefault = []string{
+		ColumnKey,
+		ColumnValue,
+		ColumnCategory,
+		ColumnDescription,
+	}
+	ColumnsAll = []string{
+		ColumnID,
+		ColumnKey,
+		ColumnValue,
+		ColumnDescription,
+		ColumnSensitive,
+		ColumnCategory,
+		ColumnHCL,
+	}
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	Org        string
+	Identifier string // Variable set name or ID
+	Columns    []string
+}
+
+func NewCmdList(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list <VARSET>",
+		Short:   "List a variable set's variables",
+		Aliases: []string{"ls"},
+		Long: text.Heredoc(`
+			List a variable set's variables.
+
+			The variable set can be identified by either its name or ID.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+
+	_ = cmdutil.FlagStringEnumSliceP(cmd, &opts.Columns, "columns", "c", ColumnsDefault, "Columns to show.", ColumnsAll)
+	_ = cmdutil.MarkFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, args []string) {
+	opts.Identifier = args[0]
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	vs, err := client.VariableSets.ReadByNameOrID(ctx, opts.Org, opts.Identifier)
+	if err != nil {
+		return fmt.Errorf("failed to read variable set %s: %w", opts.Identifier, err)
+	}
+
+	vars, err := client.VariableSets.ListVariables(ctx, vs.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list variables for %s: %w", vs.Name, err)
+	}
+
+	p := cmdutil.FieldPrinter(opts.IO, opts.Columns...)
+	for _, v := range vars {
+		p.Write(extractFields(v))
+	}
+	p.Flush()
+
+	return nil
+}
+
+func extractFields(v *tfc.VariableSetVariable) map[string]string {
+	return map[string]string{
+		ColumnID:          v.ID,
+		ColumnKey:         v.Key,
+		ColumnValue:       v.Value,
+		ColumnDescription: v.Description,
+		ColumnCategory:    string(v.Category),
+		ColumnHCL:         strconv.FormatBool(v.HCL),
+		ColumnSensitive:   strconv.FormatBool(v.Sensitive),
+	}
+}
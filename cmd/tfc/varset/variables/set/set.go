@@ -0,0 +1,184 @@
+package set
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/ptr"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+	"github.com/zkhvan/tfc/pkg/varfile"
+)
+
+var Categories = []string{"terraform", "env"}
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+	Prompter        func() *cmdutil.Prompter
+
+	Org         string
+	VarSet      string // Variable set name or ID
+	Identifier  string // Variable name or ID
+	Value       cmdutil.ValueFlags
+	Description string
+	Category    string
+	HCL         bool
+	Sensitive   bool
+}
+
+func NewCmdSet(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+		Prompter:        f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "set <VARSET> <NAME|ID>",
+		Short: "Set a variable set's variable",
+		Long: text.Heredoc(`
+			Set the value of a variable in a variable set.
+
+			The variable set can be identified by either its name or ID, the
+			variable by either its name and --category or its ID. If the
+			variable does not exist, it will be created.
+
+			The value can be read from standard input with --value -, from a
+			file with --value-file, or from the output of a shell command with
+			--value-from-cmd. Values of --hcl variables are checked to be
+			valid HCL expressions before they're set.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Set a terraform variable
+			$ tfc varsets variables set defaults region --org myorg --value us-east-1
+
+			# Set a sensitive environment variable
+			$ tfc varsets variables set aws-credentials AWS_SECRET_ACCESS_KEY --org myorg \
+			    --value "secret" --category env --sensitive
+
+			# Prompt for a sensitive value without echoing it
+			$ tfc varsets variables set aws-credentials AWS_SECRET_ACCESS_KEY --org myorg \
+			    --value - --category env --sensitive
+		`),
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+
+	cmdutil.AddValueFlags(cmd, &opts.Value)
+	cmd.Flags().StringVarP(&opts.Description, "description", "d", "", "Variable description")
+	_ = cmdutil.FlagStringEnum(cmd, &opts.Category, "category", "terraform", "Variable category", Categories)
+	cmd.Flags().BoolVar(&opts.HCL, "hcl", false, "Parse the value as HCL")
+	cmd.Flags().BoolVar(&opts.Sensitive, "sensitive", false, "Mark the variable as sensitive")
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, args []string) {
+	opts.VarSet = args[0]
+	opts.Identifier = args[1]
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := cmdutil.ValidateEnum("category", opts.Category, Categories); err != nil {
+		return err
+	}
+
+	value, err := opts.Value.Read(ctx, opts.IO, opts.Prompter, opts.Identifier)
+	if err != nil {
+		return err
+	}
+
+	if opts.HCL {
+		if err := varfile.ValidateHCL(opts.Identifier, value); err != nil {
+			return err
+		}
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	vs, err := client.VariableSets.ReadByNameOrID(ctx, opts.Org, opts.VarSet)
+	if err != nil {
+		return fmt.Errorf("failed to read variable set %s: %w", opts.VarSet, err)
+	}
+
+	vars, err := client.VariableSets.ListVariables(ctx, vs.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list variables for %s: %w", vs.Name, err)
+	}
+
+	category := tfe.CategoryType(opts.Category)
+
+	// A variable set can have a terraform and an env variable with the same
+	// key, so keys are matched within the category.
+	var existingVar *tfc.VariableSetVariable
+	for _, v := range vars {
+		if v.ID == opts.Identifier || (v.Key == opts.Identifier && v.Category == category) {
+			existingVar = v
+			break
+		}
+	}
+
+	if existingVar != nil {
+		updateOpts := tfe.VariableSetVariableUpdateOptions{
+			Value:     ptr.String(value),
+			HCL:       ptr.Bool(opts.HCL),
+			Sensitive: ptr.Bool(opts.Sensitive),
+		}
+
+		if opts.Description != "" {
+			updateOpts.Description = ptr.String(opts.Description)
+		}
+
+		updatedVar, err := client.VariableSets.UpdateVariable(ctx, vs.ID, existingVar.ID, updateOpts)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(opts.IO.Out, "Variable %q updated successfully\n", updatedVar.Key)
+	} else {
+		createOpts := tfe.VariableSetVariableCreateOptions{
+			Key:       ptr.String(opts.Identifier),
+			Value:     ptr.String(value),
+			Category:  &category,
+			HCL:       ptr.Bool(opts.HCL),
+			Sensitive: ptr.Bool(opts.Sensitive),
+		}
+
+		if opts.Description != "" {
+			createOpts.Description = ptr.String(opts.Description)
+		}
+
+		newVar, err := client.VariableSets.CreateVariable(ctx, vs.ID, createOpts)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(opts.IO.Out, "Variable %q created successfully\n", newVar.Key)
+	}
+
+	return nil
+}
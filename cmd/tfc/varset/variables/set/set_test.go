@@ -0,0 +1,202 @@
+package set_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/zkhvan/tfc/cmd/tfc/varset/variables/set"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+func TestSet_update_within_category(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/varsets/varset-1",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"varset-1","type":"varsets","attributes":{"name":"defaults"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/varsets/varset-1/relationships/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-1",
+							"type": "vars",
+							"attributes": {"key": "region", "value": "us-east-1", "category": "env"}
+						},
+						{
+							"id": "var-2",
+							"type": "vars",
+							"attributes": {"key": "region", "value": "us-east-1", "category": "terraform"}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	var requests []string
+	mux.HandleFunc(
+		"/api/v2/varsets/varset-1/relationships/vars/",
+		func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			fmt.Fprint(w, `{"data":{"id":"var-new","type":"vars","attributes":{"key":"region"}}}`)
+		},
+	)
+	mux.HandleFunc(
+		"POST /api/v2/varsets/varset-1/relationships/vars",
+		func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			fmt.Fprint(w, `{"data":{"id":"var-new","type":"vars","attributes":{"key":"replicas"}}}`)
+		},
+	)
+
+	result := runCommand(t, client, "varset-1", "region", "--value", "eu-west-1")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, "Variable \"region\" updated successfully\n")
+	test.StringSlice(t, requests, []string{
+		"PATCH /api/v2/varsets/varset-1/relationships/vars/var-2",
+	})
+}
+
+func TestSet_create(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/varsets/varset-1",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"varset-1","type":"varsets","attributes":{"name":"defaults"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/varsets/varset-1/relationships/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-1",
+							"type": "vars",
+							"attributes": {"key": "region", "value": "us-east-1", "category": "env"}
+						},
+						{
+							"id": "var-2",
+							"type": "vars",
+							"attributes": {"key": "region", "value": "us-east-1", "category": "terraform"}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	var requests []string
+	mux.HandleFunc(
+		"/api/v2/varsets/varset-1/relationships/vars/",
+		func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			fmt.Fprint(w, `{"data":{"id":"var-new","type":"vars","attributes":{"key":"region"}}}`)
+		},
+	)
+	mux.HandleFunc(
+		"POST /api/v2/varsets/varset-1/relationships/vars",
+		func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			fmt.Fprint(w, `{"data":{"id":"var-new","type":"vars","attributes":{"key":"replicas"}}}`)
+		},
+	)
+
+	result := runCommand(t, client, "varset-1", "replicas", "--value", "3")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, "Variable \"replicas\" created successfully\n")
+	test.StringSlice(t, requests, []string{
+		"POST /api/v2/varsets/varset-1/relationships/vars",
+	})
+}
+
+func TestSet_invalid_category(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/varsets/varset-1",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"varset-1","type":"varsets","attributes":{"name":"defaults"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/varsets/varset-1/relationships/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-1",
+							"type": "vars",
+							"attributes": {"key": "region", "value": "us-east-1", "category": "env"}
+						},
+						{
+							"id": "var-2",
+							"type": "vars",
+							"attributes": {"key": "region", "value": "us-east-1", "category": "terraform"}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	result := runCommand(t, client, "varset-1", "region", "--value", "x", "--category", "other")
+
+	test.Buffer(t, result.ErrBuf, "invalid category \"other\": must be one of terraform, env\n")
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+	}
+
+	cmd := set.NewCmdSet(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
@@ -0,0 +1,28 @@
+package variables
+
+import (
+	"github.com/spf13/cobra"
+
+	deleteCmd "github.com/zkhvan/tfc/cmd/tfc/varset/variables/delete"
+	listCmd "github.com/zkhvan/tfc/cmd/tfc/varset/variables/list"
+	setCmd "github.com/zkhvan/tfc/cmd/tfc/varset/variables/set"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+func NewCmdVariables(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "variables",
+		Short:   "Manage a variable set's variables",
+		Aliases: []string{"vars"},
+		Long: text.Heredoc(`
+			Manage a variable set's variables.
+		`),
+	}
+
+	cmd.AddCommand(listCmd.NewCmdList(f))
+	cmd.AddCommand(setCmd.NewCmdSet(f))
+	cmd.AddCommand(deleteCmd.NewCmdDelete(f))
+
+	return cmd
+}
@@ -0,0 +1,42 @@
+package varset
+
+import (
+	"github.com/spf13/cobra"
+
+	applyCmd "github.com/zkhvan/tfc/cmd/tfc/varset/apply"
+	createCmd "github.com/zkhvan/tfc/cmd/tfc/varset/create"
+	deleteCmd "github.com/zkhvan/tfc/cmd/tfc/varset/delete"
+	editCmd "github.com/zkhvan/tfc/cmd/tfc/varset/edit"
+	listCmd "github.com/zkhvan/tfc/cmd/tfc/varset/list"
+	removeCmd "github.com/zkhvan/tfc/cmd/tfc/varset/remove"
+	variablesCmd "github.com/zkhvan/tfc/cmd/tfc/varset/variables"
+	viewCmd "github.com/zkhvan/tfc/cmd/tfc/varset/view"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+func NewCmdVarset(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "varsets",
+		Aliases: []string{"varset", "vs"},
+		Short:   "Manage variable sets",
+		Long: text.Heredoc(`
+			Manage the variable sets of an organization.
+
+			Variable sets share variables across workspaces. They're applied
+			to workspaces and projects, or globally to every workspace of the
+			organization.
+		`),
+	}
+
+	cmd.AddCommand(listCmd.NewCmdList(f))
+	cmd.AddCommand(viewCmd.NewCmdView(f))
+	cmd.AddCommand(createCmd.NewCmdCreate(f))
+	cmd.AddCommand(editCmd.NewCmdEdit(f))
+	cmd.AddCommand(deleteCmd.NewCmdDelete(f))
+	cmd.AddCommand(applyCmd.NewCmdApply(f))
+	cmd.AddCommand(removeCmd.NewCmdRemove(f))
+	cmd.AddCommand(variablesCmd.NewCmdVariables(f))
+
+	return cmd
+}
@@ -0,0 +1,168 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/hashicorp/go-tfe"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	Org        string
+	Identifier string // Variable set name or ID
+}
+
+func NewCmdView(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view <NAME|ID>",
+		Short: "View variable set details",
+		Long: text.Heredoc(`
+			View detailed information about a variable set.
+
+			Displays whether the variable set is global or has priority over
+			workspace variables, the workspaces and projects it's applied to,
+			and its variables. Values of sensitive variables are hidden.
+
+			If --org is not specified and state.tf is present, the
+			organization will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# View a variable set by name
+			$ tfc varsets view aws-credentials --org myorg
+
+			# View a variable set by ID
+			$ tfc varsets view varset-abc123
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddOrgFlag(cmd, &opts.Org)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, args []string) {
+	opts.Identifier = args[0]
+	cmdutil.CompleteOrg(&opts.Org, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	vs, err := client.VariableSets.ReadByNameOrID(ctx, opts.Org, opts.Identifier,
+		tfe.VariableSetWorkspaces,
+		tfe.VariableSetProjects,
+		tfe.VariableSetVars,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to read variable set %s: %w", opts.Identifier, err)
+	}
+
+	opts.displayVariableSet(vs)
+
+	return nil
+}
+
+func (opts *Options) displayVariableSet(vs *tfc.VariableSet) {
+	out := opts.IO.Out
+
+	faintStyle := lipgloss.NewStyle().Faint(true)
+	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("6"))
+
+	// Identity Section
+	fmt.Fprintf(out, "%s\n", headerStyle.Render("IDENTITY"))
+	fmt.Fprintf(out, "  Name:                 %s\n", vs.Name)
+	fmt.Fprintf(out, "  ID:                   %s\n", faintStyle.Render(vs.ID))
+
+	if vs.Organization != nil {
+		fmt.Fprintf(out, "  Organization:         %s\n", vs.Organization.Name)
+	}
+
+	if vs.Description != "" {
+		fmt.Fprintf(out, "  Description:          %s\n", vs.Description)
+	}
+
+	// Scope Section
+	fmt.Fprintf(out, "\n%s\n", headerStyle.Render("SCOPE"))
+	fmt.Fprintf(out, "  Global:               %s\n", cmdutil.FormatBool(vs.Global))
+	fmt.Fprintf(out, "  Priority:             %s\n", cmdutil.FormatBool(vs.Priority))
+
+	// Global variable sets apply to every workspace, they can't be applied
+	// to specific workspaces or projects.
+	if !vs.Global {
+		var workspaces []string
+		for _, ws := range vs.Workspaces {
+			workspaces = append(workspaces, ws.Name)
+		}
+
+		var projects []string
+		for _, p := range vs.Projects {
+			projects = append(projects, p.Name)
+		}
+
+		fmt.Fprintf(out, "\n%s\n", headerStyle.Render("WORKSPACES"))
+		cmdutil.WriteNames(out, workspaces)
+
+		fmt.Fprintf(out, "\n%s\n", headerStyle.Render("PROJECTS"))
+		cmdutil.WriteNames(out, projects)
+	}
+
+	// Variables Section
+	fmt.Fprintf(out, "\n%s\n", headerStyle.Render("VARIABLES"))
+	if len(vs.Variables) == 0 {
+		fmt.Fprintf(out, "  %s\n", faintStyle.Render("(none)"))
+		return
+	}
+
+	vars := slices.Clone(vs.Variables)
+	sort.Slice(vars, func(i, j int) bool {
+		if vars[i].Category != vars[j].Category {
+			return vars[i].Category > vars[j].Category
+		}
+		return vars[i].Key < vars[j].Key
+	})
+
+	width := 0
+	for _, v := range vars {
+		width = max(width, len(v.Key))
+	}
+
+	for _, v := range vars {
+		value := v.Value
+		if v.Sensitive {
+			value = faintStyle.Render("(sensitive)")
+		}
+
+		fmt.Fprintf(out, "  %-*s  %-9s  %s\n", width, v.Key, v.Category, value)
+	}
+}
@@ -0,0 +1,142 @@
+package view_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/zkhvan/tfc/cmd/tfc/varset/view"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+func TestView(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/varsets",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "varset-2", "type": "varsets", "attributes": {"name": "aws-credentials-old"}},
+				{"id": "varset-1", "type": "varsets", "attributes": {"name": "aws-credentials"}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/varsets/varset-1",
+		func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("include"); got != "workspaces,projects,vars" {
+				t.Errorf("got include %q", got)
+			}
+
+			fmt.Fprint(w, `
+				{
+					"data": {
+						"id": "varset-1",
+						"type": "varsets",
+						"attributes": {"name": "aws-credentials", "description": "AWS access", "priority": true},
+						"relationships": {
+							"organization": {"data": {"id": "myorg", "type": "organizations"}},
+							"workspaces": {"data": [{"id": "ws-2", "type": "workspaces"}, {"id": "ws-1", "type": "workspaces"}]},
+							"projects": {"data": []},
+							"vars": {"data": [{"id": "var-1", "type": "vars"}, {"id": "var-2", "type": "vars"}]}
+						}
+					},
+					"included": [
+						{
+							"id": "myorg",
+							"type": "organizations",
+							"attributes": {"name": "myorg"}
+						},
+						{
+							"id": "ws-1",
+							"type": "workspaces",
+							"attributes": {"name": "app-prod"}
+						},
+						{
+							"id": "ws-2",
+							"type": "workspaces",
+							"attributes": {"name": "app-dev"}
+						},
+						{
+							"id": "var-1",
+							"type": "vars",
+							"attributes": {"key": "AWS_SECRET_ACCESS_KEY", "category": "env", "sensitive": true}
+						},
+						{
+							"id": "var-2",
+							"type": "vars",
+							"attributes": {"key": "region", "value": "us-east-1", "category": "terraform"}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	result := runCommand(t, client, "aws-credentials", "--org", "myorg")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		IDENTITY
+		  Name:                 aws-credentials
+		  ID:                   varset-1
+		  Organization:         myorg
+		  Description:          AWS access
+
+		SCOPE
+		  Global:               No
+		  Priority:             Yes
+
+		WORKSPACES
+		  app-dev
+		  app-prod
+
+		PROJECTS
+		  (none)
+
+		VARIABLES
+		  region                 terraform  us-east-1
+		  AWS_SECRET_ACCESS_KEY  env        (sensitive)
+	`))
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+	}
+
+	cmd := view.NewCmdView(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
@@ -0,0 +1,30 @@
+package access
+
+import (
+	"github.com/spf13/cobra"
+
+	grantCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/access/grant"
+	listCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/access/list"
+	revokeCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/access/revoke"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+func NewCmdAccess(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "access",
+		Short: "Manage the access of teams to a workspace",
+		Long: text.Heredoc(`
+			Manage the access of teams to a workspace.
+
+			Teams are granted one of the read, plan, write or admin permission
+			levels, or a custom set of permissions.
+		`),
+	}
+
+	cmd.AddCommand(listCmd.NewCmdList(f))
+	cmd.AddCommand(grantCmd.NewCmdGrant(f))
+	cmd.AddCommand(revokeCmd.NewCmdRevoke(f))
+
+	return cmd
+}
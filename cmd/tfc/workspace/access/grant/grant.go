@@ -0,0 +1,268 @@
+package grant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+var (
+	AccessLevels = []string{
+		string(tfe.AccessRead),
+		string(tfe.AccessPlan),
+		string(tfe.AccessWrite),
+		string(tfe.AccessAdmin),
+		string(tfe.AccessCustom),
+	}
+	RunsPermissions = []string{
+		string(tfe.RunsPermissionRead),
+		string(tfe.RunsPermissionPlan),
+		string(tfe.RunsPermissionApply),
+	}
+	VariablesPermissions = []string{
+		string(tfe.VariablesPermissionNone),
+		string(tfe.VariablesPermissionRead),
+		string(tfe.VariablesPermissionWrite),
+	}
+	StateVersionsPermissions = []string{
+		string(tfe.StateVersionsPermissionNone),
+		string(tfe.StateVersionsPermissionReadOutputs),
+		string(tfe.StateVersionsPermissionRead),
+		string(tfe.StateVersionsPermissionWrite),
+	}
+	SentinelMocksPermissions = []string{
+		string(tfe.SentinelMocksPermissionNone),
+		string(tfe.SentinelMocksPermissionRead),
+	}
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	WorkspaceID cmdutil.WorkspaceIdentifier
+	Team        string // Team name or ID
+	Access      string
+
+	// Permissions of the custom access level, only set for the given
+	// flags.
+	Runs             *string
+	Variables        *string
+	StateVersions    *string
+	SentinelMocks    *string
+	WorkspaceLocking *bool
+	RunTasks         *bool
+}
+
+func NewCmdGrant(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	var (
+		runs             string
+		variables        string
+		stateVersions    string
+		sentinelMocks    string
+		workspaceLocking bool
+		runTasks         bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "grant <TEAM> --access <LEVEL>",
+		Short: "Grant a team access to a workspace",
+		Long: text.Heredoc(`
+			Grant a team access to a workspace, or change the access of a team
+			that already has access.
+
+			The --access level is one of read, plan, write, admin or custom.
+			The permissions of the custom level are set with --runs,
+			--variables, --state-versions, --sentinel-mocks,
+			--workspace-locking and --run-tasks.
+
+			If -W/--workspace is not specified and state.tf is present,
+			the organization and workspace will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Let a team plan runs
+			$ tfc workspaces access grant developers --access plan -W myorg/myworkspace
+
+			# Let a team apply runs and read variables, without reading the state
+			$ tfc workspaces access grant deployers --access custom \
+			    --runs apply --variables read --state-versions read-outputs
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			if flags.Changed("runs") {
+				opts.Runs = &runs
+			}
+			if flags.Changed("variables") {
+				opts.Variables = &variables
+			}
+			if flags.Changed("state-versions") {
+				opts.StateVersions = &stateVersions
+			}
+			if flags.Changed("sentinel-mocks") {
+				opts.SentinelMocks = &sentinelMocks
+			}
+			if flags.Changed("workspace-locking") {
+				opts.WorkspaceLocking = &workspaceLocking
+			}
+			if flags.Changed("run-tasks") {
+				opts.RunTasks = &runTasks
+			}
+
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceFlag(cmd, &opts.WorkspaceID, opts.TFEClient)
+
+	_ = cmdutil.FlagStringEnum(cmd, &opts.Access, "access", "", "Access level", AccessLevels)
+	_ = cmdutil.FlagStringEnum(cmd, &runs, "runs", "", "Runs permission of the custom level", RunsPermissions)
+	_ = cmdutil.FlagStringEnum(cmd, &variables, "variables", "",
+		"Variables permission of the custom level", VariablesPermissions,
+	)
+	_ = cmdutil.FlagStringEnum(cmd, &stateVersions, "state-versions", "",
+		"State versions permission of the custom level", StateVersionsPermissions,
+	)
+	_ = cmdutil.FlagStringEnum(cmd, &sentinelMocks, "sentinel-mocks", "",
+		"Sentinel mocks permission of the custom level", SentinelMocksPermissions,
+	)
+	cmd.Flags().BoolVar(&workspaceLocking, "workspace-locking", false,
+		"Allow locking the workspace with the custom level",
+	)
+	cmd.Flags().BoolVar(&runTasks, "run-tasks", false, "Allow managing run tasks with the custom level")
+
+	_ = cmd.MarkFlagRequired("access")
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, args []string) {
+	opts.Team = args[0]
+	cmdutil.CompleteWorkspaceIdentifierSilent(cmd, &opts.WorkspaceID, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := opts.WorkspaceID.Validate(); err != nil {
+		return fmt.Errorf("workspace required: use -W ORG/WORKSPACE or ensure state.tf exists")
+	}
+
+	if err := opts.validate(); err != nil {
+		return err
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	ws, err := client.Workspaces.Read(ctx, opts.WorkspaceID.Org, opts.WorkspaceID.Workspace)
+	if err != nil {
+		return fmt.Errorf("failed to read workspace %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	team, err := client.Teams.ReadByNameOrID(ctx, opts.WorkspaceID.Org, opts.Team)
+	if err != nil {
+		return fmt.Errorf("failed to read team %s: %w", opts.Team, err)
+	}
+
+	access, err := client.TeamAccess.ListForWorkspace(ctx, ws.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list team access for %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	level := tfe.AccessType(opts.Access)
+
+	for _, a := range access {
+		if a.Team == nil || a.Team.ID != team.ID {
+			continue
+		}
+
+		_, err := client.TeamAccess.Update(ctx, a.ID, tfe.TeamAccessUpdateOptions{
+			Access:           &level,
+			Runs:             (*tfe.RunsPermissionType)(opts.Runs),
+			Variables:        (*tfe.VariablesPermissionType)(opts.Variables),
+			StateVersions:    (*tfe.StateVersionsPermissionType)(opts.StateVersions),
+			SentinelMocks:    (*tfe.SentinelMocksPermissionType)(opts.SentinelMocks),
+			WorkspaceLocking: opts.WorkspaceLocking,
+			RunTasks:         opts.RunTasks,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update access of team %s: %w", team.Name, err)
+		}
+
+		fmt.Fprintf(opts.IO.Out, "Changed the access of team %s to %s from %s to %s\n",
+			team.Name, opts.WorkspaceID.String(), a.Access, level,
+		)
+		return nil
+	}
+
+	_, err = client.TeamAccess.Add(ctx, tfe.TeamAccessAddOptions{
+		Access:           &level,
+		Runs:             (*tfe.RunsPermissionType)(opts.Runs),
+		Variables:        (*tfe.VariablesPermissionType)(opts.Variables),
+		StateVersions:    (*tfe.StateVersionsPermissionType)(opts.StateVersions),
+		SentinelMocks:    (*tfe.SentinelMocksPermissionType)(opts.SentinelMocks),
+		WorkspaceLocking: opts.WorkspaceLocking,
+		RunTasks:         opts.RunTasks,
+		Team:             &tfe.Team{ID: team.ID},
+		Workspace:        &tfe.Workspace{ID: ws.ID},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to grant access to team %s: %w", team.Name, err)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Granted %s access to %s to team %s\n", level, opts.WorkspaceID.String(), team.Name)
+
+	return nil
+}
+
+func (opts *Options) validate() error {
+	if err := cmdutil.ValidateEnum("access", opts.Access, AccessLevels); err != nil {
+		return err
+	}
+
+	custom := opts.Runs != nil || opts.Variables != nil || opts.StateVersions != nil ||
+		opts.SentinelMocks != nil || opts.WorkspaceLocking != nil || opts.RunTasks != nil
+	if custom && opts.Access != string(tfe.AccessCustom) {
+		return fmt.Errorf("permission flags can only be used with --access custom")
+	}
+
+	enums := []struct {
+		name    string
+		value   *string
+		options []string
+	}{
+		{"runs", opts.Runs, RunsPermissions},
+		{"variables", opts.Variables, VariablesPermissions},
+		{"state-versions", opts.StateVersions, StateVersionsPermissions},
+		{"sentinel-mocks", opts.SentinelMocks, SentinelMocksPermissions},
+	}
+	for _, e := range enums {
+		if e.value == nil {
+			continue
+		}
+		if err := cmdutil.ValidateEnum(e.name, *e.value, e.options); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,294 @@
+package grant_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/zkhvan/tfc/cmd/tfc/workspace/access/grant"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type request struct {
+	Method     string
+	Path       string
+	Attributes map[string]any
+}
+
+func TestGrant_add(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces/app",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "ws-1", "type": "workspaces", "attributes": {"name": "app"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/teams",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "team-1", "type": "teams", "attributes": {"name": "deployers"}},
+				{"id": "team-2", "type": "teams", "attributes": {"name": "developers"}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/team-workspaces",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "tws-2", "type": "team-workspaces", "attributes": {"access": "read"},
+					"relationships": {"team": {"data": {"id": "team-2", "type": "teams"}}}}
+			]}`)
+		},
+	)
+
+	var requests []request
+	record := func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Data struct {
+				Attributes map[string]any `json:"attributes"`
+			} `json:"data"`
+		}
+		b, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(b, &body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		requests = append(requests, request{r.Method, r.URL.Path, body.Data.Attributes})
+		fmt.Fprint(w, `{"data": {"id": "tws-new", "type": "team-workspaces", "attributes": {}}}`)
+	}
+	mux.HandleFunc("POST /api/v2/team-workspaces", record)
+	mux.HandleFunc("PATCH /api/v2/team-workspaces/{id}", record)
+
+	result := runCommand(t, client, "deployers", "-W", "myorg/app",
+		"--access", "custom", "--runs", "apply", "--state-versions", "read-outputs", "--workspace-locking",
+	)
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, "Granted custom access to myorg/app to team deployers\n")
+
+	if len(requests) != 1 {
+		t.Fatalf("got %d requests, want 1", len(requests))
+	}
+
+	got := requests[0]
+	test.StringSlice(t, []string{got.Method + " " + got.Path}, []string{"POST /api/v2/team-workspaces"})
+
+	want := map[string]any{
+		"access":            "custom",
+		"runs":              "apply",
+		"state-versions":    "read-outputs",
+		"workspace-locking": true,
+	}
+	for k, v := range want {
+		if got.Attributes[k] != v {
+			t.Errorf("got %s %v, want %v", k, got.Attributes[k], v)
+		}
+	}
+	if _, ok := got.Attributes["variables"]; ok {
+		t.Errorf("got variables %v, want unset", got.Attributes["variables"])
+	}
+}
+
+func TestGrant_update(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces/app",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "ws-1", "type": "workspaces", "attributes": {"name": "app"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/teams",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "team-1", "type": "teams", "attributes": {"name": "deployers"}},
+				{"id": "team-2", "type": "teams", "attributes": {"name": "developers"}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/team-workspaces",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "tws-2", "type": "team-workspaces", "attributes": {"access": "read"},
+					"relationships": {"team": {"data": {"id": "team-2", "type": "teams"}}}}
+			]}`)
+		},
+	)
+
+	var requests []request
+	record := func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Data struct {
+				Attributes map[string]any `json:"attributes"`
+			} `json:"data"`
+		}
+		b, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(b, &body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		requests = append(requests, request{r.Method, r.URL.Path, body.Data.Attributes})
+		fmt.Fprint(w, `{"data": {"id": "tws-new", "type": "team-workspaces", "attributes": {}}}`)
+	}
+	mux.HandleFunc("POST /api/v2/team-workspaces", record)
+	mux.HandleFunc("PATCH /api/v2/team-workspaces/{id}", record)
+
+	result := runCommand(t, client, "developers", "-W", "myorg/app", "--access", "write")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, "Changed the access of team developers to myorg/app from read to write\n")
+
+	if len(requests) != 1 {
+		t.Fatalf("got %d requests, want 1", len(requests))
+	}
+	test.StringSlice(t,
+		[]string{requests[0].Method + " " + requests[0].Path},
+		[]string{"PATCH /api/v2/team-workspaces/tws-2"},
+	)
+}
+
+func TestGrant_custom_flags_require_custom_access(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces/app",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "ws-1", "type": "workspaces", "attributes": {"name": "app"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/teams",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "team-1", "type": "teams", "attributes": {"name": "deployers"}},
+				{"id": "team-2", "type": "teams", "attributes": {"name": "developers"}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/team-workspaces",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "tws-2", "type": "team-workspaces", "attributes": {"access": "read"},
+					"relationships": {"team": {"data": {"id": "team-2", "type": "teams"}}}}
+			]}`)
+		},
+	)
+
+	var requests []request
+	record := func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Data struct {
+				Attributes map[string]any `json:"attributes"`
+			} `json:"data"`
+		}
+		b, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(b, &body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		requests = append(requests, request{r.Method, r.URL.Path, body.Data.Attributes})
+		fmt.Fprint(w, `{"data": {"id": "tws-new", "type": "team-workspaces", "attributes": {}}}`)
+	}
+	mux.HandleFunc("POST /api/v2/team-workspaces", record)
+	mux.HandleFunc("PATCH /api/v2/team-workspaces/{id}", record)
+
+	result := runCommand(t, client, "developers", "-W", "myorg/app", "--access", "plan", "--runs", "apply")
+
+	test.Buffer(t, result.ErrBuf, "permission flags can only be used with --access custom\n")
+	if len(requests) != 0 {
+		t.Errorf("got %d requests, want 0", len(requests))
+	}
+}
+
+func TestGrant_invalid_access(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces/app",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "ws-1", "type": "workspaces", "attributes": {"name": "app"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/teams",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "team-1", "type": "teams", "attributes": {"name": "deployers"}},
+				{"id": "team-2", "type": "teams", "attributes": {"name": "developers"}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/team-workspaces",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "tws-2", "type": "team-workspaces", "attributes": {"access": "read"},
+					"relationships": {"team": {"data": {"id": "team-2", "type": "teams"}}}}
+			]}`)
+		},
+	)
+
+	result := runCommand(t, client, "developers", "-W", "myorg/app", "--access", "owner")
+
+	test.Buffer(t, result.ErrBuf, "invalid access \"owner\": must be one of read, plan, write, admin, custom\n")
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+	}
+
+	cmd := grant.NewCmdGrant(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
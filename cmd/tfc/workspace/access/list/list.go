@@ -0,0 +1,209 @@
+package list
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+const (
+	ColumnTeam          string = "TEAM"
+	ColumnAccess        string = "ACCESS"
+	ColumnRuns          string = "RUNS"
+	ColumnVariables     string = "VARIABLES"
+	ColumnStateVersions string = "STATE_VERSIONS"
+	ColumnSentinelMocks string = "SENTINEL_MOCKS"
+	ColumnLocking       string = "LOCKING"
+	ColumnRunTasks      string = "RUN_TASKS"
+)
+
+var (
+	ColumnsDefault = []string{
+		ColumnTeam,
+		ColumnAccess,
+		ColumnRuns,
+		ColumnVariables,
+		ColumnStateVersions,
+		ColumnLocking,
+	}
+	ColumnsAll = []string{
+		ColumnTeam,
+		ColumnAccess,
+		ColumnRuns,
+		ColumnVariables,
+		ColumnStateVersions,
+		ColumnSentinelMocks,
+		ColumnLocking,
+		ColumnRunTasks,
+	}
+)
+
+// Access is the JSON representation of the access of a team to a
+// workspace.
+type Access struct {
+	Team          string `json:"team"`
+	Access        string `json:"access"`
+	Runs          string `json:"runs"`
+	Variables     string `json:"variables"`
+	StateVersions string `json:"state_versions"`
+	SentinelMocks string `json:"sentinel_mocks"`
+	Locking       bool   `json:"workspace_locking"`
+	RunTasks      bool   `json:"run_tasks"`
+}
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	WorkspaceID cmdutil.WorkspaceIdentifier
+	Columns     []string
+	Format      string
+}
+
+func NewCmdList(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List the teams with access to a workspace",
+		Aliases: []string{"ls"},
+		Long: text.Heredoc(`
+			List the teams with access to a workspace and their permissions.
+
+			Each team is shown with its permission level and the permissions
+			it grants: RUNS is read, plan or apply, VARIABLES is none, read or
+			write and STATE_VERSIONS is none, read-outputs, read or write.
+
+			Access granted on the workspace's project isn't included, use
+			"tfc projects view" to see the teams with access to the project.
+
+			If -W/--workspace is not specified and state.tf is present,
+			the organization and workspace will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# List the teams with access to the workspace in state.tf
+			$ tfc workspaces access list
+
+			# Export the access to a workspace for an access review
+			$ tfc workspaces access list -W myorg/myworkspace --format json
+		`),
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceFlag(cmd, &opts.WorkspaceID, opts.TFEClient)
+	cmdutil.AddFormatFlag(cmd, &opts.Format)
+
+	_ = cmdutil.FlagStringEnumSliceP(cmd, &opts.Columns, "columns", "c", ColumnsDefault, "Columns to show.", ColumnsAll)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, _ []string) {
+	cmdutil.CompleteWorkspaceIdentifierSilent(cmd, &opts.WorkspaceID, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := opts.WorkspaceID.Validate(); err != nil {
+		return fmt.Errorf("workspace required: use -W ORG/WORKSPACE or ensure state.tf exists")
+	}
+
+	if err := cmdutil.ValidateEnum("format", opts.Format, []string{cmdutil.FormatTable, cmdutil.FormatJSON}); err != nil {
+		return err
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	ws, err := client.Workspaces.Read(ctx, opts.WorkspaceID.Org, opts.WorkspaceID.Workspace)
+	if err != nil {
+		return fmt.Errorf("failed to read workspace %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	access, err := client.TeamAccess.ListForWorkspace(ctx, ws.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list team access for %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	// Team access only references teams by ID.
+	teams := map[string]string{}
+	if len(access) > 0 {
+		list, _, err := client.Teams.List(ctx, opts.WorkspaceID.Org, &tfc.TeamListOptions{
+			ListOptions: tfc.ListOptions{Limit: math.MaxInt},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list teams for %s: %w", opts.WorkspaceID.Org, err)
+		}
+		for _, t := range list {
+			teams[t.ID] = t.Name
+		}
+	}
+
+	result := make([]Access, 0, len(access))
+	for _, a := range access {
+		team := ""
+		if a.Team != nil {
+			team = a.Team.ID
+			if name, ok := teams[a.Team.ID]; ok {
+				team = name
+			}
+		}
+
+		result = append(result, Access{
+			Team:          team,
+			Access:        string(a.Access),
+			Runs:          string(a.Runs),
+			Variables:     string(a.Variables),
+			StateVersions: string(a.StateVersions),
+			SentinelMocks: string(a.SentinelMocks),
+			Locking:       a.WorkspaceLocking,
+			RunTasks:      a.RunTasks,
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Team < result[j].Team })
+
+	if opts.Format == cmdutil.FormatJSON {
+		return cmdutil.PrintJSON(opts.IO, result)
+	}
+
+	p := cmdutil.FieldPrinter(opts.IO, opts.Columns...)
+	for _, a := range result {
+		p.Write(map[string]string{
+			ColumnTeam:          a.Team,
+			ColumnAccess:        a.Access,
+			ColumnRuns:          a.Runs,
+			ColumnVariables:     a.Variables,
+			ColumnStateVersions: a.StateVersions,
+			ColumnSentinelMocks: a.SentinelMocks,
+			ColumnLocking:       strconv.FormatBool(a.Locking),
+			ColumnRunTasks:      strconv.FormatBool(a.RunTasks),
+		})
+	}
+	p.Flush()
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package list_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/zkhvan/tfc/cmd/tfc/workspace/access/list"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+func TestList(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces/app",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "ws-1", "type": "workspaces", "attributes": {"name": "app"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/team-workspaces",
+		func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("filter[workspace][id]"); got != "ws-1" {
+				t.Errorf("got workspace filter %q", got)
+			}
+
+			fmt.Fprint(w, `{"data": [
+				{"id": "tws-1", "type": "team-workspaces", "attributes": {
+					"access": "plan", "runs": "plan", "variables": "read", "state-versions": "read",
+					"sentinel-mocks": "none", "workspace-locking": false, "run-tasks": false
+				}, "relationships": {"team": {"data": {"id": "team-2", "type": "teams"}}}},
+				{"id": "tws-2", "type": "team-workspaces", "attributes": {
+					"access": "custom", "runs": "apply", "variables": "none", "state-versions": "read-outputs",
+					"sentinel-mocks": "read", "workspace-locking": true, "run-tasks": false
+				}, "relationships": {"team": {"data": {"id": "team-1", "type": "teams"}}}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/teams",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "team-1", "type": "teams", "attributes": {"name": "deployers"}},
+				{"id": "team-2", "type": "teams", "attributes": {"name": "developers"}}
+			]}`)
+		},
+	)
+
+	t.Run("table", func(t *testing.T) {
+		result := runCommand(t, client, "-W", "myorg/app")
+
+		test.BufferEmpty(t, result.ErrBuf)
+		test.Buffer(t, result.OutBuf, text.Heredoc(`
+			TEAM        ACCESS  RUNS   VARIABLES  STATE_VERSIONS  LOCKING
+			deployers   custom  apply  none       read-outputs    true
+			developers  plan    plan   read       read            false
+		`))
+	})
+
+	t.Run("json", func(t *testing.T) {
+		result := runCommand(t, client, "-W", "myorg/app", "--format", "json")
+
+		test.BufferEmpty(t, result.ErrBuf)
+		test.Buffer(t, result.OutBuf, text.Heredoc(`
+			[
+			  {
+			    "team": "deployers",
+			    "access": "custom",
+			    "runs": "apply",
+			    "variables": "none",
+			    "state_versions": "read-outputs",
+			    "sentinel_mocks": "read",
+			    "workspace_locking": true,
+			    "run_tasks": false
+			  },
+			  {
+			    "team": "developers",
+			    "access": "plan",
+			    "runs": "plan",
+			    "variables": "read",
+			    "state_versions": "read",
+			    "sentinel_mocks": "none",
+			    "workspace_locking": false,
+			    "run_tasks": false
+			  }
+			]
+		`))
+	})
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+	}
+
+	cmd := list.NewCmdList(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
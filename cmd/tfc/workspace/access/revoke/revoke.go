@@ -0,0 +1,107 @@
+package revoke
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	WorkspaceID cmdutil.WorkspaceIdentifier
+	Team        string // Team name or ID
+}
+
+func NewCmdRevoke(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "revoke <TEAM>",
+		Short: "Revoke the access of a team to a workspace",
+		Long: text.Heredoc(`
+			Revoke the access of a team to a workspace.
+
+			The team keeps any access granted on the workspace's project or
+			through its organization permissions.
+
+			If -W/--workspace is not specified and state.tf is present,
+			the organization and workspace will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Revoke the access of a team
+			$ tfc workspaces access revoke contractors -W myorg/myworkspace
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceFlag(cmd, &opts.WorkspaceID, opts.TFEClient)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, args []string) {
+	opts.Team = args[0]
+	cmdutil.CompleteWorkspaceIdentifierSilent(cmd, &opts.WorkspaceID, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := opts.WorkspaceID.Validate(); err != nil {
+		return fmt.Errorf("workspace required: use -W ORG/WORKSPACE or ensure state.tf exists")
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	ws, err := client.Workspaces.Read(ctx, opts.WorkspaceID.Org, opts.WorkspaceID.Workspace)
+	if err != nil {
+		return fmt.Errorf("failed to read workspace %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	team, err := client.Teams.ReadByNameOrID(ctx, opts.WorkspaceID.Org, opts.Team)
+	if err != nil {
+		return fmt.Errorf("failed to read team %s: %w", opts.Team, err)
+	}
+
+	access, err := client.TeamAccess.ListForWorkspace(ctx, ws.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list team access for %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	for _, a := range access {
+		if a.Team == nil || a.Team.ID != team.ID {
+			continue
+		}
+
+		if err := client.TeamAccess.Remove(ctx, a.ID); err != nil {
+			return fmt.Errorf("failed to revoke access of team %s: %w", team.Name, err)
+		}
+
+		fmt.Fprintf(opts.IO.Out, "Revoked the %s access of team %s to %s\n", a.Access, team.Name, opts.WorkspaceID.String())
+		return nil
+	}
+
+	return fmt.Errorf("team %s has no access to %s", team.Name, opts.WorkspaceID.String())
+}
@@ -0,0 +1,363 @@
+package clone
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/dotenv"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/ptr"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type Options struct {
+	IO               *iolib.IOStreams
+	TFEClient        func() (*tfc.Client, error)
+	TFEClientForHost func(hostname string) (*tfc.Client, error)
+	TerraformConfig  func() *tfconfig.TerraformConfig
+	Prompter         func() *cmdutil.Prompter
+
+	Source       tfc.OrgWorkspace
+	Destination  tfc.OrgWorkspace
+	Branch       string
+	VarFile      string
+	ToHost       string
+	Project      string
+	OAuthTokenID string
+}
+
+func NewCmdClone(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:               f.IOStreams,
+		TFEClient:        f.TFEClient,
+		TFEClientForHost: f.TFEClientForHost,
+		TerraformConfig:  f.TerraformConfig,
+		Prompter:         f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "clone <SOURCE> <DESTINATION>",
+		Short: "Clone a workspace with its settings, variables and tags",
+		Long: text.Heredoc(`
+			Create a new workspace with the settings, variables and tags of an
+			existing one.
+
+			Both workspaces are given in ORG/WORKSPACE format. The organization
+			of the source defaults to the one in state.tf, and the organization
+			of the destination defaults to the one of the source.
+
+			The Terraform version, execution mode, VCS repository, working
+			directory, VCS trigger prefixes and patterns and other settings are
+			copied, along with the workspace's tags, tag bindings and
+			non-sensitive variables. Run triggers from other workspaces aren't
+			copied, add them with "tfc workspaces run-triggers add".
+
+			The values of sensitive variables can't be read back, so they're
+			read from --var-file (KEY=VALUE lines) or prompted for. A value
+			of --var-file applies to both the terraform and env variable of
+			its key. Any left without a value are created with an empty
+			placeholder value that needs to be set before running the
+			workspace.
+
+			VCS connections and agent pools belong to an organization, so they
+			are only copied within the same organization. Use --oauth-token-id
+			to connect the repository through another organization's VCS
+			provider.
+
+			With --to-host, the workspace is cloned to another Terraform
+			Enterprise host using that host's token from the Terraform
+			credentials file.
+		`),
+		Example: text.Heredoc(`
+			# Clone a workspace within an organization
+			$ tfc workspaces clone myorg/app-staging app-prod
+
+			# Clone a workspace tracking a different branch
+			$ tfc workspaces clone myorg/app-staging myorg/app-prod --branch release
+
+			# Clone a workspace to another organization, reading sensitive values from a file
+			$ tfc workspaces clone myorg/app otherorg/app --var-file secrets.env
+
+			# Clone a workspace to a Terraform Enterprise host
+			$ tfc workspaces clone myorg/app myorg/app --to-host tfe.example.com
+		`),
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Branch, "branch", "",
+		"VCS branch for the new workspace (defaults to the source's branch)",
+	)
+	cmd.Flags().StringVar(&opts.VarFile, "var-file", "", "File with KEY=VALUE lines providing sensitive variable values")
+	cmd.Flags().StringVar(&opts.ToHost, "to-host", "", "Terraform Enterprise host to create the workspace on")
+	cmd.Flags().StringVar(&opts.Project, "project", "",
+		"Project for the new workspace (defaults to the source's project)",
+	)
+	cmd.Flags().StringVar(&opts.OAuthTokenID, "oauth-token-id", "", "VCS OAuth token ID to use for the new workspace")
+
+	_ = cmdutil.MarkFlagsWithNoFileCompletions(cmd, "branch", "to-host", "project", "oauth-token-id")
+
+	return cmd
+}
+
+func (opts *Options) Complete(_ *cobra.Command, args []string) {
+	opts.Source = tfc.ParseOrgWorkspace(args[0])
+	if !opts.Source.HasOrg() {
+		if cfg := opts.TerraformConfig(); cfg != nil && cfg.IsValid() {
+			opts.Source.Org = cfg.Organization
+		}
+	}
+
+	opts.Destination = tfc.ParseOrgWorkspace(args[1])
+	if !opts.Destination.HasOrg() {
+		opts.Destination.Org = opts.Source.Org
+	}
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := opts.Source.Validate(); err != nil {
+		return fmt.Errorf("invalid source workspace: %w", err)
+	}
+
+	if err := opts.Destination.Validate(); err != nil {
+		return fmt.Errorf("invalid destination workspace: %w", err)
+	}
+
+	srcClient, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	dstClient := srcClient
+	if opts.ToHost != "" {
+		dstClient, err = opts.TFEClientForHost(opts.ToHost)
+		if err != nil {
+			return err
+		}
+	}
+
+	sameOrg := opts.ToHost == "" && opts.Source.Org == opts.Destination.Org
+
+	src, err := srcClient.Workspaces.Read(ctx, opts.Source.Org, opts.Source.Workspace)
+	if err != nil {
+		return fmt.Errorf("failed to read workspace %s: %w", opts.Source.String(), err)
+	}
+
+	vars, _, err := srcClient.Variables.List(ctx, src.ID, &tfc.VariableListOptions{
+		ListOptions: tfc.ListOptions{Limit: math.MaxInt},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list variables for %s: %w", opts.Source.String(), err)
+	}
+
+	bindings, err := srcClient.Workspaces.ListTagBindings(ctx, src.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list tag bindings for %s: %w", opts.Source.String(), err)
+	}
+
+	// Collect the sensitive values before creating anything, so a failed
+	// prompt doesn't leave a half-configured workspace behind.
+	values, placeholders, err := opts.sensitiveValues(vars)
+	if err != nil {
+		return err
+	}
+
+	createOpts := opts.createOptions(src, sameOrg)
+	createOpts.Tags = make([]*tfc.Tag, 0, len(src.TagNames))
+	for _, name := range src.TagNames {
+		createOpts.Tags = append(createOpts.Tags, &tfc.Tag{Name: name})
+	}
+	for _, b := range bindings {
+		createOpts.TagBindings = append(createOpts.TagBindings, &tfc.TagBinding{Key: b.Key, Value: b.Value})
+	}
+
+	switch {
+	case opts.Project != "":
+		p, err := dstClient.Projects.ReadByName(ctx, opts.Destination.Org, opts.Project)
+		if err != nil {
+			return err
+		}
+		createOpts.Project = p
+	case sameOrg && src.Project != nil:
+		createOpts.Project = &tfc.Project{ID: src.Project.ID}
+	}
+
+	dst, err := dstClient.Workspaces.Create(ctx, opts.Destination.Org, createOpts)
+	if err != nil {
+		return fmt.Errorf("failed to create workspace %s: %w", opts.Destination.String(), err)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Created workspace %s from %s\n", opts.Destination.String(), opts.Source.String())
+
+	for i, v := range vars {
+		value := v.Value
+		if v.Sensitive {
+			value = values[variableID{v.Key, v.Category}]
+		}
+
+		_, err := dstClient.Variables.Create(ctx, dst.ID, tfe.VariableCreateOptions{
+			Key:         ptr.String(v.Key),
+			Value:       ptr.String(value),
+			Description: ptr.String(v.Description),
+			Category:    &v.Category,
+			HCL:         ptr.Bool(v.HCL),
+			Sensitive:   ptr.Bool(v.Sensitive),
+		})
+		if err != nil {
+			return fmt.Errorf("workspace %s was created with only %d of %d variables: failed to create variable %q (%s): %w",
+				opts.Destination.String(), i, len(vars), v.Key, v.Category, err,
+			)
+		}
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Copied %d variables, %d tags and %d tag bindings\n",
+		len(vars)-len(placeholders), len(src.TagNames), len(bindings),
+	)
+
+	if len(placeholders) > 0 {
+		fmt.Fprintf(opts.IO.Out, "\nSensitive variables created with placeholder values that need to be set:\n")
+		for _, v := range placeholders {
+			fmt.Fprintf(opts.IO.Out, "  %s (%s)\n", v.Key, v.Category)
+		}
+	}
+
+	return nil
+}
+
+// createOptions returns the options to create the destination workspace with
+// the settings of the source workspace.
+func (opts *Options) createOptions(src *tfc.Workspace, sameOrg bool) tfe.WorkspaceCreateOptions {
+	o := tfe.WorkspaceCreateOptions{
+		Name:                ptr.String(opts.Destination.Workspace),
+		Description:         ptr.String(src.Description),
+		AllowDestroyPlan:    ptr.Bool(src.AllowDestroyPlan),
+		AssessmentsEnabled:  ptr.Bool(src.AssessmentsEnabled),
+		AutoApply:           ptr.Bool(src.AutoApply),
+		AutoApplyRunTrigger: ptr.Bool(src.AutoApplyRunTrigger),
+		ExecutionMode:       ptr.String(src.ExecutionMode),
+		FileTriggersEnabled: ptr.Bool(src.FileTriggersEnabled),
+		GlobalRemoteState:   ptr.Bool(src.GlobalRemoteState),
+		QueueAllRuns:        ptr.Bool(src.QueueAllRuns),
+		SpeculativeEnabled:  ptr.Bool(src.SpeculativeEnabled),
+		TerraformVersion:    ptr.String(src.TerraformVersion),
+		TriggerPrefixes:     src.TriggerPrefixes,
+		TriggerPatterns:     src.TriggerPatterns,
+		WorkingDirectory:    ptr.String(src.WorkingDirectory),
+	}
+
+	if src.ExecutionMode == "agent" {
+		if sameOrg && src.AgentPool != nil {
+			o.AgentPoolID = ptr.String(src.AgentPool.ID)
+		} else {
+			o.ExecutionMode = ptr.String("remote")
+			fmt.Fprintf(opts.IO.ErrOut,
+				"Agent pools can't be copied across organizations, using remote execution instead\n",
+			)
+		}
+	}
+
+	if src.VCSRepo != nil {
+		vcs := &tfe.VCSRepoOptions{
+			Identifier:        ptr.String(src.VCSRepo.Identifier),
+			Branch:            ptr.String(src.VCSRepo.Branch),
+			IngressSubmodules: ptr.Bool(src.VCSRepo.IngressSubmodules),
+		}
+
+		if src.VCSRepo.TagsRegex != "" {
+			vcs.TagsRegex = ptr.String(src.VCSRepo.TagsRegex)
+		}
+
+		if opts.Branch != "" {
+			vcs.Branch = ptr.String(opts.Branch)
+		}
+
+		switch {
+		case opts.OAuthTokenID != "":
+			vcs.OAuthTokenID = ptr.String(opts.OAuthTokenID)
+			o.VCSRepo = vcs
+		case sameOrg && src.VCSRepo.OAuthTokenID != "":
+			vcs.OAuthTokenID = ptr.String(src.VCSRepo.OAuthTokenID)
+			o.VCSRepo = vcs
+		case sameOrg && src.VCSRepo.GHAInstallationID != "":
+			vcs.GHAInstallationID = ptr.String(src.VCSRepo.GHAInstallationID)
+			o.VCSRepo = vcs
+		default:
+			fmt.Fprintf(opts.IO.ErrOut,
+				"VCS connections can't be copied across organizations, skipping repository %s; use --oauth-token-id\n",
+				src.VCSRepo.Identifier,
+			)
+		}
+	}
+
+	return o
+}
+
+// variableID identifies a variable of a workspace.
+type variableID struct {
+	key      string
+	category tfe.CategoryType
+}
+
+// sensitiveValues returns the values of the sensitive variables read from the
+// var file or prompted for, along with the variables left without a value. A
+// value of the var file applies to the variables of its key in every category.
+func (opts *Options) sensitiveValues(vars []*tfc.Variable) (map[variableID]string, []*tfc.Variable, error) {
+	fileValues := map[string]string{}
+	if opts.VarFile != "" {
+		data, err := os.ReadFile(opts.VarFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read var file: %w", err)
+		}
+
+		entries, err := dotenv.Parse(data)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse var file %s: %w", opts.VarFile, err)
+		}
+		fileValues = dotenv.Map(entries)
+	}
+
+	var (
+		values       = map[variableID]string{}
+		placeholders []*tfc.Variable
+		prompter     = opts.Prompter()
+	)
+	for _, v := range vars {
+		if !v.Sensitive {
+			continue
+		}
+
+		id := variableID{v.Key, v.Category}
+		if value, ok := fileValues[v.Key]; ok {
+			values[id] = value
+			continue
+		}
+
+		if prompter.CanPrompt() {
+			value, err := prompter.Secret(fmt.Sprintf("Value for sensitive variable %s (%s): ", v.Key, v.Category))
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if value != "" {
+				values[id] = value
+				continue
+			}
+		}
+
+		placeholders = append(placeholders, v)
+	}
+
+	return values, placeholders, nil
+}
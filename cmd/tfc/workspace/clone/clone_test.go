@@ -0,0 +1,573 @@
+package clone_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/zkhvan/tfc/cmd/tfc/workspace/clone"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type createdWorkspace struct {
+	Org        string
+	Attributes struct {
+		Name             string   `json:"name"`
+		TerraformVersion string   `json:"terraform-version"`
+		ExecutionMode    string   `json:"execution-mode"`
+		WorkingDirectory string   `json:"working-directory"`
+		TriggerPrefixes  []string `json:"trigger-prefixes"`
+		VCSRepo          *struct {
+			Identifier   string `json:"identifier"`
+			Branch       string `json:"branch"`
+			OAuthTokenID string `json:"oauth-token-id"`
+		} `json:"vcs-repo"`
+	}
+	Relationships struct {
+		Project struct {
+			Data *struct {
+				ID string `json:"id"`
+			} `json:"data"`
+		} `json:"project"`
+		Tags struct {
+			Data []struct {
+				Attributes struct {
+					Name string `json:"name"`
+				} `json:"attributes"`
+			} `json:"data"`
+		} `json:"tags"`
+		TagBindings struct {
+			Data []struct {
+				Attributes struct {
+					Key   string `json:"key"`
+					Value string `json:"value"`
+				} `json:"attributes"`
+			} `json:"data"`
+		} `json:"tag-bindings"`
+	}
+}
+
+type createdVariable struct {
+	Key       string `json:"key"`
+	Value     string `json:"value"`
+	Category  string `json:"category"`
+	Sensitive bool   `json:"sensitive"`
+}
+
+func TestClone_same_organization(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	var (
+		workspace *createdWorkspace
+		variables []createdVariable
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": {
+						"id": "ws-src",
+						"type": "workspaces",
+						"attributes": {
+							"name": "app-staging",
+							"terraform-version": "1.9.0",
+							"execution-mode": "remote",
+							"working-directory": "envs/app",
+							"trigger-prefixes": ["modules/"],
+							"tag-names": ["app"],
+							"vcs-repo": {
+								"identifier": "acme/infra",
+								"branch": "main",
+								"oauth-token-id": "ot-123"
+							}
+						},
+						"relationships": {
+							"project": {"data": {"id": "prj-1", "type": "projects"}}
+						}
+					}
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-1",
+							"type": "vars",
+							"attributes": {"key": "region", "value": "us-east-1", "category": "terraform"}
+						},
+						{
+							"id": "var-2",
+							"type": "vars",
+							"attributes": {"key": "DB_PASSWORD", "category": "env", "sensitive": true}
+						},
+						{
+							"id": "var-3",
+							"type": "vars",
+							"attributes": {"key": "API_TOKEN", "category": "env", "sensitive": true}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/tag-bindings",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":[{"id":"tb-1","type":"tag-bindings","attributes":{"key":"env","value":"staging"}}]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"POST /api/v2/organizations/{organization}/workspaces",
+		func(w http.ResponseWriter, r *http.Request) {
+			var payload struct {
+				Data createdWorkspace `json:"data"`
+			}
+
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &payload); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			workspace = &payload.Data
+			workspace.Org = r.PathValue("organization")
+
+			fmt.Fprint(w, `{"data":{"id":"ws-dst","type":"workspaces","attributes":{"name":"app-prod"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"POST /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, r *http.Request) {
+			test.PathValue(t, r, "workspace_id", "ws-dst")
+
+			var payload struct {
+				Data struct {
+					Attributes createdVariable `json:"attributes"`
+				} `json:"data"`
+			}
+
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &payload); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			variables = append(variables, payload.Data.Attributes)
+
+			fmt.Fprintf(w, `{"data":{"id":"var-new","type":"vars","attributes":{"key":%q}}}`, payload.Data.Attributes.Key)
+		},
+	)
+
+	varFile := filepath.Join(t.TempDir(), "secrets.env")
+	if err := os.WriteFile(varFile, []byte("DB_PASSWORD=hunter2\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	result := runCommand(t, client, "", "myorg/app-staging", "app-prod", "--branch", "release", "--var-file", varFile)
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		Created workspace myorg/app-prod from myorg/app-staging
+		Copied 2 variables, 1 tags and 1 tag bindings
+
+		Sensitive variables created with placeholder values that need to be set:
+		  API_TOKEN (env)
+	`))
+
+	ws := workspace
+	if ws == nil {
+		t.Fatal("workspace was not created")
+	}
+
+	if ws.Org != "myorg" || ws.Attributes.Name != "app-prod" {
+		t.Errorf("created workspace got %s/%s, want myorg/app-prod", ws.Org, ws.Attributes.Name)
+	}
+	if ws.Attributes.TerraformVersion != "1.9.0" || ws.Attributes.WorkingDirectory != "envs/app" {
+		t.Errorf("settings were not copied: %+v", ws.Attributes)
+	}
+	test.StringSlice(t, ws.Attributes.TriggerPrefixes, []string{"modules/"})
+
+	vcs := ws.Attributes.VCSRepo
+	if vcs == nil || vcs.Identifier != "acme/infra" || vcs.Branch != "release" || vcs.OAuthTokenID != "ot-123" {
+		t.Errorf("vcs repo got %+v", vcs)
+	}
+
+	if ws.Relationships.Project.Data == nil || ws.Relationships.Project.Data.ID != "prj-1" {
+		t.Errorf("project was not copied: %+v", ws.Relationships.Project.Data)
+	}
+
+	if len(ws.Relationships.Tags.Data) != 1 || ws.Relationships.Tags.Data[0].Attributes.Name != "app" {
+		t.Errorf("tags were not copied: %+v", ws.Relationships.Tags.Data)
+	}
+	if len(ws.Relationships.TagBindings.Data) != 1 || ws.Relationships.TagBindings.Data[0].Attributes.Key != "env" {
+		t.Errorf("tag bindings were not copied: %+v", ws.Relationships.TagBindings.Data)
+	}
+
+	want := []createdVariable{
+		{Key: "region", Value: "us-east-1", Category: "terraform"},
+		{Key: "DB_PASSWORD", Value: "hunter2", Category: "env", Sensitive: true},
+		{Key: "API_TOKEN", Value: "", Category: "env", Sensitive: true},
+	}
+	if fmt.Sprint(variables) != fmt.Sprint(want) {
+		t.Errorf("variables got %+v, want %+v", variables, want)
+	}
+}
+
+func TestClone_other_organization_prompts_for_sensitive_values(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	var (
+		workspace *createdWorkspace
+		variables []createdVariable
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": {
+						"id": "ws-src",
+						"type": "workspaces",
+						"attributes": {
+							"name": "app-staging",
+							"terraform-version": "1.9.0",
+							"execution-mode": "remote",
+							"working-directory": "envs/app",
+							"trigger-prefixes": ["modules/"],
+							"tag-names": ["app"],
+							"vcs-repo": {
+								"identifier": "acme/infra",
+								"branch": "main",
+								"oauth-token-id": "ot-123"
+							}
+						},
+						"relationships": {
+							"project": {"data": {"id": "prj-1", "type": "projects"}}
+						}
+					}
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-1",
+							"type": "vars",
+							"attributes": {"key": "region", "value": "us-east-1", "category": "terraform"}
+						},
+						{
+							"id": "var-2",
+							"type": "vars",
+							"attributes": {"key": "DB_PASSWORD", "category": "env", "sensitive": true}
+						},
+						{
+							"id": "var-3",
+							"type": "vars",
+							"attributes": {"key": "API_TOKEN", "category": "env", "sensitive": true}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/tag-bindings",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":[{"id":"tb-1","type":"tag-bindings","attributes":{"key":"env","value":"staging"}}]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"POST /api/v2/organizations/{organization}/workspaces",
+		func(w http.ResponseWriter, r *http.Request) {
+			var payload struct {
+				Data createdWorkspace `json:"data"`
+			}
+
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &payload); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			workspace = &payload.Data
+			workspace.Org = r.PathValue("organization")
+
+			fmt.Fprint(w, `{"data":{"id":"ws-dst","type":"workspaces","attributes":{"name":"app-prod"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"POST /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, r *http.Request) {
+			test.PathValue(t, r, "workspace_id", "ws-dst")
+
+			var payload struct {
+				Data struct {
+					Attributes createdVariable `json:"attributes"`
+				} `json:"data"`
+			}
+
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &payload); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			variables = append(variables, payload.Data.Attributes)
+
+			fmt.Fprintf(w, `{"data":{"id":"var-new","type":"vars","attributes":{"key":%q}}}`, payload.Data.Attributes.Key)
+		},
+	)
+
+	result := runCommand(t, client, "s3cret\ntoken\n", "myorg/app-staging", "otherorg/app")
+
+	test.Buffer(t, result.ErrBuf, "Value for sensitive variable DB_PASSWORD (env): "+
+		"Value for sensitive variable API_TOKEN (env): "+
+		"VCS connections can't be copied across organizations, skipping repository acme/infra; use --oauth-token-id\n",
+	)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		Created workspace otherorg/app from myorg/app-staging
+		Copied 3 variables, 1 tags and 1 tag bindings
+	`))
+
+	ws := workspace
+	if ws.Org != "otherorg" {
+		t.Errorf("created workspace in %q, want %q", ws.Org, "otherorg")
+	}
+	if ws.Attributes.VCSRepo != nil {
+		t.Errorf("vcs repo should not be copied across organizations: %+v", ws.Attributes.VCSRepo)
+	}
+	if ws.Relationships.Project.Data != nil {
+		t.Errorf("project should not be copied across organizations: %+v", ws.Relationships.Project.Data)
+	}
+
+	if variables[1].Value != "s3cret" || variables[2].Value != "token" {
+		t.Errorf("sensitive values got %+v", variables)
+	}
+}
+
+func TestClone_sensitive_values_of_the_same_key(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	var variables []createdVariable
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ws-src","type":"workspaces","attributes":{"name":"app-staging"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-1",
+							"type": "vars",
+							"attributes": {"key": "token", "category": "terraform", "sensitive": true}
+						},
+						{
+							"id": "var-2",
+							"type": "vars",
+							"attributes": {"key": "token", "category": "env", "sensitive": true}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/tag-bindings",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":[]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"POST /api/v2/organizations/{organization}/workspaces",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ws-dst","type":"workspaces","attributes":{"name":"app-prod"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"POST /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, r *http.Request) {
+			var payload struct {
+				Data struct {
+					Attributes createdVariable `json:"attributes"`
+				} `json:"data"`
+			}
+
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &payload); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			variables = append(variables, payload.Data.Attributes)
+
+			fmt.Fprintf(w, `{"data":{"id":"var-new","type":"vars","attributes":{"key":%q}}}`, payload.Data.Attributes.Key)
+		},
+	)
+
+	result := runCommand(t, client, "tf-secret\nenv-secret\n", "myorg/app-staging", "app-prod")
+
+	test.Buffer(t, result.ErrBuf, "Value for sensitive variable token (terraform): "+
+		"Value for sensitive variable token (env): ",
+	)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		Created workspace myorg/app-prod from myorg/app-staging
+		Copied 2 variables, 0 tags and 0 tag bindings
+	`))
+
+	want := []createdVariable{
+		{Key: "token", Value: "tf-secret", Category: "terraform", Sensitive: true},
+		{Key: "token", Value: "env-secret", Category: "env", Sensitive: true},
+	}
+	if fmt.Sprint(variables) != fmt.Sprint(want) {
+		t.Errorf("variables got %+v, want %+v", variables, want)
+	}
+}
+
+func TestClone_variable_error(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ws-src","type":"workspaces","attributes":{"name":"app-staging"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-1",
+							"type": "vars",
+							"attributes": {"key": "region", "value": "us-east-1", "category": "terraform"}
+						},
+						{
+							"id": "var-2",
+							"type": "vars",
+							"attributes": {"key": "zones", "value": "[]", "category": "terraform"}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/tag-bindings",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":[]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"POST /api/v2/organizations/{organization}/workspaces",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ws-dst","type":"workspaces","attributes":{"name":"app-prod"}}}`)
+		},
+	)
+
+	created := 0
+	mux.HandleFunc(
+		"POST /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			if created > 0 {
+				http.Error(w, "internal error", http.StatusInternalServerError)
+				return
+			}
+			created++
+
+			fmt.Fprint(w, `{"data":{"id":"var-new","type":"vars","attributes":{"key":"region"}}}`)
+		},
+	)
+
+	result := runCommand(t, client, "", "myorg/app-staging", "app-prod")
+
+	test.Buffer(t, result.OutBuf, "Created workspace myorg/app-prod from myorg/app-staging\n")
+	test.Buffer(t, result.ErrBuf, "workspace myorg/app-prod was created with only 1 of 2 variables: "+
+		"failed to create variable \"zones\" (terraform): 500 Internal Server Error\n",
+	)
+}
+
+func runCommand(t *testing.T, client *tfc.Client, stdin string, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, in, stdout, stderr := iolib.Test()
+	in.WriteString(stdin)
+	ios.SetStdinTTY(stdin != "")
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+		Prompter:        func() *cmdutil.Prompter { return cmdutil.NewPrompter(ios) },
+	}
+
+	cmd := clone.NewCmdClone(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
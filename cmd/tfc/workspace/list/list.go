@@ -32,6 +32,11 @@ const (
 	ColumnResourceCount string = "RESOURCE_COUNT"
 	ColumnWorkingDir    string = "WORKING_DIR"
 	ColumnRunStatus     string = "RUN_STATUS"
+	ColumnProject       string = "PROJECT"
+	ColumnLocked        string = "LOCKED"
+	ColumnExecutionMode string = "EXECUTION_MODE"
+	ColumnAutoApply     string = "AUTO_APPLY"
+	ColumnTags          string = "TAGS"
 )
 
 var ColumnAll = []string{
@@ -45,6 +50,18 @@ var ColumnAll = []string{
 	ColumnTFVersion,
 	ColumnWorkingDir,
 	ColumnRunStatus,
+	ColumnProject,
+	ColumnLocked,
+	ColumnExecutionMode,
+	ColumnAutoApply,
+	ColumnTags,
+}
+
+var SortOptions = []string{
+	"name",
+	"-name",
+	"current-run.created-at",
+	"-current-run.created-at",
 }
 
 var (
@@ -62,21 +79,10 @@ type Options struct {
 	Clock     *cmdutil.Clock
 	Printer   cmdutil.Printer
 
-	Organization      string
-	OrganizationExact bool
-	Name              string
-	Tags              []string
-	ExcludeTags       []string
-	VCSRepos          []string
-
-	// Filter the results based on various groups of run statuses.
-	Pending bool
-	Errored bool
-	Running bool
-	Holding bool
-	Applied bool
+	Filter cmdutil.WorkspaceFilter
 
 	Limit          int
+	Sort           string
 	Columns        []string
 	ColumnsChanged bool
 	WithVariables  []string
@@ -95,6 +101,7 @@ func NewCmdList(f *cmdutil.Factory) *cobra.Command {
 		IO:        f.IOStreams,
 		TFEClient: f.TFEClient,
 		Clock:     f.Clock,
+		Filter:    cmdutil.WorkspaceFilter{Clock: f.Clock},
 	}
 
 	cmd := &cobra.Command{
@@ -111,6 +118,16 @@ func NewCmdList(f *cmdutil.Factory) *cobra.Command {
 
 			# List the workspaces in one organization
 			tfc workspaces list --org example-org
+
+			# List the workspaces of a project with a tag binding
+			tfc workspaces list --org example-org --project platform --tag env=prod
+
+			# List the locked workspaces that haven't had a run in 30 days
+			tfc workspaces list --org example-org --locked --no-run-in-days 30
+
+			# List the agent workspaces still on Terraform < 1.6, most recently run first
+			tfc workspaces list --org example-org --execution-mode agent \
+			  --terraform-version '< 1.6' --sort -current-run.created-at
 		`),
 		Aliases:           []string{"ls"},
 		ValidArgsFunction: cobra.NoFileCompletions,
@@ -120,19 +137,10 @@ func NewCmdList(f *cmdutil.Factory) *cobra.Command {
 		},
 	}
 
-	cmd.Flags().StringVarP(&opts.Name, "name", "n", "", "Search by the workspace name.")
-	cmd.Flags().StringVarP(&opts.Organization, "org", "o", "", "Search by the organization name.")
-	cmd.Flags().StringSliceVarP(&opts.Tags, "tags", "t", []string{}, "Search by the tags.")
-	cmd.Flags().StringSliceVarP(&opts.ExcludeTags, "exclude-tags", "T", []string{}, "Search by excluding the tags.")
-	cmd.Flags().StringSliceVarP(&opts.VCSRepos, "vcs-repos", "r", []string{}, "Search by the VCS repository name.")
-
-	cmd.Flags().BoolVar(&opts.Pending, "pending", false, "Search for workspaces with pending runs.")
-	cmd.Flags().BoolVar(&opts.Errored, "errored", false, "Search for workspaces with errored runs.")
-	cmd.Flags().BoolVar(&opts.Running, "running", false, "Search for workspaces with running runs.")
-	cmd.Flags().BoolVar(&opts.Holding, "holding", false, "Search for workspaces with holding runs.")
-	cmd.Flags().BoolVar(&opts.Applied, "applied", false, "Search for workspaces with applied runs.")
+	cmdutil.AddWorkspaceFilterFlags(cmd, &opts.Filter)
 
 	cmd.Flags().IntVarP(&opts.Limit, "limit", "l", 20, "Limit the number of results.")
+	_ = cmdutil.FlagStringEnum(cmd, &opts.Sort, "sort", "", "Sort the results.", SortOptions)
 	cmd.Flags().StringSliceVarP(&opts.WithVariables, "with-variables", "v", []string{},
 		"Retrieve workspace variables to display as columns (expensive).",
 	)
@@ -144,14 +152,7 @@ func NewCmdList(f *cmdutil.Factory) *cobra.Command {
 }
 
 func (opts *Options) Complete(cmd *cobra.Command, _ []string) {
-	// Check if there's any wildcard characters
-	if strings.ContainsAny(opts.Organization, "%*") {
-		opts.Organization = strings.ReplaceAll(opts.Organization, "*", "%")
-	}
-
-	if len(opts.Organization) > 0 && !strings.Contains(opts.Organization, "%") {
-		opts.OrganizationExact = true
-	}
+	opts.Filter.Complete()
 
 	if len(opts.WithVariables) > 0 {
 		opts.Columns = append(opts.Columns, opts.WithVariables...)
@@ -161,13 +162,23 @@ func (opts *Options) Complete(cmd *cobra.Command, _ []string) {
 }
 
 func (opts *Options) Run(ctx context.Context) error {
+	if err := opts.Filter.Validate(); err != nil {
+		return err
+	}
+
+	if opts.Sort != "" {
+		if err := cmdutil.ValidateEnum("sort", opts.Sort, SortOptions); err != nil {
+			return err
+		}
+	}
+
 	client, err := opts.TFEClient()
 	if err != nil {
 		return err
 	}
 
 	// Filter the results to the organizations that the user has access to.
-	orgs, err := listOrganizations(ctx, client, opts.Organization, opts.OrganizationExact)
+	orgs, err := opts.Filter.Organizations(ctx, client)
 	if err != nil {
 		return err
 	}
@@ -182,7 +193,7 @@ func (opts *Options) Run(ctx context.Context) error {
 	//     they're looking for, only add the column if they end up with
 	//     results that have more than one organization
 	if !opts.ColumnsChanged {
-		if len(opts.Organization) == 0 || len(orgs) > 1 {
+		if len(opts.Filter.Organization) == 0 || len(orgs) > 1 {
 			opts.Columns = append([]string{"ORG"}, opts.Columns...)
 		}
 	}
@@ -191,20 +202,23 @@ func (opts *Options) Run(ctx context.Context) error {
 
 	var errs []error
 	for _, org := range orgs {
-		o := tfc.WorkspaceListOptions{
-			ListOptions: tfc.ListOptions{
-				Limit: opts.Limit,
-			},
-			Search:           opts.Name,
-			Tags:             strings.Join(opts.Tags, ","),
-			ExcludeTags:      strings.Join(opts.ExcludeTags, ","),
-			CurrentRunStatus: opts.runStatus(),
-			VCSRepos:         opts.VCSRepos,
+		o, err := opts.Filter.ListOptions(ctx, client, org.Name, opts.Limit)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error listing workspaces for %q: %w", org.Name, err))
+			continue
 		}
 
-		if slices.Contains(opts.Columns, ColumnRunStatus) {
+		o.Sort = opts.Sort
+
+		if slices.Contains(opts.Columns, ColumnRunStatus) && !slices.Contains(o.Include, tfe.WSCurrentRun) {
 			o.Include = append(o.Include, tfe.WSCurrentRun)
 		}
+		if slices.Contains(opts.Columns, ColumnProject) {
+			o.Include = append(o.Include, tfe.WSProject)
+		}
+		if slices.Contains(opts.Columns, ColumnTags) {
+			o.Include = append(o.Include, tfe.WSEffectiveTagBindings)
+		}
 
 		workspaces, paging, err := client.Workspaces.List(ctx, org.Name, &o)
 		if err != nil {
@@ -242,32 +256,6 @@ func (opts *Options) Run(ctx context.Context) error {
 	return nil
 }
 
-func (opts *Options) runStatus() string {
-	var statuses []tfe.RunStatus
-
-	if opts.Pending {
-		statuses = append(statuses, tfc.RunStatusesInGroup(tfc.RunStatusGroupPending)...)
-	}
-	if opts.Errored {
-		statuses = append(statuses, tfc.RunStatusesInGroup(tfc.RunStatusGroupErrored)...)
-	}
-	if opts.Running {
-		statuses = append(statuses, tfc.RunStatusesInGroup(tfc.RunStatusGroupRunning)...)
-	}
-	if opts.Holding {
-		statuses = append(statuses, tfc.RunStatusesInGroup(tfc.RunStatusGroupHolding)...)
-	}
-	if opts.Applied {
-		statuses = append(statuses, tfc.RunStatusesInGroup(tfc.RunStatusGroupApplied)...)
-	}
-
-	result := make([]string, 0, len(statuses))
-	for _, s := range statuses {
-		result = append(result, string(s))
-	}
-	return strings.Join(result, ",")
-}
-
 func (opts *Options) extractWorkspaceFields(ws *tfe.Workspace, wsVars []*tfe.Variable) map[string]string {
 	renderTime := func(at time.Time) string {
 		rat := text.RelativeTimeAgo(opts.Clock.Now(), at)
@@ -288,6 +276,14 @@ func (opts *Options) extractWorkspaceFields(ws *tfe.Workspace, wsVars []*tfe.Var
 		ColumnWorkingDir:    ws.WorkingDirectory,
 		ColumnTFVersion:     ws.TerraformVersion,
 		ColumnResourceCount: strconv.Itoa(ws.ResourceCount),
+		ColumnLocked:        strconv.FormatBool(ws.Locked),
+		ColumnExecutionMode: ws.ExecutionMode,
+		ColumnAutoApply:     strconv.FormatBool(ws.AutoApply),
+		ColumnTags:          renderTags(ws),
+	}
+
+	if ws.Project != nil {
+		v[ColumnProject] = ws.Project.Name
 	}
 
 	if ws.Organization != nil {
@@ -319,36 +315,20 @@ func (opts *Options) extractWorkspaceFields(ws *tfe.Workspace, wsVars []*tfe.Var
 	return v
 }
 
-func listWorkspacesVariables(ctx context.Context, client *tfc.Client, id string) ([]*tfc.Variable, error) {
-	variables, _, err := client.Variables.List(ctx, id, &tfc.VariableListOptions{})
-	if err != nil {
-		return nil, err
+// renderTags joins a workspace's legacy tags and effective tag bindings.
+func renderTags(ws *tfe.Workspace) string {
+	tags := slices.Clone(ws.TagNames)
+	for _, b := range ws.EffectiveTagBindings {
+		tags = append(tags, fmt.Sprintf("%s=%s", b.Key, b.Value))
 	}
-
-	return variables, nil
+	return strings.Join(tags, ",")
 }
 
-func listOrganizations(
-	ctx context.Context,
-	client *tfc.Client,
-	name string,
-	nameExact bool,
-) ([]*tfe.Organization, error) {
-	if nameExact {
-		org, err := client.Organizations.Read(ctx, name)
-		if err != nil {
-			return nil, fmt.Errorf("get organization: %w", err)
-		}
-
-		return []*tfe.Organization{org}, nil
-	}
-
-	orgs, _, err := client.Organizations.List(ctx, &tfc.OrganizationListOptions{
-		Query: name,
-	})
+func listWorkspacesVariables(ctx context.Context, client *tfc.Client, id string) ([]*tfc.Variable, error) {
+	variables, _, err := client.Variables.List(ctx, id, &tfc.VariableListOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	return orgs, nil
+	return variables, nil
 }
@@ -395,6 +395,152 @@ var (
 	referenceTime = time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC)
 )
 
+func TestList_project_and_tag_binding_filters(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"o","type":"organizations","attributes":{"name":"o"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/projects",
+		func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("filter[names]"); got != "platform" {
+				t.Errorf("filter[names] got %q, want %q", got, "platform")
+			}
+
+			fmt.Fprint(w, `{"data":[{"id":"prj-1","type":"projects","attributes":{"name":"platform"}}]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces",
+		func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			for param, want := range map[string]string{
+				"filter[project][id]":      "prj-1",
+				"filter[tagged][0][key]":   "env",
+				"filter[tagged][0][value]": "prod",
+				"search[wildcard-name]":    "*-app",
+				"sort":                     "-name",
+				"include":                  "project,effective_tag_bindings",
+			} {
+				if got := q.Get(param); got != want {
+					t.Errorf("%s got %q, want %q", param, got, want)
+				}
+			}
+
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "ws-1",
+							"type": "workspaces",
+							"attributes": {
+								"name": "prod-app",
+								"tag-names": ["legacy"]
+							},
+							"relationships": {
+								"project": {"data": {"id": "prj-1", "type": "projects"}},
+								"effective-tag-bindings": {
+									"data": [{"id": "etb-1", "type": "effective-tag-bindings"}]
+								}
+							}
+						}
+					],
+					"included": [
+						{"id": "prj-1", "type": "projects", "attributes": {"name": "platform"}},
+						{
+							"id": "etb-1",
+							"type": "effective-tag-bindings",
+							"attributes": {"key": "env", "value": "prod"}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	result := runCommand(t, client,
+		"--org", "o",
+		"--project", "platform",
+		"--tag", "env=prod",
+		"--wildcard", "*-app",
+		"--sort", "-name",
+		"--columns", "NAME,PROJECT,TAGS",
+	)
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		NAME      PROJECT   TAGS
+		prod-app  platform  legacy,env=prod
+	`))
+}
+
+func TestList_client_side_filters(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"o","type":"organizations","attributes":{"name":"o"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces",
+		func(w http.ResponseWriter, _ *http.Request) {
+			workspace := func(name, version string, locked bool, runID string) string {
+				return text.Heredocf(`
+					{
+						"id": "ws-%[1]s",
+						"type": "workspaces",
+						"attributes": {
+							"name": "%[1]s",
+							"terraform-version": "%[2]s",
+							"locked": %[3]t,
+							"execution-mode": "remote",
+							"auto-apply": true
+						},
+						"relationships": {
+							"current-run": {"data": {"id": "%[4]s", "type": "runs"}}
+						}
+					}
+				`, name, version, locked, runID)
+			}
+
+			fmt.Fprintf(w, `{"data":[%s,%s,%s,%s],"included":[%s,%s]}`,
+				workspace("stale", "1.5.7", true, "run-old"),
+				workspace("recent", "1.5.7", true, "run-new"),
+				workspace("unlocked", "1.5.7", false, "run-old"),
+				workspace("upgraded", "1.9.0", true, "run-old"),
+				`{"id":"run-old","type":"runs","attributes":{"created-at":"1999-11-01T12:00:00Z"}}`,
+				`{"id":"run-new","type":"runs","attributes":{"created-at":"1999-12-31T12:00:00Z"}}`,
+			)
+		},
+	)
+
+	result := runCommand(t, client,
+		"--org", "o",
+		"--terraform-version", "< 1.6",
+		"--execution-mode", "remote",
+		"--locked",
+		"--no-run-in-days", "30",
+		"--columns", "NAME,TF_VERSION,LOCKED,EXECUTION_MODE,AUTO_APPLY",
+	)
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		NAME   TF_VERSION  LOCKED  EXECUTION_MODE  AUTO_APPLY
+		stale  1.5.7       true    remote          true
+	`))
+}
+
 func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
 	t.Helper()
 
@@ -0,0 +1,242 @@
+package create
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/ptr"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+// TriggerAll selects every notification trigger.
+const TriggerAll = "all"
+
+var (
+	DestinationTypes = func() []string {
+		var types []string
+		for _, t := range tfc.NotificationDestinationTypes {
+			types = append(types, string(t))
+		}
+		return types
+	}()
+	Triggers = func() []string {
+		triggers := []string{TriggerAll}
+		for _, t := range tfc.NotificationTriggers {
+			triggers = append(triggers, string(t))
+		}
+		return triggers
+	}()
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	WorkspaceID    cmdutil.WorkspaceIdentifier
+	Name           string
+	Type           string
+	URL            string
+	Token          string
+	Triggers       []string
+	EmailUsers     []string
+	EmailAddresses []string
+	Disabled       bool
+}
+
+func NewCmdCreate(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create <NAME> --type <TYPE>",
+		Short: "Create a notification for a workspace",
+		Long: text.Heredoc(`
+			Create a notification configuration for a workspace.
+
+			The --type is one of generic, slack, microsoft-teams or email.
+			Generic, Slack and Microsoft Teams notifications are sent to
+			--url, generic payloads are signed with --token when set. Email
+			notifications are sent to --email-users, given by username, email
+			or ID, and on Terraform Enterprise to --email-addresses.
+
+			The --triggers are the events sent, "all" sends every event.
+
+			If -W/--workspace is not specified and state.tf is present,
+			the organization and workspace will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Send failed and completed runs to a webhook
+			$ tfc workspaces notifications create ci --type generic --url https://ci.example.com/hook \
+			    --token s3cr3t --triggers run:errored,run:completed -W myorg/myworkspace
+
+			# Send every event to Slack
+			$ tfc workspaces notifications create slack --type slack \
+			    --url https://hooks.slack.com/services/T0/B0/X --triggers all
+
+			# Email drift to some users
+			$ tfc workspaces notifications create drift --type email \
+			    --email-users alice,bob@example.com --triggers assessment:drifted
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceFlag(cmd, &opts.WorkspaceID, opts.TFEClient)
+
+	_ = cmdutil.FlagStringEnum(cmd, &opts.Type, "type", "", "Destination type", DestinationTypes)
+	cmd.Flags().StringVar(&opts.URL, "url", "", "URL to send the notifications to")
+	cmd.Flags().StringVar(&opts.Token, "token", "", "Token to sign generic payloads with")
+	_ = cmdutil.FlagStringEnumSlice(cmd, &opts.Triggers, "triggers", nil, "Events to send, or all", Triggers)
+	cmd.Flags().StringSliceVar(&opts.EmailUsers, "email-users", nil, "Users to email, by username, email or ID")
+	cmd.Flags().StringSliceVar(&opts.EmailAddresses, "email-addresses", nil,
+		"Email addresses to email, Terraform Enterprise only",
+	)
+	cmd.Flags().BoolVar(&opts.Disabled, "disabled", false, "Create the notification disabled")
+
+	_ = cmd.MarkFlagRequired("type")
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, args []string) {
+	opts.Name = args[0]
+	cmdutil.CompleteWorkspaceIdentifierSilent(cmd, &opts.WorkspaceID, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := opts.WorkspaceID.Validate(); err != nil {
+		return fmt.Errorf("workspace required: use -W ORG/WORKSPACE or ensure state.tf exists")
+	}
+
+	if err := cmdutil.ValidateEnum("type", opts.Type, DestinationTypes); err != nil {
+		return err
+	}
+	destination := tfe.NotificationDestinationType(opts.Type)
+
+	recipients := len(opts.EmailUsers) + len(opts.EmailAddresses)
+	if err := ValidateDestination(destination, opts.URL, opts.Token, recipients); err != nil {
+		return err
+	}
+	if destination == tfe.NotificationDestinationTypeEmail && recipients == 0 {
+		return fmt.Errorf("recipients required: use --email-users or --email-addresses")
+	}
+	if destination != tfe.NotificationDestinationTypeEmail && opts.URL == "" {
+		return fmt.Errorf("url required: use --url")
+	}
+
+	triggers, err := ParseTriggers(opts.Triggers)
+	if err != nil {
+		return err
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	ws, err := client.Workspaces.Read(ctx, opts.WorkspaceID.Org, opts.WorkspaceID.Workspace)
+	if err != nil {
+		return fmt.Errorf("failed to read workspace %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	users, err := ResolveUsers(ctx, client, opts.WorkspaceID.Org, opts.EmailUsers)
+	if err != nil {
+		return err
+	}
+
+	o := tfe.NotificationConfigurationCreateOptions{
+		DestinationType: &destination,
+		Enabled:         ptr.Bool(!opts.Disabled),
+		Name:            ptr.String(opts.Name),
+		Triggers:        triggers,
+		EmailAddresses:  opts.EmailAddresses,
+		EmailUsers:      users,
+	}
+	if opts.URL != "" {
+		o.URL = ptr.String(opts.URL)
+	}
+	if opts.Token != "" {
+		o.Token = ptr.String(opts.Token)
+	}
+
+	nc, err := client.NotificationConfigurations.Create(ctx, ws.ID, o)
+	if err != nil {
+		return fmt.Errorf("failed to create notification %s: %w", opts.Name, err)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Created notification %s (%s) for %s\n", nc.Name, nc.ID, opts.WorkspaceID.String())
+
+	return nil
+}
+
+// ValidateDestination returns an error if the URL, token or number of email
+// recipients don't fit the destination type.
+func ValidateDestination(destination tfe.NotificationDestinationType, url, token string, recipients int) error {
+	if destination == tfe.NotificationDestinationTypeEmail {
+		if url != "" || token != "" {
+			return fmt.Errorf("--url and --token can't be used with email notifications")
+		}
+		return nil
+	}
+
+	if recipients > 0 {
+		return fmt.Errorf("--email-users and --email-addresses can only be used with email notifications")
+	}
+	if token != "" && destination != tfe.NotificationDestinationTypeGeneric {
+		return fmt.Errorf("--token can only be used with generic notifications")
+	}
+
+	return nil
+}
+
+// ParseTriggers parses notification triggers, "all" selecting every
+// trigger.
+func ParseTriggers(values []string) ([]tfe.NotificationTriggerType, error) {
+	if slices.Contains(values, TriggerAll) {
+		return slices.Clone(tfc.NotificationTriggers), nil
+	}
+
+	triggers := []tfe.NotificationTriggerType{}
+	for _, v := range values {
+		if err := cmdutil.ValidateEnum("trigger", v, Triggers); err != nil {
+			return nil, err
+		}
+		triggers = append(triggers, tfe.NotificationTriggerType(v))
+	}
+
+	return triggers, nil
+}
+
+// ResolveUsers resolves users of an organization by username, email or ID.
+func ResolveUsers(ctx context.Context, client *tfc.Client, org string, identifiers []string) ([]*tfe.User, error) {
+	var users []*tfe.User
+	for _, identifier := range identifiers {
+		identifier = strings.TrimSpace(identifier)
+
+		user, err := client.Organizations.ReadMember(ctx, org, identifier)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read user %s: %w", identifier, err)
+		}
+		users = append(users, &tfe.User{ID: user.ID})
+	}
+
+	return users, nil
+}
@@ -0,0 +1,187 @@
+package create_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/zkhvan/tfc/cmd/tfc/workspace/notifications/create"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+func TestCreate_generic(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces/app",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "ws-1", "type": "workspaces", "attributes": {"name": "app"}}}`)
+		},
+	)
+
+	var body string
+	mux.HandleFunc(
+		"POST /api/v2/workspaces/ws-1/notification-configurations",
+		func(w http.ResponseWriter, r *http.Request) {
+			b, _ := io.ReadAll(r.Body)
+			body = string(b)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"data": {"id": "nc-1", "type": "notification-configurations", "attributes": {"name": "ci"}}}`)
+		},
+	)
+
+	result := runCommand(t, client, "ci", "-W", "myorg/app", "--type", "generic",
+		"--url", "https://ci.example.com/hook", "--token", "s3cr3t", "--triggers", "run:errored,run:completed",
+	)
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, "Created notification ci (nc-1) for myorg/app\n")
+
+	want := `{"data":{"type":"notification-configurations","attributes":{"destination-type":"generic",` +
+		`"enabled":true,"name":"ci","token":"s3cr3t","triggers":["run:errored","run:completed"],` +
+		`"url":"https://ci.example.com/hook"},` +
+		`"relationships":{"subscribable":{"data":{"type":"workspaces","id":"ws-1"}}}}}` + "\n"
+	if body != want {
+		t.Errorf("got body %s, want %s", body, want)
+	}
+}
+
+func TestCreate_email(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces/app",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "ws-1", "type": "workspaces", "attributes": {"name": "app"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/organization-memberships",
+		func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("q"); got != "bob@example.com" {
+				t.Errorf("got query %q", got)
+			}
+
+			fmt.Fprint(w, `{
+				"data": [{"id": "ou-2", "type": "organization-memberships", "attributes": {"email": "bob@example.com"},
+					"relationships": {"user": {"data": {"id": "user-2", "type": "users"}}}}],
+				"included": [{"id": "user-2", "type": "users", "attributes": {"username": "bob"}}]
+			}`)
+		},
+	)
+
+	var body string
+	mux.HandleFunc(
+		"POST /api/v2/workspaces/ws-1/notification-configurations",
+		func(w http.ResponseWriter, r *http.Request) {
+			b, _ := io.ReadAll(r.Body)
+			body = string(b)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"data": {"id": "nc-1", "type": "notification-configurations", "attributes": {"name": "ci"}}}`)
+		},
+	)
+
+	result := runCommand(t, client, "drift", "-W", "myorg/app", "--type", "email",
+		"--email-users", "user-1,bob@example.com", "--triggers", "assessment:drifted",
+	)
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, "Created notification ci (nc-1) for myorg/app\n")
+
+	want := `{"data":{"type":"notification-configurations","attributes":{"destination-type":"email",` +
+		`"enabled":true,"name":"drift","triggers":["assessment:drifted"]},"relationships":{` +
+		`"subscribable":{"data":{"type":"workspaces","id":"ws-1"}},` +
+		`"users":{"data":[{"type":"users","id":"user-1"},{"type":"users","id":"user-2"}]}}}}` + "\n"
+	if body != want {
+		t.Errorf("got body %s, want %s", body, want)
+	}
+}
+
+func TestCreate_invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{
+			name: "token_on_slack",
+			args: []string{"--type", "slack", "--url", "https://hooks.slack.com/x", "--token", "s3cr3t"},
+			want: "--token can only be used with generic notifications\n",
+		},
+		{
+			name: "email_without_recipients",
+			args: []string{"--type", "email"},
+			want: "recipients required: use --email-users or --email-addresses\n",
+		},
+		{
+			name: "slack_without_url",
+			args: []string{"--type", "slack"},
+			want: "url required: use --url\n",
+		},
+		{
+			name: "url_on_email",
+			args: []string{"--type", "email", "--url", "https://example.com", "--email-users", "alice"},
+			want: "--url and --token can't be used with email notifications\n",
+		},
+		{
+			name: "unknown_trigger",
+			args: []string{"--type", "generic", "--url", "https://example.com", "--triggers", "run:done"},
+			want: "invalid trigger \"run:done\": must be one of all, run:created, run:planning, run:needs_attention, " +
+				"run:applying, run:completed, run:errored, assessment:drifted, assessment:failed, " +
+				"assessment:check_failure, workspace:auto_destroy_reminder, workspace:auto_destroy_run_results\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, _, teardown := tfetest.Setup()
+			defer teardown()
+
+			result := runCommand(t, client, append([]string{"ci", "-W", "myorg/app"}, tt.args...)...)
+
+			test.Buffer(t, result.ErrBuf, tt.want)
+		})
+	}
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+	}
+
+	cmd := create.NewCmdCreate(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
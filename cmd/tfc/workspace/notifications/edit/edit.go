@@ -0,0 +1,175 @@
+package edit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/cmd/tfc/workspace/notifications/create"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/ptr"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	WorkspaceID    cmdutil.WorkspaceIdentifier
+	Identifier     string // Notification name or ID
+	Name           *string
+	URL            *string
+	Token          *string
+	Enabled        *bool
+	Triggers       []string
+	EmailUsers     []string
+	EmailAddresses []string
+}
+
+func NewCmdEdit(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	var (
+		name    string
+		url     string
+		token   string
+		enabled bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "edit <NAME|ID>",
+		Short: "Edit a notification of a workspace",
+		Long: text.Heredoc(`
+			Edit a notification configuration of a workspace.
+
+			Only the settings of the given flags are changed. The --triggers,
+			--email-users and --email-addresses replace the current ones.
+
+			If -W/--workspace is not specified and state.tf is present,
+			the organization and workspace will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Only send failed runs
+			$ tfc workspaces notifications edit ci --triggers run:errored -W myorg/myworkspace
+
+			# Disable a notification
+			$ tfc workspaces notifications edit slack --enabled=false
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			if flags.Changed("name") {
+				opts.Name = &name
+			}
+			if flags.Changed("url") {
+				opts.URL = &url
+			}
+			if flags.Changed("token") {
+				opts.Token = &token
+			}
+			if flags.Changed("enabled") {
+				opts.Enabled = &enabled
+			}
+
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceFlag(cmd, &opts.WorkspaceID, opts.TFEClient)
+
+	cmd.Flags().StringVarP(&name, "name", "n", "", "New notification name")
+	cmd.Flags().StringVar(&url, "url", "", "URL to send the notifications to")
+	cmd.Flags().StringVar(&token, "token", "", "Token to sign generic payloads with")
+	cmd.Flags().BoolVar(&enabled, "enabled", true, "Enable or disable the notification")
+	_ = cmdutil.FlagStringEnumSlice(cmd, &opts.Triggers, "triggers", nil, "Events to send, or all", create.Triggers)
+	cmd.Flags().StringSliceVar(&opts.EmailUsers, "email-users", nil, "Users to email, by username, email or ID")
+	cmd.Flags().StringSliceVar(&opts.EmailAddresses, "email-addresses", nil,
+		"Email addresses to email, Terraform Enterprise only",
+	)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, args []string) {
+	opts.Identifier = args[0]
+	cmdutil.CompleteWorkspaceIdentifierSilent(cmd, &opts.WorkspaceID, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := opts.WorkspaceID.Validate(); err != nil {
+		return fmt.Errorf("workspace required: use -W ORG/WORKSPACE or ensure state.tf exists")
+	}
+
+	if opts.Name == nil && opts.URL == nil && opts.Token == nil && opts.Enabled == nil &&
+		opts.Triggers == nil && opts.EmailUsers == nil && opts.EmailAddresses == nil {
+		return fmt.Errorf(
+			"nothing to edit: use --name, --url, --token, --enabled, --triggers, --email-users or --email-addresses",
+		)
+	}
+
+	var triggers []tfe.NotificationTriggerType
+	if opts.Triggers != nil {
+		var err error
+		if triggers, err = create.ParseTriggers(opts.Triggers); err != nil {
+			return err
+		}
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	ws, err := client.Workspaces.Read(ctx, opts.WorkspaceID.Org, opts.WorkspaceID.Workspace)
+	if err != nil {
+		return fmt.Errorf("failed to read workspace %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	nc, err := client.NotificationConfigurations.ReadByNameOrID(ctx, ws.ID, opts.Identifier)
+	if err != nil {
+		return fmt.Errorf("failed to read notification %s of %s: %w", opts.Identifier, opts.WorkspaceID.String(), err)
+	}
+
+	err = create.ValidateDestination(nc.DestinationType, ptr.Deref(opts.URL), ptr.Deref(opts.Token),
+		len(opts.EmailUsers)+len(opts.EmailAddresses),
+	)
+	if err != nil {
+		return err
+	}
+
+	users, err := create.ResolveUsers(ctx, client, opts.WorkspaceID.Org, opts.EmailUsers)
+	if err != nil {
+		return err
+	}
+
+	nc, err = client.NotificationConfigurations.Update(ctx, nc.ID, tfe.NotificationConfigurationUpdateOptions{
+		Name:           opts.Name,
+		URL:            opts.URL,
+		Token:          opts.Token,
+		Enabled:        opts.Enabled,
+		Triggers:       triggers,
+		EmailUsers:     users,
+		EmailAddresses: opts.EmailAddresses,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update notification %s: %w", opts.Identifier, err)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Updated notification %s (%s) for %s\n", nc.Name, nc.ID, opts.WorkspaceID.String())
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package list
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+const (
+	ColumnID           string = "ID"
+	ColumnName         string = "NAME"
+	ColumnType         string = "TYPE"
+	ColumnEnabled      string = "ENABLED"
+	ColumnTriggers     string = "TRIGGERS"
+	ColumnDestination  string = "DESTINATION"
+	ColumnLastDelivery string = "LAST_DELIVERY"
+)
+
+var (
+	ColumnsDefault = []string{
+		ColumnName,
+		ColumnType,
+		ColumnEnabled,
+		ColumnTriggers,
+	}
+	ColumnsAll = []string{
+		ColumnID,
+		ColumnName,
+		ColumnType,
+		ColumnEnabled,
+		ColumnTriggers,
+		ColumnDestination,
+		ColumnLastDelivery,
+	}
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	WorkspaceID cmdutil.WorkspaceIdentifier
+	Columns     []string
+}
+
+func NewCmdList(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List the notifications of a workspace",
+		Aliases: []string{"ls"},
+		Long: text.Heredoc(`
+			List the notification configurations of a workspace.
+
+			If -W/--workspace is not specified and state.tf is present,
+			the organization and workspace will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			$ tfc workspaces notifications list -W myorg/myworkspace -c NAME,TYPE,LAST_DELIVERY
+		`),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceFlag(cmd, &opts.WorkspaceID, opts.TFEClient)
+	_ = cmdutil.FlagStringEnumSliceP(cmd, &opts.Columns, "columns", "c", ColumnsDefault, "Columns to show.", ColumnsAll)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, _ []string) {
+	cmdutil.CompleteWorkspaceIdentifierSilent(cmd, &opts.WorkspaceID, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := opts.WorkspaceID.Validate(); err != nil {
+		return fmt.Errorf("workspace required: use -W ORG/WORKSPACE or ensure state.tf exists")
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	ws, err := client.Workspaces.Read(ctx, opts.WorkspaceID.Org, opts.WorkspaceID.Workspace)
+	if err != nil {
+		return fmt.Errorf("failed to read workspace %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	configs, err := client.NotificationConfigurations.ListForWorkspace(ctx, ws.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list notifications for %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	p := cmdutil.FieldPrinter(opts.IO, opts.Columns...)
+	for _, nc := range configs {
+		p.Write(extractFields(nc))
+	}
+	p.Flush()
+
+	return nil
+}
+
+func extractFields(nc *tfc.NotificationConfiguration) map[string]string {
+	destination := nc.URL
+	if destination == "" {
+		var recipients []string
+		for _, u := range nc.EmailUsers {
+			recipients = append(recipients, u.ID)
+		}
+		recipients = append(recipients, nc.EmailAddresses...)
+		destination = strings.Join(recipients, ",")
+	}
+
+	lastDelivery := ""
+	if r := tfc.LastDeliveryResponse(nc); r != nil {
+		lastDelivery = r.Code
+		if ok, err := strconv.ParseBool(r.Successful); err == nil && !ok {
+			lastDelivery += " (failed)"
+		}
+	}
+
+	triggers := strings.Join(nc.Triggers, ",")
+	if triggers == "" {
+		triggers = "none"
+	}
+
+	return map[string]string{
+		ColumnID:           nc.ID,
+		ColumnName:         nc.Name,
+		ColumnType:         string(nc.DestinationType),
+		ColumnEnabled:      strconv.FormatBool(nc.Enabled),
+		ColumnTriggers:     triggers,
+		ColumnDestination:  destination,
+		ColumnLastDelivery: lastDelivery,
+	}
+}
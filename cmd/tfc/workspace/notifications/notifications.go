@@ -0,0 +1,35 @@
+package notifications
+
+import (
+	"github.com/spf13/cobra"
+
+	createCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/notifications/create"
+	deleteCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/notifications/delete"
+	editCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/notifications/edit"
+	listCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/notifications/list"
+	verifyCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/notifications/verify"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+func NewCmdNotifications(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "notifications",
+		Aliases: []string{"notification"},
+		Short:   "Manage the notifications of a workspace",
+		Long: text.Heredoc(`
+			Manage the notification configurations of a workspace.
+
+			A notification configuration sends the events of a workspace to a
+			generic webhook, Slack, Microsoft Teams or email.
+		`),
+	}
+
+	cmd.AddCommand(listCmd.NewCmdList(f))
+	cmd.AddCommand(createCmd.NewCmdCreate(f))
+	cmd.AddCommand(editCmd.NewCmdEdit(f))
+	cmd.AddCommand(deleteCmd.NewCmdDelete(f))
+	cmd.AddCommand(verifyCmd.NewCmdVerify(f))
+
+	return cmd
+}
@@ -0,0 +1,123 @@
+package verify
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+// maxBodyLength is the maximum length of the response body shown.
+const maxBodyLength = 500
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	WorkspaceID cmdutil.WorkspaceIdentifier
+	Identifier  string // Notification name or ID
+}
+
+func NewCmdVerify(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "verify <NAME|ID>",
+		Short: "Send a test notification",
+		Long: text.Heredoc(`
+			Send a verification payload to the destination of a notification
+			configuration and show the response of the destination.
+
+			The command fails when the destination doesn't accept the payload.
+
+			If -W/--workspace is not specified and state.tf is present,
+			the organization and workspace will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			$ tfc workspaces notifications verify ci -W myorg/myworkspace
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceFlag(cmd, &opts.WorkspaceID, opts.TFEClient)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, args []string) {
+	opts.Identifier = args[0]
+	cmdutil.CompleteWorkspaceIdentifierSilent(cmd, &opts.WorkspaceID, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := opts.WorkspaceID.Validate(); err != nil {
+		return fmt.Errorf("workspace required: use -W ORG/WORKSPACE or ensure state.tf exists")
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	ws, err := client.Workspaces.Read(ctx, opts.WorkspaceID.Org, opts.WorkspaceID.Workspace)
+	if err != nil {
+		return fmt.Errorf("failed to read workspace %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	nc, err := client.NotificationConfigurations.ReadByNameOrID(ctx, ws.ID, opts.Identifier)
+	if err != nil {
+		return fmt.Errorf("failed to read notification %s of %s: %w", opts.Identifier, opts.WorkspaceID.String(), err)
+	}
+
+	nc, err = client.NotificationConfigurations.Verify(ctx, nc.ID)
+	if err != nil {
+		return fmt.Errorf("failed to verify notification %s: %w", opts.Identifier, err)
+	}
+
+	r := tfc.LastDeliveryResponse(nc)
+	if r == nil {
+		fmt.Fprintf(opts.IO.Out, "Sent a verification for notification %s\n", nc.Name)
+		return nil
+	}
+
+	destination := r.URL
+	if destination == "" {
+		destination = nc.URL
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Sent a verification for notification %s to %s\n", nc.Name, destination)
+	fmt.Fprintf(opts.IO.Out, "  Response:             %s\n", r.Code)
+
+	if body := strings.TrimSpace(r.Body); body != "" {
+		if len(body) > maxBodyLength {
+			body = body[:maxBodyLength] + "..."
+		}
+		fmt.Fprintf(opts.IO.Out, "  Body:                 %s\n", body)
+	}
+
+	if ok, err := strconv.ParseBool(r.Successful); err == nil && !ok {
+		return fmt.Errorf("notification %s failed verification: the destination responded %s", nc.Name, r.Code)
+	}
+
+	return nil
+}
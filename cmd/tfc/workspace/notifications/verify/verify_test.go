@@ -0,0 +1,135 @@
+package verify_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/zkhvan/tfc/cmd/tfc/workspace/notifications/verify"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+func TestVerify(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces/app",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "ws-1", "type": "workspaces", "attributes": {"name": "app"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/ws-1/notification-configurations",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "nc-1", "type": "notification-configurations", "attributes": {"name": "slack"}},
+				{"id": "nc-2", "type": "notification-configurations",
+					"attributes": {"name": "ci", "url": "https://ci.example.com/hook"}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"POST /api/v2/notification-configurations/nc-2/actions/verify",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "nc-2", "type": "notification-configurations", "attributes": {
+				"name": "ci", "url": "https://ci.example.com/hook", "delivery-responses": [
+					{"code": "500", "successful": "false", "body": "down", "sent-at": "2000-01-01T10:00:00Z"},
+					{"code": "200", "successful": "true", "body": "ok", "sent-at": "2000-01-01T12:00:00Z",
+						"url": "https://ci.example.com/hook"}
+				]}}}`)
+		},
+	)
+
+	result := runCommand(t, client, "ci", "-W", "myorg/app")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		Sent a verification for notification ci to https://ci.example.com/hook
+		  Response:             200
+		  Body:                 ok
+	`))
+}
+
+func TestVerify_failed(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces/app",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "ws-1", "type": "workspaces", "attributes": {"name": "app"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/ws-1/notification-configurations",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "nc-1", "type": "notification-configurations", "attributes": {"name": "slack"}},
+				{"id": "nc-2", "type": "notification-configurations",
+					"attributes": {"name": "ci", "url": "https://ci.example.com/hook"}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"POST /api/v2/notification-configurations/nc-2/actions/verify",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "nc-2", "type": "notification-configurations", "attributes": {
+				"name": "ci", "url": "https://ci.example.com/hook", "delivery-responses": [
+					{"code": "401", "successful": "false", "body": "invalid signature", "sent-at": "2000-01-01T12:00:00Z"}
+				]}}}`)
+		},
+	)
+
+	result := runCommand(t, client, "ci", "-W", "myorg/app")
+
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		Sent a verification for notification ci to https://ci.example.com/hook
+		  Response:             401
+		  Body:                 invalid signature
+	`))
+	test.Buffer(t, result.ErrBuf, "notification ci failed verification: the destination responded 401\n")
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+	}
+
+	cmd := verify.NewCmdVerify(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
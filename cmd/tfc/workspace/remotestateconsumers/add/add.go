@@ -0,0 +1,117 @@
+package add
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	WorkspaceID cmdutil.WorkspaceIdentifier
+	Consumers   []string // Workspace names
+}
+
+func NewCmdAdd(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "add <WORKSPACE>...",
+		Short: "Allow workspaces to read the state of a workspace",
+		Long: text.Heredoc(`
+			Allow workspaces of the same organization to read the state of a
+			workspace.
+
+			If -W/--workspace is not specified and state.tf is present,
+			the organization and workspace will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Let app and dns read the outputs of network
+			$ tfc workspaces remote-state-consumers add app dns -W myorg/network
+		`),
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceFlag(cmd, &opts.WorkspaceID, opts.TFEClient)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, args []string) {
+	opts.Consumers = args
+	cmdutil.CompleteWorkspaceIdentifierSilent(cmd, &opts.WorkspaceID, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := opts.WorkspaceID.Validate(); err != nil {
+		return fmt.Errorf("workspace required: use -W ORG/WORKSPACE or ensure state.tf exists")
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	ws, err := client.Workspaces.Read(ctx, opts.WorkspaceID.Org, opts.WorkspaceID.Workspace)
+	if err != nil {
+		return fmt.Errorf("failed to read workspace %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	ids, err := ReadWorkspaceIDs(ctx, client, opts.WorkspaceID.Org, opts.Consumers)
+	if err != nil {
+		return err
+	}
+
+	if err := client.Workspaces.AddRemoteStateConsumers(ctx, ws.ID, ids); err != nil {
+		return fmt.Errorf("failed to add remote state consumers to %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Allowed %s to read the state of %s\n",
+		strings.Join(opts.Consumers, ", "), opts.WorkspaceID.String(),
+	)
+
+	if ws.GlobalRemoteState {
+		fmt.Fprintf(opts.IO.ErrOut,
+			"%s has global remote state: the remote state consumers only apply once it's disabled\n",
+			opts.WorkspaceID.String(),
+		)
+	}
+
+	return nil
+}
+
+// ReadWorkspaceIDs reads the IDs of workspaces of an organization by their
+// names.
+func ReadWorkspaceIDs(ctx context.Context, client *tfc.Client, org string, names []string) ([]string, error) {
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		ws, err := client.Workspaces.Read(ctx, org, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read workspace %s/%s: %w", org, name, err)
+		}
+		ids = append(ids, ws.ID)
+	}
+	return ids, nil
+}
@@ -0,0 +1,140 @@
+package add_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/zkhvan/tfc/cmd/tfc/workspace/remotestateconsumers/add"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+func TestAdd(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces/network",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "ws-1", "type": "workspaces",
+				"attributes": {"name": "network", "global-remote-state": false}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces/app",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "ws-2", "type": "workspaces", "attributes": {"name": "app"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces/dns",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "ws-3", "type": "workspaces", "attributes": {"name": "dns"}}}`)
+		},
+	)
+
+	var ids []string
+	mux.HandleFunc(
+		"POST /api/v2/workspaces/ws-1/relationships/remote-state-consumers",
+		func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Data []struct {
+					ID string `json:"id"`
+				} `json:"data"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Error(err)
+			}
+			for _, d := range body.Data {
+				ids = append(ids, d.ID)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		},
+	)
+
+	result := runCommand(t, client, "app", "dns", "-W", "myorg/network")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, "Allowed app, dns to read the state of myorg/network\n")
+
+	if diff := cmp.Diff([]string{"ws-2", "ws-3"}, ids); diff != "" {
+		t.Errorf("consumer IDs mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestAdd_global_remote_state(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces/network",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "ws-1", "type": "workspaces",
+				"attributes": {"name": "network", "global-remote-state": true}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces/app",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "ws-2", "type": "workspaces", "attributes": {"name": "app"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"POST /api/v2/workspaces/ws-1/relationships/remote-state-consumers",
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		},
+	)
+
+	result := runCommand(t, client, "app", "-W", "myorg/network")
+
+	test.Buffer(t, result.OutBuf, "Allowed app to read the state of myorg/network\n")
+	test.Buffer(t, result.ErrBuf,
+		"myorg/network has global remote state: the remote state consumers only apply once it's disabled\n",
+	)
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+	}
+
+	cmd := add.NewCmdAdd(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
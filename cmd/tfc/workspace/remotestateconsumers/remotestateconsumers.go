@@ -0,0 +1,37 @@
+package remotestateconsumers
+
+import (
+	"github.com/spf13/cobra"
+
+	addCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/remotestateconsumers/add"
+	listCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/remotestateconsumers/list"
+	removeCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/remotestateconsumers/remove"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+func NewCmdRemoteStateConsumers(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remote-state-consumers",
+		Aliases: []string{"remote-state-consumer"},
+		Short:   "Manage the workspaces that can read the state of a workspace",
+		Long: text.Heredoc(`
+			Manage the remote state consumers of a workspace: the workspaces
+			allowed to read its state with the terraform_remote_state or
+			tfe_outputs data sources.
+
+			A workspace with global remote state shares its state with every
+			workspace of the organization, and its remote state consumers
+			don't apply until global remote state is disabled.
+
+			Use "tfc graph remote-state" to see which workspaces read the
+			state of which across a whole organization.
+		`),
+	}
+
+	cmd.AddCommand(listCmd.NewCmdList(f))
+	cmd.AddCommand(addCmd.NewCmdAdd(f))
+	cmd.AddCommand(removeCmd.NewCmdRemove(f))
+
+	return cmd
+}
@@ -0,0 +1,209 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/term/color"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+const (
+	ColumnID         string = "ID"
+	ColumnAddress    string = "ADDRESS"
+	ColumnType       string = "TYPE"
+	ColumnName       string = "NAME"
+	ColumnProvider   string = "PROVIDER"
+	ColumnModule     string = "MODULE"
+	ColumnCreatedAt  string = "CREATED_AT"
+	ColumnModifiedAt string = "MODIFIED_AT"
+)
+
+var (
+	ColumnsDefault = []string{
+		ColumnAddress,
+		ColumnProvider,
+		ColumnModule,
+		ColumnModifiedAt,
+	}
+	ColumnsAll = []string{
+		ColumnID,
+		ColumnAddress,
+		ColumnType,
+		ColumnName,
+		ColumnProvider,
+		ColumnModule,
+		ColumnCreatedAt,
+		ColumnModifiedAt,
+	}
+)
+
+var (
+	TimeStyle = lipgloss.NewStyle().Foreground(color.LightBlack)
+)
+
+// Resource is the JSON representation of a workspace resource.
+type Resource struct {
+	Organization string `json:"organization,omitempty"`
+	Workspace    string `json:"workspace,omitempty"`
+	ID           string `json:"id"`
+	Address      string `json:"address"`
+	Type         string `json:"type"`
+	Name         string `json:"name"`
+	Provider     string `json:"provider"`
+	Module       string `json:"module"`
+	CreatedAt    string `json:"created_at"`
+	ModifiedAt   string `json:"modified_at"`
+}
+
+// NewResource converts a workspace resource to its JSON representation.
+func NewResource(r *tfc.WorkspaceResource) Resource {
+	return Resource{
+		ID:         r.ID,
+		Address:    r.Address,
+		Type:       r.ProviderType,
+		Name:       r.Name,
+		Provider:   r.Provider,
+		Module:     r.Module,
+		CreatedAt:  r.CreatedAt,
+		ModifiedAt: r.UpdatedAt,
+	}
+}
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	Clock           *cmdutil.Clock
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	WorkspaceID cmdutil.WorkspaceIdentifier
+	Limit       int
+	Columns     []string
+	Format      string
+}
+
+func NewCmdResources(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		Clock:           f.Clock,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "resources",
+		Short: "List a workspace's managed resources",
+		Long: text.Heredoc(`
+			List the resources managed by a workspace, as recorded in its
+			current state.
+
+			If -W/--workspace is not specified and state.tf is present,
+			the organization and workspace will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# List the resources of the workspace in state.tf
+			$ tfc workspaces resources
+
+			# List the resources of a workspace as JSON
+			$ tfc workspaces resources -W myorg/myworkspace --format json
+		`),
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceFlag(cmd, &opts.WorkspaceID, opts.TFEClient)
+	cmdutil.AddFormatFlag(cmd, &opts.Format)
+
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "l", 100, "Limit the number of results.")
+	_ = cmdutil.FlagStringEnumSliceP(cmd, &opts.Columns, "columns", "c", ColumnsDefault, "Columns to show.", ColumnsAll)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, _ []string) {
+	cmdutil.CompleteWorkspaceIdentifierSilent(cmd, &opts.WorkspaceID, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := opts.WorkspaceID.Validate(); err != nil {
+		return fmt.Errorf("workspace required: use -W ORG/WORKSPACE or ensure state.tf exists")
+	}
+
+	if err := cmdutil.ValidateEnum("format", opts.Format, []string{cmdutil.FormatTable, cmdutil.FormatJSON}); err != nil {
+		return err
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	ws, err := client.Workspaces.Read(ctx, opts.WorkspaceID.Org, opts.WorkspaceID.Workspace)
+	if err != nil {
+		return fmt.Errorf("failed to read workspace %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	resources, paging, err := client.WorkspaceResources.List(ctx, ws.ID, &tfc.WorkspaceResourceListOptions{
+		ListOptions: tfc.ListOptions{Limit: opts.Limit},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list resources for %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	if opts.Format == cmdutil.FormatJSON {
+		out := make([]Resource, 0, len(resources))
+		for _, r := range resources {
+			out = append(out, NewResource(r))
+		}
+		return cmdutil.PrintJSON(opts.IO, out)
+	}
+
+	if paging.ReachedLimit {
+		fmt.Fprintf(opts.IO.Out, "Showing top %d results\n\n", opts.Limit)
+	}
+
+	p := cmdutil.FieldPrinter(opts.IO, opts.Columns...)
+	for _, r := range resources {
+		p.Write(ExtractFields(opts.Clock, r))
+	}
+	p.Flush()
+
+	return nil
+}
+
+// ExtractFields returns the table columns of a workspace resource.
+func ExtractFields(clock *cmdutil.Clock, r *tfc.WorkspaceResource) map[string]string {
+	renderTime := func(at string) string {
+		t, err := time.Parse(time.RFC3339, at)
+		if err != nil {
+			return at
+		}
+
+		return TimeStyle.Render(text.RelativeTimeAgo(clock.Now(), t))
+	}
+
+	return map[string]string{
+		ColumnID:         r.ID,
+		ColumnAddress:    r.Address,
+		ColumnType:       r.ProviderType,
+		ColumnName:       r.Name,
+		ColumnProvider:   r.Provider,
+		ColumnModule:     r.Module,
+		ColumnCreatedAt:  renderTime(r.CreatedAt),
+		ColumnModifiedAt: renderTime(r.UpdatedAt),
+	}
+}
@@ -0,0 +1,231 @@
+package attach
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+var (
+	Stages = []string{
+		string(tfe.PrePlan),
+		string(tfe.PostPlan),
+		string(tfe.PreApply),
+		string(tfe.PostApply),
+	}
+	EnforcementLevels = []string{
+		string(tfe.Advisory),
+		string(tfe.Mandatory),
+	}
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	WorkspaceID cmdutil.WorkspaceIdentifier
+	Task        string // Run task name or ID
+
+	// Stages and enforcement level, only set for the given flags. A new
+	// attachment defaults to advisory in post_plan, an existing one keeps
+	// its settings.
+	Stages      *[]string
+	Enforcement *string
+}
+
+func NewCmdAttach(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	var (
+		stages      []string
+		enforcement string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "attach <TASK>",
+		Short: "Attach a run task to a workspace",
+		Long: text.Heredoc(`
+			Attach a run task to a workspace, or change the stages and
+			enforcement level of a run task that is already attached.
+
+			The --stage is one or more of pre_plan, post_plan, pre_apply and
+			post_apply. The --enforcement level is advisory or mandatory, a
+			failed mandatory task stops the run. A run task is attached as
+			advisory in post_plan by default. For a run task that is already
+			attached, only the settings of the given flags are changed.
+
+			If -W/--workspace is not specified and state.tf is present,
+			the organization and workspace will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Run a task after the plan, only warning when it fails
+			$ tfc workspaces run-tasks attach snyk -W myorg/myworkspace
+
+			# Block the apply when the task fails
+			$ tfc workspaces run-tasks attach snyk --stage post_plan,pre_apply --enforcement mandatory
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			if flags.Changed("stage") {
+				opts.Stages = &stages
+			}
+			if flags.Changed("enforcement") {
+				opts.Enforcement = &enforcement
+			}
+
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceFlag(cmd, &opts.WorkspaceID, opts.TFEClient)
+
+	_ = cmdutil.FlagStringEnumSlice(cmd, &stages, "stage", []string{string(tfe.PostPlan)},
+		"Stages to run the task in", Stages,
+	)
+	_ = cmdutil.FlagStringEnum(cmd, &enforcement, "enforcement", string(tfe.Advisory),
+		"Enforcement level: advisory or mandatory", EnforcementLevels,
+	)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, args []string) {
+	opts.Task = args[0]
+	cmdutil.CompleteWorkspaceIdentifierSilent(cmd, &opts.WorkspaceID, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := opts.WorkspaceID.Validate(); err != nil {
+		return fmt.Errorf("workspace required: use -W ORG/WORKSPACE or ensure state.tf exists")
+	}
+
+	if err := opts.validate(); err != nil {
+		return err
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	ws, err := client.Workspaces.Read(ctx, opts.WorkspaceID.Org, opts.WorkspaceID.Workspace)
+	if err != nil {
+		return fmt.Errorf("failed to read workspace %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	task, err := client.RunTasks.ReadByNameOrID(ctx, opts.WorkspaceID.Org, opts.Task)
+	if err != nil {
+		return fmt.Errorf("failed to read run task %s: %w", opts.Task, err)
+	}
+
+	attached, err := client.RunTasks.ListForWorkspace(ctx, ws.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list run tasks for %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	for _, wrt := range attached {
+		if wrt.RunTask == nil || wrt.RunTask.ID != task.ID {
+			continue
+		}
+
+		level, stages := wrt.EnforcementLevel, wrt.Stages
+		if len(stages) == 0 && wrt.Stage != "" {
+			stages = []tfe.Stage{wrt.Stage}
+		}
+
+		if opts.Stages == nil && opts.Enforcement == nil {
+			fmt.Fprintf(opts.IO.Out, "Run task %s is already attached to %s to run %s in %s\n",
+				task.Name, opts.WorkspaceID.String(), level, formatStages(stages))
+			return nil
+		}
+
+		if opts.Stages != nil {
+			stages = toStages(*opts.Stages)
+		}
+		if opts.Enforcement != nil {
+			level = tfe.TaskEnforcementLevel(*opts.Enforcement)
+		}
+
+		if _, err := client.RunTasks.UpdateAttachment(ctx, ws.ID, wrt.ID, level, stages); err != nil {
+			return fmt.Errorf("failed to update run task %s: %w", task.Name, err)
+		}
+
+		fmt.Fprintf(opts.IO.Out, "Updated run task %s on %s to run %s in %s\n",
+			task.Name, opts.WorkspaceID.String(), level, formatStages(stages))
+		return nil
+	}
+
+	stages := []tfe.Stage{tfe.PostPlan}
+	if opts.Stages != nil {
+		stages = toStages(*opts.Stages)
+	}
+	level := tfe.Advisory
+	if opts.Enforcement != nil {
+		level = tfe.TaskEnforcementLevel(*opts.Enforcement)
+	}
+
+	if _, err := client.RunTasks.Attach(ctx, ws.ID, task.ID, level, stages); err != nil {
+		return fmt.Errorf("failed to attach run task %s: %w", task.Name, err)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Attached run task %s to %s to run %s in %s\n",
+		task.Name, opts.WorkspaceID.String(), level, formatStages(stages))
+
+	return nil
+}
+
+func (opts *Options) validate() error {
+	if opts.Stages != nil {
+		if len(*opts.Stages) == 0 {
+			return fmt.Errorf("stage required: use --stage with one of %s", strings.Join(Stages, ", "))
+		}
+		for _, s := range *opts.Stages {
+			if err := cmdutil.ValidateEnum("stage", s, Stages); err != nil {
+				return err
+			}
+		}
+	}
+
+	if opts.Enforcement != nil {
+		if err := cmdutil.ValidateEnum("enforcement", *opts.Enforcement, EnforcementLevels); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func toStages(names []string) []tfe.Stage {
+	stages := make([]tfe.Stage, 0, len(names))
+	for _, s := range names {
+		stages = append(stages, tfe.Stage(s))
+	}
+	return stages
+}
+
+func formatStages(stages []tfe.Stage) string {
+	names := make([]string, 0, len(stages))
+	for _, s := range stages {
+		names = append(names, string(s))
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,301 @@
+package attach_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/zkhvan/tfc/cmd/tfc/workspace/runtasks/attach"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+func TestAttach(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces/app",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "ws-1", "type": "workspaces", "attributes": {"name": "app"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/tasks",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "task-1", "type": "tasks", "attributes": {"name": "snyk"}},
+				{"id": "task-2", "type": "tasks", "attributes": {"name": "cost-check"}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/ws-1/tasks",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": []}`)
+		},
+	)
+
+	var body string
+	mux.HandleFunc(
+		"POST /api/v2/workspaces/ws-1/tasks",
+		func(w http.ResponseWriter, r *http.Request) {
+			b, _ := io.ReadAll(r.Body)
+			body = string(b)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"data": {"id": "wstask-1", "type": "workspace-tasks"}}`)
+		},
+	)
+
+	result := runCommand(t, client, "snyk", "-W", "myorg/app",
+		"--stage", "post_plan,pre_apply", "--enforcement", "mandatory",
+	)
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, "Attached run task snyk to myorg/app to run mandatory in post_plan, pre_apply\n")
+
+	want := `{"data":{"type":"workspace-tasks","attributes":{"enforcement-level":"mandatory",` +
+		`"stages":["post_plan","pre_apply"]},"relationships":{"task":{"data":{"type":"tasks","id":"task-1"}}}}}` + "\n"
+	if body != want {
+		t.Errorf("got body %s, want %s", body, want)
+	}
+}
+
+func TestAttach_already_attached(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces/app",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "ws-1", "type": "workspaces", "attributes": {"name": "app"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/tasks",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "task-1", "type": "tasks", "attributes": {"name": "snyk"}},
+				{"id": "task-2", "type": "tasks", "attributes": {"name": "cost-check"}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/ws-1/tasks",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{
+					"id": "wstask-1",
+					"type": "workspace-tasks",
+					"attributes": {"enforcement-level": "advisory", "stages": ["post_plan"]},
+					"relationships": {"task": {"data": {"id": "task-1", "type": "tasks"}}}
+				}
+			]}`)
+		},
+	)
+
+	var updated bool
+	mux.HandleFunc(
+		"PATCH /api/v2/workspaces/ws-1/tasks/wstask-1",
+		func(w http.ResponseWriter, _ *http.Request) {
+			updated = true
+			fmt.Fprint(w, `{"data": {"id": "wstask-1", "type": "workspace-tasks"}}`)
+		},
+	)
+
+	result := runCommand(t, client, "snyk", "-W", "myorg/app", "--enforcement", "mandatory")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, "Updated run task snyk on myorg/app to run mandatory in post_plan\n")
+
+	if !updated {
+		t.Error("expected the workspace run task to be updated")
+	}
+}
+
+func TestAttach_already_attached_keeps_stages(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces/app",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "ws-1", "type": "workspaces", "attributes": {"name": "app"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/tasks",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "task-1", "type": "tasks", "attributes": {"name": "snyk"}},
+				{"id": "task-2", "type": "tasks", "attributes": {"name": "cost-check"}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/ws-1/tasks",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{
+					"id": "wstask-1",
+					"type": "workspace-tasks",
+					"attributes": {"enforcement-level": "mandatory", "stages": ["pre_apply"]},
+					"relationships": {"task": {"data": {"id": "task-1", "type": "tasks"}}}
+				}
+			]}`)
+		},
+	)
+
+	var body string
+	mux.HandleFunc(
+		"PATCH /api/v2/workspaces/ws-1/tasks/wstask-1",
+		func(w http.ResponseWriter, r *http.Request) {
+			b, _ := io.ReadAll(r.Body)
+			body = string(b)
+			fmt.Fprint(w, `{"data": {"id": "wstask-1", "type": "workspace-tasks"}}`)
+		},
+	)
+
+	result := runCommand(t, client, "snyk", "-W", "myorg/app", "--enforcement", "advisory")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, "Updated run task snyk on myorg/app to run advisory in pre_apply\n")
+
+	if !strings.Contains(body, `"stages":["pre_apply"]`) {
+		t.Errorf("got body %s, want the stages kept", body)
+	}
+}
+
+func TestAttach_already_attached_without_flags(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces/app",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "ws-1", "type": "workspaces", "attributes": {"name": "app"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/tasks",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "task-1", "type": "tasks", "attributes": {"name": "snyk"}},
+				{"id": "task-2", "type": "tasks", "attributes": {"name": "cost-check"}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/ws-1/tasks",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{
+					"id": "wstask-1",
+					"type": "workspace-tasks",
+					"attributes": {"enforcement-level": "mandatory", "stages": ["pre_apply"]},
+					"relationships": {"task": {"data": {"id": "task-1", "type": "tasks"}}}
+				}
+			]}`)
+		},
+	)
+
+	var updated bool
+	mux.HandleFunc(
+		"PATCH /api/v2/workspaces/ws-1/tasks/wstask-1",
+		func(w http.ResponseWriter, _ *http.Request) {
+			updated = true
+			fmt.Fprint(w, `{"data": {"id": "wstask-1", "type": "workspace-tasks"}}`)
+		},
+	)
+
+	result := runCommand(t, client, "snyk", "-W", "myorg/app")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, "Run task snyk is already attached to myorg/app to run mandatory in pre_apply\n")
+
+	if updated {
+		t.Error("expected the workspace run task not to be updated")
+	}
+}
+
+func TestAttach_invalid_stage(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/workspaces/app",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": {"id": "ws-1", "type": "workspaces", "attributes": {"name": "app"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/myorg/tasks",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": [
+				{"id": "task-1", "type": "tasks", "attributes": {"name": "snyk"}},
+				{"id": "task-2", "type": "tasks", "attributes": {"name": "cost-check"}}
+			]}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/ws-1/tasks",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data": []}`)
+		},
+	)
+
+	result := runCommand(t, client, "snyk", "-W", "myorg/app", "--stage", "post_apply,apply")
+
+	test.Buffer(t, result.ErrBuf,
+		"invalid stage \"apply\": must be one of pre_plan, post_plan, pre_apply, post_apply\n",
+	)
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+	}
+
+	cmd := attach.NewCmdAttach(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
@@ -0,0 +1,103 @@
+package detach
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	WorkspaceID cmdutil.WorkspaceIdentifier
+	Task        string // Run task name or ID
+}
+
+func NewCmdDetach(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "detach <TASK>",
+		Short: "Detach a run task from a workspace",
+		Long: text.Heredoc(`
+			Detach a run task from a workspace. The run task itself is kept.
+
+			If -W/--workspace is not specified and state.tf is present,
+			the organization and workspace will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			$ tfc workspaces run-tasks detach snyk -W myorg/myworkspace
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceFlag(cmd, &opts.WorkspaceID, opts.TFEClient)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, args []string) {
+	opts.Task = args[0]
+	cmdutil.CompleteWorkspaceIdentifierSilent(cmd, &opts.WorkspaceID, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := opts.WorkspaceID.Validate(); err != nil {
+		return fmt.Errorf("workspace required: use -W ORG/WORKSPACE or ensure state.tf exists")
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	ws, err := client.Workspaces.Read(ctx, opts.WorkspaceID.Org, opts.WorkspaceID.Workspace)
+	if err != nil {
+		return fmt.Errorf("failed to read workspace %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	task, err := client.RunTasks.ReadByNameOrID(ctx, opts.WorkspaceID.Org, opts.Task)
+	if err != nil {
+		return fmt.Errorf("failed to read run task %s: %w", opts.Task, err)
+	}
+
+	attached, err := client.RunTasks.ListForWorkspace(ctx, ws.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list run tasks for %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	for _, wrt := range attached {
+		if wrt.RunTask == nil || wrt.RunTask.ID != task.ID {
+			continue
+		}
+
+		if err := client.RunTasks.Detach(ctx, ws.ID, wrt.ID); err != nil {
+			return fmt.Errorf("failed to detach run task %s: %w", task.Name, err)
+		}
+
+		fmt.Fprintf(opts.IO.Out, "Detached run task %s from %s\n", task.Name, opts.WorkspaceID.String())
+		return nil
+	}
+
+	return fmt.Errorf("run task %s is not attached to %s", task.Name, opts.WorkspaceID.String())
+}
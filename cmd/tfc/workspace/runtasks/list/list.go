@@ -0,0 +1,159 @@
+package list
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+const (
+	ColumnID          string = "ID"
+	ColumnTask        string = "TASK"
+	ColumnTaskID      string = "TASK_ID"
+	ColumnStages      string = "STAGES"
+	ColumnEnforcement string = "ENFORCEMENT"
+)
+
+var (
+	ColumnsDefault = []string{
+		ColumnTask,
+		ColumnStages,
+		ColumnEnforcement,
+	}
+	ColumnsAll = []string{
+		ColumnID,
+		ColumnTask,
+		ColumnTaskID,
+		ColumnStages,
+		ColumnEnforcement,
+	}
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	WorkspaceID cmdutil.WorkspaceIdentifier
+	Columns     []string
+}
+
+func NewCmdList(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List the run tasks of a workspace",
+		Aliases: []string{"ls"},
+		Long: text.Heredoc(`
+			List the run tasks attached to a workspace, with the stages they
+			run in and their enforcement level.
+
+			If -W/--workspace is not specified and state.tf is present,
+			the organization and workspace will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			$ tfc workspaces run-tasks list -W myorg/myworkspace
+		`),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceFlag(cmd, &opts.WorkspaceID, opts.TFEClient)
+	_ = cmdutil.FlagStringEnumSliceP(cmd, &opts.Columns, "columns", "c", ColumnsDefault, "Columns to show.", ColumnsAll)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, _ []string) {
+	cmdutil.CompleteWorkspaceIdentifierSilent(cmd, &opts.WorkspaceID, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := opts.WorkspaceID.Validate(); err != nil {
+		return fmt.Errorf("workspace required: use -W ORG/WORKSPACE or ensure state.tf exists")
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	ws, err := client.Workspaces.Read(ctx, opts.WorkspaceID.Org, opts.WorkspaceID.Workspace)
+	if err != nil {
+		return fmt.Errorf("failed to read workspace %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	attached, err := client.RunTasks.ListForWorkspace(ctx, ws.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list run tasks for %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	// Workspace run tasks only reference run tasks by ID.
+	names := map[string]string{}
+	if len(attached) > 0 {
+		tasks, _, err := client.RunTasks.List(ctx, opts.WorkspaceID.Org, &tfc.RunTaskListOptions{
+			ListOptions: tfc.ListOptions{Limit: math.MaxInt},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list run tasks for %s: %w", opts.WorkspaceID.Org, err)
+		}
+		for _, t := range tasks {
+			names[t.ID] = t.Name
+		}
+	}
+
+	p := cmdutil.FieldPrinter(opts.IO, opts.Columns...)
+	for _, wrt := range attached {
+		p.Write(extractFields(wrt, names))
+	}
+	p.Flush()
+
+	return nil
+}
+
+func extractFields(wrt *tfc.WorkspaceRunTask, names map[string]string) map[string]string {
+	taskID := ""
+	if wrt.RunTask != nil {
+		taskID = wrt.RunTask.ID
+	}
+
+	name := taskID
+	if n, ok := names[taskID]; ok {
+		name = n
+	}
+
+	var stages []string
+	for _, s := range wrt.Stages {
+		stages = append(stages, string(s))
+	}
+	if len(stages) == 0 && wrt.Stage != "" {
+		stages = append(stages, string(wrt.Stage))
+	}
+
+	return map[string]string{
+		ColumnID:          wrt.ID,
+		ColumnTask:        name,
+		ColumnTaskID:      taskID,
+		ColumnStages:      strings.Join(stages, ","),
+		ColumnEnforcement: string(wrt.EnforcementLevel),
+	}
+}
@@ -0,0 +1,32 @@
+package runtasks
+
+import (
+	"github.com/spf13/cobra"
+
+	attachCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/runtasks/attach"
+	detachCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/runtasks/detach"
+	listCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/runtasks/list"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+func NewCmdRunTasks(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "run-tasks",
+		Aliases: []string{"run-task"},
+		Short:   "Manage the run tasks of a workspace",
+		Long: text.Heredoc(`
+			Manage the run tasks attached to a workspace.
+
+			An attached run task runs in the given stages of the workspace's
+			runs. A failed mandatory task stops the run, a failed advisory
+			task only warns.
+		`),
+	}
+
+	cmd.AddCommand(listCmd.NewCmdList(f))
+	cmd.AddCommand(attachCmd.NewCmdAttach(f))
+	cmd.AddCommand(detachCmd.NewCmdDetach(f))
+
+	return cmd
+}
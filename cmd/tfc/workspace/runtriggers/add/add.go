@@ -0,0 +1,123 @@
+package add
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+const (
+	DirectionInbound  string = "inbound"
+	DirectionOutbound string = "outbound"
+)
+
+var Directions = []string{DirectionInbound, DirectionOutbound}
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	WorkspaceID cmdutil.WorkspaceIdentifier
+	Other       string // Name of the other workspace
+	Direction   string
+}
+
+func NewCmdAdd(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "add <WORKSPACE>",
+		Short: "Add a run trigger to a workspace",
+		Long: text.Heredoc(`
+			Add a run trigger between a workspace and another workspace of
+			the same organization.
+
+			With --direction inbound, the default, an apply in the given
+			workspace queues a run in the workspace. With --direction
+			outbound, an apply in the workspace queues a run in the given
+			workspace.
+
+			If -W/--workspace is not specified and state.tf is present,
+			the organization and workspace will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Queue a run in app after network applies
+			$ tfc workspaces run-triggers add network -W myorg/app
+
+			# Queue a run in dns and app after network applies
+			$ tfc workspaces run-triggers add dns --direction outbound -W myorg/network
+			$ tfc workspaces run-triggers add app --direction outbound -W myorg/network
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceFlag(cmd, &opts.WorkspaceID, opts.TFEClient)
+
+	_ = cmdutil.FlagStringEnum(cmd, &opts.Direction, "direction", DirectionInbound,
+		"Whether the given workspace triggers the workspace (inbound) or the reverse (outbound)", Directions,
+	)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, args []string) {
+	opts.Other = args[0]
+	cmdutil.CompleteWorkspaceIdentifierSilent(cmd, &opts.WorkspaceID, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := opts.WorkspaceID.Validate(); err != nil {
+		return fmt.Errorf("workspace required: use -W ORG/WORKSPACE or ensure state.tf exists")
+	}
+
+	if err := cmdutil.ValidateEnum("direction", opts.Direction, Directions); err != nil {
+		return err
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	ws, err := client.Workspaces.Read(ctx, opts.WorkspaceID.Org, opts.WorkspaceID.Workspace)
+	if err != nil {
+		return fmt.Errorf("failed to read workspace %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	other, err := client.Workspaces.Read(ctx, opts.WorkspaceID.Org, opts.Other)
+	if err != nil {
+		return fmt.Errorf("failed to read workspace %s/%s: %w", opts.WorkspaceID.Org, opts.Other, err)
+	}
+
+	source, target := other, ws
+	if opts.Direction == DirectionOutbound {
+		source, target = ws, other
+	}
+
+	if _, err := client.RunTriggers.Create(ctx, target.ID, source.ID); err != nil {
+		return fmt.Errorf("failed to add run trigger from %s to %s: %w", source.Name, target.Name, err)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Added a run trigger: an apply in %s queues a run in %s\n", source.Name, target.Name)
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package add_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/zkhvan/tfc/cmd/tfc/workspace/runtriggers/add"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+func TestAdd(t *testing.T) {
+	// Both directions create the run trigger on app, sourced from network.
+	wantBody := `{"data":{"type":"run-triggers","relationships":` +
+		`{"sourceable":{"data":{"type":"workspaces","id":"ws-2"}}}}}` + "\n"
+
+	tests := []struct {
+		name    string
+		args    []string
+		path    string
+		wantOut string
+	}{
+		{
+			name:    "inbound",
+			args:    []string{"network", "-W", "myorg/app"},
+			path:    "POST /api/v2/workspaces/ws-1/run-triggers",
+			wantOut: "Added a run trigger: an apply in network queues a run in app\n",
+		},
+		{
+			name:    "outbound",
+			args:    []string{"app", "--direction", "outbound", "-W", "myorg/network"},
+			path:    "POST /api/v2/workspaces/ws-1/run-triggers",
+			wantOut: "Added a run trigger: an apply in network queues a run in app\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mux, teardown := tfetest.Setup()
+			defer teardown()
+
+			mux.HandleFunc(
+				"GET /api/v2/organizations/myorg/workspaces/app",
+				func(w http.ResponseWriter, _ *http.Request) {
+					fmt.Fprint(w, `{"data": {"id": "ws-1", "type": "workspaces", "attributes": {"name": "app"}}}`)
+				},
+			)
+
+			mux.HandleFunc(
+				"GET /api/v2/organizations/myorg/workspaces/network",
+				func(w http.ResponseWriter, _ *http.Request) {
+					fmt.Fprint(w, `{"data": {"id": "ws-2", "type": "workspaces", "attributes": {"name": "network"}}}`)
+				},
+			)
+
+			var body string
+			mux.HandleFunc(tt.path, func(w http.ResponseWriter, r *http.Request) {
+				b, _ := io.ReadAll(r.Body)
+				body = string(b)
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprint(w, `{"data": {"id": "rt-1", "type": "run-triggers"}}`)
+			})
+
+			result := runCommand(t, client, tt.args...)
+
+			test.BufferEmpty(t, result.ErrBuf)
+			test.Buffer(t, result.OutBuf, tt.wantOut)
+
+			if body != wantBody {
+				t.Errorf("got body %s, want %s", body, wantBody)
+			}
+		})
+	}
+}
+
+func TestAdd_invalid_direction(t *testing.T) {
+	client, _, teardown := tfetest.Setup()
+	defer teardown()
+
+	result := runCommand(t, client, "network", "-W", "myorg/app", "--direction", "both")
+
+	test.Buffer(t, result.ErrBuf, "invalid direction \"both\": must be one of inbound, outbound\n")
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+	}
+
+	cmd := add.NewCmdAdd(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
@@ -0,0 +1,159 @@
+package list
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/cmd/tfc/workspace/runtriggers/add"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+const (
+	ColumnID        string = "ID"
+	ColumnDirection string = "DIRECTION"
+	ColumnWorkspace string = "WORKSPACE"
+	ColumnCreated   string = "CREATED"
+)
+
+var (
+	ColumnsDefault = []string{
+		ColumnDirection,
+		ColumnWorkspace,
+		ColumnCreated,
+	}
+	ColumnsAll = []string{
+		ColumnID,
+		ColumnDirection,
+		ColumnWorkspace,
+		ColumnCreated,
+	}
+)
+
+const DirectionAll string = "all"
+
+var Directions = append([]string{DirectionAll}, add.Directions...)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+	Clock           *cmdutil.Clock
+
+	WorkspaceID cmdutil.WorkspaceIdentifier
+	Direction   string
+	Columns     []string
+}
+
+func NewCmdList(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+		Clock:           f.Clock,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List the run triggers of a workspace",
+		Aliases: []string{"ls"},
+		Long: text.Heredoc(`
+			List the run triggers of a workspace. An inbound run trigger
+			shows the workspace whose applies queue runs in the workspace, an
+			outbound run trigger shows the workspace the workspace queues
+			runs in.
+
+			If -W/--workspace is not specified and state.tf is present,
+			the organization and workspace will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			$ tfc workspaces run-triggers list -W myorg/myworkspace
+
+			# Only list the workspaces queuing runs in the workspace
+			$ tfc workspaces run-triggers list --direction inbound
+		`),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceFlag(cmd, &opts.WorkspaceID, opts.TFEClient)
+	_ = cmdutil.FlagStringEnum(cmd, &opts.Direction, "direction", DirectionAll,
+		"Direction of the run triggers", Directions,
+	)
+	_ = cmdutil.FlagStringEnumSliceP(cmd, &opts.Columns, "columns", "c", ColumnsDefault, "Columns to show.", ColumnsAll)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, _ []string) {
+	cmdutil.CompleteWorkspaceIdentifierSilent(cmd, &opts.WorkspaceID, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := opts.WorkspaceID.Validate(); err != nil {
+		return fmt.Errorf("workspace required: use -W ORG/WORKSPACE or ensure state.tf exists")
+	}
+
+	if err := cmdutil.ValidateEnum("direction", opts.Direction, Directions); err != nil {
+		return err
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	ws, err := client.Workspaces.Read(ctx, opts.WorkspaceID.Org, opts.WorkspaceID.Workspace)
+	if err != nil {
+		return fmt.Errorf("failed to read workspace %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	var inbound, outbound []*tfc.RunTrigger
+
+	if opts.Direction != add.DirectionOutbound {
+		inbound, err = client.RunTriggers.ListInbound(ctx, ws.ID)
+		if err != nil {
+			return fmt.Errorf("failed to list inbound run triggers for %s: %w", opts.WorkspaceID.String(), err)
+		}
+	}
+
+	if opts.Direction != add.DirectionInbound {
+		outbound, err = client.RunTriggers.ListOutbound(ctx, ws.ID)
+		if err != nil {
+			return fmt.Errorf("failed to list outbound run triggers for %s: %w", opts.WorkspaceID.String(), err)
+		}
+	}
+
+	now := opts.Clock.Now()
+
+	p := cmdutil.FieldPrinter(opts.IO, opts.Columns...)
+	for _, rt := range inbound {
+		p.Write(extractFields(now, rt, add.DirectionInbound, rt.SourceableName))
+	}
+	for _, rt := range outbound {
+		p.Write(extractFields(now, rt, add.DirectionOutbound, rt.WorkspaceName))
+	}
+	p.Flush()
+
+	return nil
+}
+
+func extractFields(now time.Time, rt *tfc.RunTrigger, direction, workspace string) map[string]string {
+	return map[string]string{
+		ColumnID:        rt.ID,
+		ColumnDirection: direction,
+		ColumnWorkspace: workspace,
+		ColumnCreated:   text.RelativeTimeAgo(now, rt.CreatedAt),
+	}
+}
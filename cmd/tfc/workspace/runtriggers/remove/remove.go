@@ -0,0 +1,129 @@
+package remove
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/cmd/tfc/workspace/runtriggers/add"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	WorkspaceID cmdutil.WorkspaceIdentifier
+	Other       string // Name of the other workspace
+	Direction   string
+}
+
+func NewCmdRemove(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "remove <WORKSPACE>",
+		Short: "Remove a run trigger from a workspace",
+		Long: text.Heredoc(`
+			Remove the run trigger between a workspace and another workspace
+			of the same organization.
+
+			With --direction inbound, the default, the run trigger that
+			queues runs in the workspace after the given workspace applies is
+			removed. With --direction outbound, the run trigger that queues
+			runs in the given workspace is removed.
+
+			If -W/--workspace is not specified and state.tf is present,
+			the organization and workspace will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Stop queuing runs in app after network applies
+			$ tfc workspaces run-triggers remove network -W myorg/app
+
+			# The same, from the source workspace
+			$ tfc workspaces run-triggers remove app --direction outbound -W myorg/network
+		`),
+		Aliases:           []string{"rm"},
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceFlag(cmd, &opts.WorkspaceID, opts.TFEClient)
+
+	_ = cmdutil.FlagStringEnum(cmd, &opts.Direction, "direction", add.DirectionInbound,
+		"Whether the given workspace triggers the workspace (inbound) or the reverse (outbound)", add.Directions,
+	)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, args []string) {
+	opts.Other = args[0]
+	cmdutil.CompleteWorkspaceIdentifierSilent(cmd, &opts.WorkspaceID, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := opts.WorkspaceID.Validate(); err != nil {
+		return fmt.Errorf("workspace required: use -W ORG/WORKSPACE or ensure state.tf exists")
+	}
+
+	if err := cmdutil.ValidateEnum("direction", opts.Direction, add.Directions); err != nil {
+		return err
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	ws, err := client.Workspaces.Read(ctx, opts.WorkspaceID.Org, opts.WorkspaceID.Workspace)
+	if err != nil {
+		return fmt.Errorf("failed to read workspace %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	other, err := client.Workspaces.Read(ctx, opts.WorkspaceID.Org, opts.Other)
+	if err != nil {
+		return fmt.Errorf("failed to read workspace %s/%s: %w", opts.WorkspaceID.Org, opts.Other, err)
+	}
+
+	source, target := other, ws
+	if opts.Direction == add.DirectionOutbound {
+		source, target = ws, other
+	}
+
+	triggers, err := client.RunTriggers.ListInbound(ctx, target.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list run triggers for %s: %w", target.Name, err)
+	}
+
+	for _, rt := range triggers {
+		if rt.Sourceable == nil || rt.Sourceable.ID != source.ID {
+			continue
+		}
+
+		if err := client.RunTriggers.Delete(ctx, rt.ID); err != nil {
+			return fmt.Errorf("failed to remove run trigger %s: %w", rt.ID, err)
+		}
+
+		fmt.Fprintf(opts.IO.Out, "Removed the run trigger from %s to %s\n", source.Name, target.Name)
+		return nil
+	}
+
+	return fmt.Errorf("no run trigger from %s to %s", source.Name, target.Name)
+}
@@ -0,0 +1,36 @@
+package runtriggers
+
+import (
+	"github.com/spf13/cobra"
+
+	addCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/runtriggers/add"
+	listCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/runtriggers/list"
+	removeCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/runtriggers/remove"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+func NewCmdRunTriggers(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "run-triggers",
+		Aliases: []string{"run-trigger"},
+		Short:   "Manage the run triggers of a workspace",
+		Long: text.Heredoc(`
+			Manage the run triggers between a workspace and other workspaces.
+
+			An inbound run trigger queues a run in the workspace after a
+			successful apply in its source workspace. An outbound run trigger
+			is the inbound run trigger of another workspace whose source is
+			the workspace.
+
+			Use "tfc graph run-triggers" to see the run triggers of a whole
+			organization.
+		`),
+	}
+
+	cmd.AddCommand(listCmd.NewCmdList(f))
+	cmd.AddCommand(addCmd.NewCmdAdd(f))
+	cmd.AddCommand(removeCmd.NewCmdRemove(f))
+
+	return cmd
+}
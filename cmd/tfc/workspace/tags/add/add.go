@@ -0,0 +1,125 @@
+package add
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+	Prompter        func() *cmdutil.Prompter
+
+	Targets cmdutil.WorkspaceTargets
+	Tags    tfc.TagSet
+}
+
+func NewCmdAdd(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+		Prompter:        f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "add <TAG|KEY=VALUE>...",
+		Short: "Add tags to a workspace",
+		Long: text.Heredoc(`
+			Add legacy tags or key/value tag bindings to a workspace.
+
+			Existing tag bindings with the same key are updated with the new
+			value.
+
+			With --bulk, the tags are added to every workspace matched by the
+			filter flags instead. The matching workspaces are listed before
+			asking for confirmation, unless --yes is given.
+
+			If -W/--workspace is not specified and state.tf is present,
+			the organization and workspace will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Add a legacy tag and a tag binding to the workspace in state.tf
+			$ tfc workspaces tags add prod team=platform
+
+			# Add a tag binding to a specific workspace
+			$ tfc workspaces tags add env=prod -W myorg/myworkspace
+
+			# Add a tag binding to every workspace whose name contains "prod"
+			$ tfc workspaces tags add env=prod --bulk --org myorg --name prod
+		`),
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.Complete(cmd, args); err != nil {
+				return err
+			}
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceTargetFlags(cmd, &opts.Targets, opts.TFEClient)
+
+	_ = cmdutil.MarkFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, args []string) error {
+	tags, err := tfc.ParseTags(args)
+	if err != nil {
+		return err
+	}
+
+	opts.Tags = tags
+	opts.Targets.Complete(cmd, opts.TerraformConfig)
+	return nil
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	workspaces, err := opts.Targets.Workspaces(ctx, client)
+	if err != nil {
+		if len(workspaces) == 0 {
+			return err
+		}
+		errs = append(errs, err)
+	}
+
+	ok, err := opts.Targets.Confirm(opts.IO, opts.Prompter, workspaces, fmt.Sprintf("Add tags %s to", opts.Tags))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.Join(errs...)
+	}
+
+	for _, ws := range workspaces {
+		name := tfc.WorkspaceOrgWorkspace(ws).String()
+
+		if err := client.Workspaces.AddTags(ctx, ws.ID, opts.Tags); err != nil {
+			errs = append(errs, fmt.Errorf("failed to add tags to %s: %w", name, err))
+			continue
+		}
+
+		fmt.Fprintf(opts.IO.Out, "Added tags %s to %s\n", opts.Tags, name)
+	}
+
+	return errors.Join(errs...)
+}
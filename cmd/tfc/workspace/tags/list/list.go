@@ -0,0 +1,171 @@
+package list
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/term/color"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+const (
+	ColumnKey           string = "KEY"
+	ColumnValue         string = "VALUE"
+	ColumnType          string = "TYPE"
+	ColumnInheritedFrom string = "INHERITED_FROM"
+)
+
+var (
+	ColumnsDefault = []string{
+		ColumnKey,
+		ColumnValue,
+		ColumnType,
+		ColumnInheritedFrom,
+	}
+	ColumnsAll = ColumnsDefault
+)
+
+const (
+	TypeTag     = "tag"
+	TypeBinding = "binding"
+)
+
+var (
+	InheritedStyle = lipgloss.NewStyle().Foreground(color.LightBlack)
+)
+
+// Tag is the JSON representation of a workspace tag.
+type Tag struct {
+	Key           string `json:"key"`
+	Value         string `json:"value"`
+	Type          string `json:"type"`
+	InheritedFrom string `json:"inherited_from,omitempty"`
+}
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	WorkspaceID cmdutil.WorkspaceIdentifier
+	Columns     []string
+	Format      string
+}
+
+func NewCmdList(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List a workspace's tags",
+		Long: text.Heredoc(`
+			List a workspace's legacy tags and key/value tag bindings.
+
+			Tag bindings inherited from the workspace's project are included,
+			along with the ID of the project they're inherited from.
+
+			If -W/--workspace is not specified and state.tf is present,
+			the organization and workspace will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# List the tags of the workspace in state.tf
+			$ tfc workspaces tags list
+
+			# List the tags of a workspace as JSON
+			$ tfc workspaces tags list -W myorg/myworkspace --format json
+		`),
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceFlag(cmd, &opts.WorkspaceID, opts.TFEClient)
+	cmdutil.AddFormatFlag(cmd, &opts.Format)
+
+	_ = cmdutil.FlagStringEnumSliceP(cmd, &opts.Columns, "columns", "c", ColumnsDefault, "Columns to show.", ColumnsAll)
+
+	_ = cmdutil.MarkAllFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, _ []string) {
+	cmdutil.CompleteWorkspaceIdentifierSilent(cmd, &opts.WorkspaceID, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := opts.WorkspaceID.Validate(); err != nil {
+		return fmt.Errorf("workspace required: use -W ORG/WORKSPACE or ensure state.tf exists")
+	}
+
+	if err := cmdutil.ValidateEnum("format", opts.Format, []string{cmdutil.FormatTable, cmdutil.FormatJSON}); err != nil {
+		return err
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	ws, err := client.Workspaces.Read(ctx, opts.WorkspaceID.Org, opts.WorkspaceID.Workspace)
+	if err != nil {
+		return fmt.Errorf("failed to read workspace %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	bindings, err := client.Workspaces.ListEffectiveTagBindings(ctx, ws.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list tag bindings for %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	tags := make([]Tag, 0, len(ws.TagNames)+len(bindings))
+	for _, name := range ws.TagNames {
+		tags = append(tags, Tag{Key: name, Type: TypeTag})
+	}
+	for _, b := range bindings {
+		tags = append(tags, Tag{
+			Key:           b.Key,
+			Value:         b.Value,
+			Type:          TypeBinding,
+			InheritedFrom: tfc.InheritedFrom(b),
+		})
+	}
+
+	if opts.Format == cmdutil.FormatJSON {
+		return cmdutil.PrintJSON(opts.IO, tags)
+	}
+
+	p := cmdutil.FieldPrinter(opts.IO, opts.Columns...)
+	for _, t := range tags {
+		fields := map[string]string{
+			ColumnKey:           t.Key,
+			ColumnValue:         t.Value,
+			ColumnType:          t.Type,
+			ColumnInheritedFrom: t.InheritedFrom,
+		}
+
+		if t.InheritedFrom != "" {
+			for k, v := range fields {
+				fields[k] = InheritedStyle.Render(v)
+			}
+		}
+
+		p.Write(fields)
+	}
+	p.Flush()
+
+	return nil
+}
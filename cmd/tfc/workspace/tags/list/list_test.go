@@ -0,0 +1,175 @@
+package list_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/zkhvan/tfc/cmd/tfc/workspace/tags/list"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+func TestList(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": {
+						"id": "ws-123",
+						"type": "workspaces",
+						"attributes": {
+							"name": "my-workspace",
+							"tag-names": ["prod"]
+						}
+					}
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/effective-tag-bindings",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "etb-1",
+							"type": "effective-tag-bindings",
+							"attributes": {"key": "env", "value": "prod"}
+						},
+						{
+							"id": "etb-2",
+							"type": "effective-tag-bindings",
+							"attributes": {"key": "team", "value": "platform"},
+							"links": {"inherited-from": "/api/v2/projects/prj-123"}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	result := runCommand(t, client, "-W", "myorg/my-workspace")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		KEY   VALUE     TYPE     INHERITED_FROM
+		prod            tag      
+		env   prod      binding  
+		team  platform  binding  prj-123
+	`))
+}
+
+func TestList_json(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": {
+						"id": "ws-123",
+						"type": "workspaces",
+						"attributes": {
+							"name": "my-workspace",
+							"tag-names": ["prod"]
+						}
+					}
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/effective-tag-bindings",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "etb-1",
+							"type": "effective-tag-bindings",
+							"attributes": {"key": "env", "value": "prod"}
+						},
+						{
+							"id": "etb-2",
+							"type": "effective-tag-bindings",
+							"attributes": {"key": "team", "value": "platform"},
+							"links": {"inherited-from": "/api/v2/projects/prj-123"}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	result := runCommand(t, client, "-W", "myorg/my-workspace", "--format", "json")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		[
+		  {
+		    "key": "prod",
+		    "value": "",
+		    "type": "tag"
+		  },
+		  {
+		    "key": "env",
+		    "value": "prod",
+		    "type": "binding"
+		  },
+		  {
+		    "key": "team",
+		    "value": "platform",
+		    "type": "binding",
+		    "inherited_from": "prj-123"
+		  }
+		]
+	`))
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+	}
+
+	cmd := list.NewCmdList(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
@@ -0,0 +1,124 @@
+package remove
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+	Prompter        func() *cmdutil.Prompter
+
+	Targets cmdutil.WorkspaceTargets
+	Tags    tfc.TagSet
+}
+
+func NewCmdRemove(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+		Prompter:        f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "remove <TAG|KEY=>...",
+		Short: "Remove tags from a workspace",
+		Long: text.Heredoc(`
+			Remove legacy tags or key/value tag bindings from a workspace.
+
+			Tag bindings are removed by key, so both KEY= and KEY=VALUE remove
+			the binding regardless of its value. Tag bindings inherited from
+			the workspace's project can only be removed from the project.
+
+			With --bulk, the tags are removed from every workspace matched by
+			the filter flags instead. The matching workspaces are listed before
+			asking for confirmation, unless --yes is given.
+
+			If -W/--workspace is not specified and state.tf is present,
+			the organization and workspace will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Remove a legacy tag and a tag binding from the workspace in state.tf
+			$ tfc workspaces tags remove prod team=
+
+			# Remove a tag binding from every workspace tagged "deprecated"
+			$ tfc workspaces tags remove env= --bulk --org myorg --tags deprecated
+		`),
+		Aliases:           []string{"rm"},
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.Complete(cmd, args); err != nil {
+				return err
+			}
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceTargetFlags(cmd, &opts.Targets, opts.TFEClient)
+
+	_ = cmdutil.MarkFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, args []string) error {
+	tags, err := tfc.ParseTags(args)
+	if err != nil {
+		return err
+	}
+
+	opts.Tags = tags
+	opts.Targets.Complete(cmd, opts.TerraformConfig)
+	return nil
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	workspaces, err := opts.Targets.Workspaces(ctx, client)
+	if err != nil {
+		if len(workspaces) == 0 {
+			return err
+		}
+		errs = append(errs, err)
+	}
+
+	ok, err := opts.Targets.Confirm(opts.IO, opts.Prompter, workspaces, fmt.Sprintf("Remove tags %s from", opts.Tags))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.Join(errs...)
+	}
+
+	for _, ws := range workspaces {
+		name := tfc.WorkspaceOrgWorkspace(ws).String()
+
+		if err := client.Workspaces.RemoveTags(ctx, ws.ID, opts.Tags); err != nil {
+			errs = append(errs, fmt.Errorf("failed to remove tags from %s: %w", name, err))
+			continue
+		}
+
+		fmt.Fprintf(opts.IO.Out, "Removed tags %s from %s\n", opts.Tags, name)
+	}
+
+	return errors.Join(errs...)
+}
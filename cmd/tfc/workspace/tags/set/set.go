@@ -0,0 +1,132 @@
+package set
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+	Prompter        func() *cmdutil.Prompter
+
+	Targets cmdutil.WorkspaceTargets
+	Tags    tfc.TagSet
+}
+
+func NewCmdSet(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+		Prompter:        f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "set [<TAG|KEY=VALUE>...]",
+		Short: "Replace a workspace's tags",
+		Long: text.Heredoc(`
+			Replace a workspace's legacy tags and key/value tag bindings with
+			the given tags. Running without any tags removes them all.
+
+			Tag bindings inherited from the workspace's project are not
+			affected.
+
+			With --bulk, the tags are replaced on every workspace matched by the
+			filter flags instead. The matching workspaces are listed before
+			asking for confirmation, unless --yes is given.
+
+			If -W/--workspace is not specified and state.tf is present,
+			the organization and workspace will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Replace the tags of the workspace in state.tf
+			$ tfc workspaces tags set prod env=prod team=platform
+
+			# Remove every tag from a workspace
+			$ tfc workspaces tags set -W myorg/myworkspace
+		`),
+		Args:              cobra.ArbitraryArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.Complete(cmd, args); err != nil {
+				return err
+			}
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceTargetFlags(cmd, &opts.Targets, opts.TFEClient)
+
+	_ = cmdutil.MarkFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, args []string) error {
+	tags, err := tfc.ParseTags(args)
+	if err != nil {
+		return err
+	}
+
+	opts.Tags = tags
+	opts.Targets.Complete(cmd, opts.TerraformConfig)
+	return nil
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	workspaces, err := opts.Targets.Workspaces(ctx, client)
+	if err != nil {
+		if len(workspaces) == 0 {
+			return err
+		}
+		errs = append(errs, err)
+	}
+
+	prompt := fmt.Sprintf("Set tags %s on", opts.Tags)
+	if opts.Tags.IsEmpty() {
+		prompt = "Remove all tags from"
+	}
+
+	ok, err := opts.Targets.Confirm(opts.IO, opts.Prompter, workspaces, prompt)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.Join(errs...)
+	}
+
+	for _, ws := range workspaces {
+		name := tfc.WorkspaceOrgWorkspace(ws).String()
+
+		if err := client.Workspaces.SetTags(ctx, ws, opts.Tags); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set tags on %s: %w", name, err))
+			continue
+		}
+
+		if opts.Tags.IsEmpty() {
+			fmt.Fprintf(opts.IO.Out, "Removed all tags from %s\n", name)
+		} else {
+			fmt.Fprintf(opts.IO.Out, "Set tags %s on %s\n", opts.Tags, name)
+		}
+	}
+
+	return errors.Join(errs...)
+}
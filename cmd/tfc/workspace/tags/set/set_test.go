@@ -0,0 +1,216 @@
+package set_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/zkhvan/tfc/cmd/tfc/workspace/tags/set"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+func TestSet_bulk(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"myorg","type":"organizations","attributes":{"name":"myorg"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces",
+		func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("search[name]"); got != "app" {
+				t.Errorf("search[name] got %q, want %q", got, "app")
+			}
+
+			fmt.Fprintf(w, `{"data":[%s,%s]}`,
+				testWorkspace("ws-a", "app-a", `["old","prod"]`),
+				testWorkspace("ws-b", "app-b", `["prod"]`),
+			)
+		},
+	)
+
+	var removed []string
+	mux.HandleFunc(
+		"DELETE /api/v2/workspaces/{workspace_id}/relationships/tags",
+		func(w http.ResponseWriter, r *http.Request) {
+			test.PathValue(t, r, "workspace_id", "ws-a")
+
+			body, _ := io.ReadAll(r.Body)
+			removed = append(removed, string(body))
+			w.WriteHeader(http.StatusNoContent)
+		},
+	)
+
+	bindings := map[string][]string{}
+	mux.HandleFunc(
+		"PATCH /api/v2/workspaces/{workspace_id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			var payload struct {
+				Data struct {
+					Relationships struct {
+						TagBindings struct {
+							Data []struct {
+								Attributes struct {
+									Key   string `json:"key"`
+									Value string `json:"value"`
+								} `json:"attributes"`
+							} `json:"data"`
+						} `json:"tag-bindings"`
+					} `json:"relationships"`
+				} `json:"data"`
+			}
+
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &payload); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			id := r.PathValue("workspace_id")
+			for _, b := range payload.Data.Relationships.TagBindings.Data {
+				bindings[id] = append(bindings[id], b.Attributes.Key+"="+b.Attributes.Value)
+			}
+
+			fmt.Fprintf(w, `{"data":{"id":%q,"type":"workspaces","attributes":{}}}`, id)
+		},
+	)
+
+	result := runCommand(t, client, "prod", "env=prod", "--bulk", "--org", "myorg", "--name", "app", "--yes")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		Matching workspaces:
+		  myorg/app-a
+		  myorg/app-b
+
+		Set tags prod, env=prod on myorg/app-a
+		Set tags prod, env=prod on myorg/app-b
+	`))
+
+	if len(removed) != 1 {
+		t.Fatalf("expected tags to be removed from 1 workspace, got %d", len(removed))
+	}
+	test.Buffer(t, bytes.NewBufferString(removed[0]), `{"data":[{"type":"tags","attributes":{"name":"old"}}]}`+"\n")
+
+	for _, id := range []string{"ws-a", "ws-b"} {
+		test.StringSlice(t, bindings[id], []string{"env=prod"})
+	}
+}
+
+func TestSet_bulk_confirmation_required(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"myorg","type":"organizations","attributes":{"name":"myorg"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprintf(w, `{"data":[%s,%s,%s],"meta":{"pagination":{"current-page":1,"total-pages":1,"total-count":3}}}`,
+				testWorkspace("ws-a", "app-a", `["prod"]`),
+				testWorkspace("ws-b", "app-b", `["prod"]`),
+				testWorkspace("ws-c", "app-c", `["prod"]`),
+			)
+		},
+	)
+
+	var changed bool
+	mux.HandleFunc(
+		"PATCH /api/v2/workspaces/{workspace_id}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			changed = true
+			w.WriteHeader(http.StatusInternalServerError)
+		},
+	)
+
+	result := runCommand(t, client, "env=prod", "--bulk", "--org", "myorg", "--limit", "2")
+
+	test.Buffer(t, result.ErrBuf, text.Heredoc(`
+		Warning: only the first 2 matching workspaces of myorg are included: use --limit to include more
+		confirmation required: use --yes to change the workspaces matched by --bulk without prompting
+	`))
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		Matching workspaces:
+		  myorg/app-a
+		  myorg/app-b
+	`))
+
+	if changed {
+		t.Error("expected no workspace to be changed without confirmation")
+	}
+}
+
+func TestSet_bulk_requires_org(t *testing.T) {
+	client, _, teardown := tfetest.Setup()
+	defer teardown()
+
+	result := runCommand(t, client, "prod", "--bulk")
+
+	test.Buffer(t, result.ErrBuf, "organization required: use --org with --bulk\n")
+	test.BufferEmpty(t, result.OutBuf)
+}
+
+func testWorkspace(id, name, tags string) string {
+	return text.Heredocf(`
+		{
+			"id": "%s",
+			"type": "workspaces",
+			"attributes": {"name": "%s", "tag-names": %s},
+			"relationships": {
+				"organization": {"data": {"id": "myorg", "type": "organizations"}}
+			}
+		}
+	`, id, name, tags)
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+		Prompter:        func() *cmdutil.Prompter { return cmdutil.NewPrompter(ios) },
+	}
+
+	cmd := set.NewCmdSet(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
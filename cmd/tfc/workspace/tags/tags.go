@@ -0,0 +1,32 @@
+package tags
+
+import (
+	"github.com/spf13/cobra"
+
+	addCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/tags/add"
+	listCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/tags/list"
+	removeCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/tags/remove"
+	setCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/tags/set"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+func NewCmdTags(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tags",
+		Short: "Manage a workspace's tags",
+		Long: text.Heredoc(`
+			Manage a workspace's tags.
+
+			Tags are given either as a plain name for legacy tags, or as
+			KEY=VALUE for key/value tag bindings.
+		`),
+	}
+
+	cmd.AddCommand(listCmd.NewCmdList(f))
+	cmd.AddCommand(addCmd.NewCmdAdd(f))
+	cmd.AddCommand(removeCmd.NewCmdRemove(f))
+	cmd.AddCommand(setCmd.NewCmdSet(f))
+
+	return cmd
+}
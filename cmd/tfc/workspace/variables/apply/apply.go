@@ -0,0 +1,185 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+	"github.com/zkhvan/tfc/pkg/varplan"
+	"github.com/zkhvan/tfc/pkg/varspec"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+	Prompter        func() *cmdutil.Prompter
+
+	WorkspaceID    cmdutil.WorkspaceIdentifier
+	File           string
+	Check          bool
+	ForceSensitive bool
+	Prune          bool
+	Yes            bool
+}
+
+func NewCmdApply(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+		Prompter:        f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "apply -f <FILE>",
+		Short: "Sync a workspace's variables with a YAML file",
+		Long: text.Heredoc(`
+			Sync a workspace's variables with a declarative YAML file.
+
+			The file lists the variables the workspace should have. Each
+			variable has a key, a value or a value_from source, and optionally
+			a category (terraform or env), hcl, sensitive and description:
+
+			    variables:
+			      - key: region
+			        value: us-east-1
+			      - key: zones
+			        value: ["a", "b"]
+			      - key: AWS_SECRET_ACCESS_KEY
+			        category: env
+			        sensitive: true
+			        value_from:
+			          env: AWS_SECRET_ACCESS_KEY
+			      - key: ca_cert
+			        value_from:
+			          file: certs/ca.pem
+			      - key: DB_PASSWORD
+			        category: env
+			        sensitive: true
+			        value_from:
+			          command: vault kv get -field=password secret/db
+
+			The changes are shown as a plan and applied after confirmation.
+			The values of sensitive variables can't be read back, so existing
+			sensitive variables are shown as unknown and left alone unless
+			--force-sensitive is set. A sensitive variable without a value
+			or value_from keeps its existing value and isn't created.
+
+			With --check, the plan is shown without applying it and the
+			command fails if there are changes, to detect drift in CI.
+
+			If -W/--workspace is not specified and state.tf is present,
+			the organization and workspace will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Show and apply the changes
+			$ tfc workspaces variables apply -f vars.yaml
+
+			# Fail if the workspace's variables drifted from the file
+			$ tfc workspaces variables apply -f vars.yaml --check
+
+			# Apply every change, including sensitive values, without prompting
+			$ tfc workspaces variables apply -f vars.yaml --force-sensitive --yes
+		`),
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceFlag(cmd, &opts.WorkspaceID, opts.TFEClient)
+
+	cmd.Flags().StringVarP(&opts.File, "file", "f", "", "YAML file of variables")
+	cmd.Flags().BoolVar(&opts.Check, "check", false, "Show the plan and fail if there are changes, without applying them")
+	cmd.Flags().BoolVar(&opts.ForceSensitive, "force-sensitive", false, "Update existing sensitive variables")
+	cmd.Flags().BoolVar(&opts.Prune, "prune", false, "Delete variables that aren't in the file")
+	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Apply the changes without asking for confirmation")
+
+	_ = cmd.MarkFlagRequired("file")
+	_ = cmd.MarkFlagFilename("file", "yaml", "yml")
+	cmd.MarkFlagsMutuallyExclusive("check", "yes")
+	_ = cmdutil.MarkFlagsWithNoFileCompletions(cmd, "check", "force-sensitive", "prune", "yes")
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, _ []string) {
+	cmdutil.CompleteWorkspaceIdentifierSilent(cmd, &opts.WorkspaceID, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := opts.WorkspaceID.Validate(); err != nil {
+		return fmt.Errorf("workspace required: use -W ORG/WORKSPACE or ensure state.tf exists")
+	}
+
+	desired, err := varspec.Load(ctx, opts.File)
+	if err != nil {
+		return err
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	ws, err := client.Workspaces.Read(ctx, opts.WorkspaceID.Org, opts.WorkspaceID.Workspace)
+	if err != nil {
+		return fmt.Errorf("failed to read workspace %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	current, err := client.Variables.ListAll(ctx, ws.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list variables for %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	plan := varplan.New(current, desired, varplan.Options{
+		Prune:          opts.Prune,
+		ForceSensitive: opts.ForceSensitive,
+	})
+
+	plan.Render(opts.IO.Out, false)
+
+	if !plan.HasChanges() {
+		fmt.Fprintln(opts.IO.Out, "\nNo changes. Variables match the file.")
+		return nil
+	}
+
+	if opts.Check {
+		return fmt.Errorf("variables of %s have drifted from %s", opts.WorkspaceID.String(), opts.File)
+	}
+
+	if !opts.Yes {
+		prompter := opts.Prompter()
+		if !prompter.CanPrompt() {
+			return fmt.Errorf("confirmation required: use --yes to apply the changes without prompting")
+		}
+
+		ok, err := prompter.Confirm(fmt.Sprintf("\nApply these changes to %s?", opts.WorkspaceID.String()))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Fprintln(opts.IO.Out, "Apply cancelled")
+			return nil
+		}
+	}
+
+	if err := plan.Apply(ctx, client, ws.ID); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(opts.IO.Out, "\nApplied: %d created, %d updated, %d deleted.\n",
+		plan.Count(varplan.ActionCreate), plan.Count(varplan.ActionUpdate), plan.Count(varplan.ActionDelete),
+	)
+
+	return nil
+}
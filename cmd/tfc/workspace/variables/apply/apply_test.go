@@ -0,0 +1,419 @@
+package apply_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/zkhvan/tfc/cmd/tfc/workspace/variables/apply"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+const spec = `
+variables:
+  - key: region
+    value: us-east-1
+  - key: replicas
+    value: 3
+  - key: DB_PASSWORD
+    category: env
+    sensitive: true
+    value: hunter2
+  - key: LOG_LEVEL
+    category: env
+    value: info
+`
+
+func writeSpec(t *testing.T) string {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), "vars.yaml")
+	if err := os.WriteFile(file, []byte(spec), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func TestApply(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	var requests []string
+	record := func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		fmt.Fprint(w, `{"data":{"id":"var-new","type":"vars","attributes":{"key":"k"}}}`)
+	}
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ws-123","type":"workspaces","attributes":{"name":"my-workspace"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-1",
+							"type": "vars",
+							"attributes": {"key": "region", "value": "us-east-1", "category": "terraform"}
+						},
+						{
+							"id": "var-2",
+							"type": "vars",
+							"attributes": {"key": "replicas", "value": "2", "category": "terraform"}
+						},
+						{
+							"id": "var-3",
+							"type": "vars",
+							"attributes": {"key": "DB_PASSWORD", "category": "env", "sensitive": true}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc("POST /api/v2/workspaces/{workspace_id}/vars", record)
+	mux.HandleFunc("PATCH /api/v2/workspaces/{workspace_id}/vars/{variable_id}", record)
+
+	result := runCommand(t, client, "-W", "myorg/my-workspace", "-f", writeSpec(t), "--yes")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		  ? DB_PASSWORD (env) = (sensitive value, unknown)
+		  + LOG_LEVEL (env) = "info"
+		  ~ replicas (terraform) = "2" -> "3"
+
+		Plan: 1 to create, 1 to update, 0 to delete, 1 unchanged. 1 sensitive unknown.
+
+		Applied: 1 created, 1 updated, 0 deleted.
+	`))
+
+	sort.Strings(requests)
+	test.StringSlice(t, requests, []string{
+		"PATCH /api/v2/workspaces/ws-123/vars/var-2",
+		"POST /api/v2/workspaces/ws-123/vars",
+	})
+}
+
+func TestApply_force_sensitive(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	var requests []string
+	record := func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		fmt.Fprint(w, `{"data":{"id":"var-new","type":"vars","attributes":{"key":"k"}}}`)
+	}
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ws-123","type":"workspaces","attributes":{"name":"my-workspace"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-1",
+							"type": "vars",
+							"attributes": {"key": "region", "value": "us-east-1", "category": "terraform"}
+						},
+						{
+							"id": "var-2",
+							"type": "vars",
+							"attributes": {"key": "replicas", "value": "2", "category": "terraform"}
+						},
+						{
+							"id": "var-3",
+							"type": "vars",
+							"attributes": {"key": "DB_PASSWORD", "category": "env", "sensitive": true}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc("POST /api/v2/workspaces/{workspace_id}/vars", record)
+	mux.HandleFunc("PATCH /api/v2/workspaces/{workspace_id}/vars/{variable_id}", record)
+
+	result := runCommand(t, client, "-W", "myorg/my-workspace", "-f", writeSpec(t), "--yes", "--force-sensitive")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		  ~ DB_PASSWORD (env) = (sensitive value) -> (sensitive value)
+		  + LOG_LEVEL (env) = "info"
+		  ~ replicas (terraform) = "2" -> "3"
+
+		Plan: 1 to create, 2 to update, 0 to delete, 1 unchanged.
+
+		Applied: 1 created, 2 updated, 0 deleted.
+	`))
+
+	if len(requests) != 3 {
+		t.Errorf("expected 3 changes, got %v", requests)
+	}
+}
+
+func TestApply_sensitive_to_non_sensitive_replaces(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	var requests []string
+	record := func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		fmt.Fprint(w, `{"data":{"id":"var-new","type":"vars","attributes":{"key":"k"}}}`)
+	}
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ws-123","type":"workspaces","attributes":{"name":"my-workspace"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-1",
+							"type": "vars",
+							"attributes": {"key": "LOG_LEVEL", "category": "env", "sensitive": true}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc("POST /api/v2/workspaces/{workspace_id}/vars", record)
+	mux.HandleFunc("PATCH /api/v2/workspaces/{workspace_id}/vars/{variable_id}", record)
+	mux.HandleFunc("DELETE /api/v2/workspaces/{workspace_id}/vars/{variable_id}", record)
+
+	file := filepath.Join(t.TempDir(), "vars.yaml")
+	content := text.Heredoc(`
+		variables:
+		  - key: LOG_LEVEL
+		    category: env
+		    value: info
+	`)
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	result := runCommand(t, client, "-W", "myorg/my-workspace", "-f", file, "--yes")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		  - LOG_LEVEL (env)
+		  + LOG_LEVEL (env) = "info"
+
+		Plan: 1 to create, 0 to update, 1 to delete, 0 unchanged.
+
+		Applied: 1 created, 0 updated, 1 deleted.
+	`))
+
+	test.StringSlice(t, requests, []string{
+		"DELETE /api/v2/workspaces/ws-123/vars/var-1",
+		"POST /api/v2/workspaces/ws-123/vars",
+	})
+}
+
+func TestApply_sensitive_description_keeps_value(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ws-123","type":"workspaces","attributes":{"name":"my-workspace"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-1",
+							"type": "vars",
+							"attributes": {"key": "DB_PASSWORD", "category": "env", "sensitive": true, "description": "old"}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	var attributes map[string]any
+	mux.HandleFunc(
+		"PATCH /api/v2/workspaces/{workspace_id}/vars/{variable_id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Data struct {
+					Attributes map[string]any `json:"attributes"`
+				} `json:"data"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			attributes = body.Data.Attributes
+			fmt.Fprint(w, `{"data":{"id":"var-1","type":"vars","attributes":{"key":"DB_PASSWORD"}}}`)
+		},
+	)
+
+	file := filepath.Join(t.TempDir(), "vars.yaml")
+	content := text.Heredoc(`
+		variables:
+		  - key: DB_PASSWORD
+		    category: env
+		    sensitive: true
+		    description: new
+		  - key: API_TOKEN
+		    category: env
+		    sensitive: true
+	`)
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	result := runCommand(t, client, "-W", "myorg/my-workspace", "-f", file, "--yes")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		  ~ DB_PASSWORD (env), description: "old" -> "new"
+
+		Plan: 0 to create, 1 to update, 0 to delete, 0 unchanged.
+
+		Applied: 0 created, 1 updated, 0 deleted.
+	`))
+
+	if _, ok := attributes["value"]; ok {
+		t.Errorf("got value in update %v, want the value left alone", attributes)
+	}
+	if got := attributes["description"]; got != "new" {
+		t.Errorf("got description %v, want %q", got, "new")
+	}
+}
+
+func TestApply_check(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	var requests []string
+	record := func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		fmt.Fprint(w, `{"data":{"id":"var-new","type":"vars","attributes":{"key":"k"}}}`)
+	}
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ws-123","type":"workspaces","attributes":{"name":"my-workspace"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-1",
+							"type": "vars",
+							"attributes": {"key": "region", "value": "us-east-1", "category": "terraform"}
+						},
+						{
+							"id": "var-2",
+							"type": "vars",
+							"attributes": {"key": "replicas", "value": "2", "category": "terraform"}
+						},
+						{
+							"id": "var-3",
+							"type": "vars",
+							"attributes": {"key": "DB_PASSWORD", "category": "env", "sensitive": true}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc("POST /api/v2/workspaces/{workspace_id}/vars", record)
+	mux.HandleFunc("PATCH /api/v2/workspaces/{workspace_id}/vars/{variable_id}", record)
+
+	file := writeSpec(t)
+	result := runCommand(t, client, "-W", "myorg/my-workspace", "-f", file, "--check")
+
+	test.Buffer(t, result.ErrBuf, fmt.Sprintf("variables of myorg/my-workspace have drifted from %s\n", file))
+
+	if len(requests) != 0 {
+		t.Errorf("expected no changes, got %v", requests)
+	}
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+		Prompter:        func() *cmdutil.Prompter { return cmdutil.NewPrompter(ios) },
+	}
+
+	cmd := apply.NewCmdApply(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
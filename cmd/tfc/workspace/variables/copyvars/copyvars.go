@@ -0,0 +1,225 @@
+package copyvars
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+	"github.com/zkhvan/tfc/pkg/varplan"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+	Prompter        func() *cmdutil.Prompter
+
+	From      cmdutil.WorkspaceIdentifier
+	To        cmdutil.WorkspaceIdentifier
+	Keys      []string
+	Overwrite bool
+	Yes       bool
+}
+
+func NewCmdCopy(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+		Prompter:        f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "copy --from <ORG/WORKSPACE> --to <ORG/WORKSPACE>",
+		Short:   "Copy variables from one workspace to another",
+		Aliases: []string{"cp"},
+		Long: text.Heredoc(`
+			Copy variables from one workspace to another.
+
+			Variables missing from the --to workspace are created. Variables
+			that exist in both workspaces but differ are only updated with
+			--overwrite. Use --keys to only copy some of the variables.
+
+			The values of sensitive variables can't be read back, so existing
+			sensitive variables are left alone and missing ones are created
+			with an empty placeholder value that needs to be set.
+
+			If --to doesn't include an organization, the organization of the
+			--from workspace is used. If --from is not specified and state.tf
+			is present, the organization and workspace will be read from
+			state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Copy the variables missing from production
+			$ tfc workspaces variables copy --from myorg/app-staging --to app-prod
+
+			# Promote a variable, overwriting its value
+			$ tfc workspaces variables copy --from myorg/app-staging --to app-prod --keys image_tag --overwrite
+		`),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.From.Raw, "from", "", "Workspace to copy from in ORG/WORKSPACE format")
+	cmd.Flags().StringVar(&opts.To.Raw, "to", "", "Workspace to copy to in ORG/WORKSPACE format")
+	cmd.Flags().StringSliceVar(&opts.Keys, "keys", []string{}, "Keys of the variables to copy (defaults to all)")
+	cmd.Flags().BoolVar(&opts.Overwrite, "overwrite", false, "Update variables that exist in both workspaces")
+	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Apply the changes without asking for confirmation")
+
+	_ = cmd.MarkFlagRequired("to")
+	_ = cmd.RegisterFlagCompletionFunc("from", cmdutil.CompletionOrgWorkspace(opts.TFEClient))
+	_ = cmd.RegisterFlagCompletionFunc("to", cmdutil.CompletionOrgWorkspace(opts.TFEClient))
+	_ = cmdutil.MarkFlagsWithNoFileCompletions(cmd, "keys", "overwrite", "yes")
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, _ []string) {
+	cmdutil.CompleteWorkspaceIdentifierSilent(cmd, &opts.From, opts.TerraformConfig)
+
+	opts.To.OrgWorkspace = tfc.ParseOrgWorkspace(opts.To.Raw)
+	if !opts.To.HasOrg() {
+		opts.To.Org = opts.From.Org
+	}
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := opts.From.Validate(); err != nil {
+		return fmt.Errorf("workspace required: use --from ORG/WORKSPACE or ensure state.tf exists")
+	}
+
+	if err := opts.To.Validate(); err != nil {
+		return fmt.Errorf("invalid --to workspace: %w", err)
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	src, err := client.Workspaces.Read(ctx, opts.From.Org, opts.From.Workspace)
+	if err != nil {
+		return fmt.Errorf("failed to read workspace %s: %w", opts.From.String(), err)
+	}
+
+	dst, err := client.Workspaces.Read(ctx, opts.To.Org, opts.To.Workspace)
+	if err != nil {
+		return fmt.Errorf("failed to read workspace %s: %w", opts.To.String(), err)
+	}
+
+	vars, err := client.Variables.ListAll(ctx, src.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list variables for %s: %w", opts.From.String(), err)
+	}
+
+	current, err := client.Variables.ListAll(ctx, dst.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list variables for %s: %w", opts.To.String(), err)
+	}
+
+	vars, err = opts.selectKeys(vars)
+	if err != nil {
+		return err
+	}
+
+	plan := varplan.New(current, varplan.FromVariables(vars, current), varplan.Options{})
+
+	skipped := 0
+	if !opts.Overwrite {
+		plan.Changes = slices.DeleteFunc(plan.Changes, func(c varplan.Change) bool {
+			if c.Action == varplan.ActionUpdate {
+				skipped++
+				return true
+			}
+			return false
+		})
+	}
+
+	plan.Render(opts.IO.Out, false)
+
+	if skipped > 0 {
+		fmt.Fprintf(opts.IO.ErrOut, "Skipped %d variables that differ in %s: use --overwrite to update them\n",
+			skipped, opts.To.String(),
+		)
+	}
+
+	if !plan.HasChanges() {
+		fmt.Fprintln(opts.IO.Out, "\nNo changes.")
+		return nil
+	}
+
+	if !opts.Yes {
+		prompter := opts.Prompter()
+		if !prompter.CanPrompt() {
+			return fmt.Errorf("confirmation required: use --yes to apply the changes without prompting")
+		}
+
+		ok, err := prompter.Confirm(fmt.Sprintf("\nCopy these variables to %s?", opts.To.String()))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Fprintln(opts.IO.Out, "Copy cancelled")
+			return nil
+		}
+	}
+
+	if err := plan.Apply(ctx, client, dst.ID); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(opts.IO.Out, "\nCopied %d variables from %s to %s: %d created, %d updated.\n",
+		plan.Count(varplan.ActionCreate)+plan.Count(varplan.ActionUpdate),
+		opts.From.String(), opts.To.String(),
+		plan.Count(varplan.ActionCreate), plan.Count(varplan.ActionUpdate),
+	)
+
+	var placeholders []string
+	for _, c := range plan.Changes {
+		if c.Action == varplan.ActionCreate && c.Desired.Sensitive {
+			placeholders = append(placeholders, fmt.Sprintf("  %s (%s)", c.Key, c.Category()))
+		}
+	}
+	if len(placeholders) > 0 {
+		fmt.Fprintln(opts.IO.Out, "\nSensitive variables created with placeholder values that need to be set:")
+		for _, p := range placeholders {
+			fmt.Fprintln(opts.IO.Out, p)
+		}
+	}
+
+	return nil
+}
+
+// selectKeys returns the variables with the --keys keys, or all variables if
+// no keys are given.
+func (opts *Options) selectKeys(vars []*tfc.Variable) ([]*tfc.Variable, error) {
+	if len(opts.Keys) == 0 {
+		return vars, nil
+	}
+
+	var selected []*tfc.Variable
+	for _, key := range opts.Keys {
+		found := false
+		for _, v := range vars {
+			if v.Key == key {
+				selected = append(selected, v)
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("variable %q not found in %s", key, opts.From.String())
+		}
+	}
+
+	return selected, nil
+}
@@ -0,0 +1,285 @@
+package copyvars_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"sort"
+	"testing"
+
+	"github.com/zkhvan/tfc/cmd/tfc/workspace/variables/copyvars"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+func TestCopy(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"data":{"id":"ws-%s","type":"workspaces","attributes":{"name":%q}}}`,
+				r.PathValue("workspace"), r.PathValue("workspace"),
+			)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/ws-app-staging/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-1",
+							"type": "vars",
+							"attributes": {"key": "region", "value": "us-east-1", "category": "terraform"}
+						},
+						{
+							"id": "var-2",
+							"type": "vars",
+							"attributes": {"key": "replicas", "value": "2", "category": "terraform"}
+						},
+						{
+							"id": "var-3",
+							"type": "vars",
+							"attributes": {"key": "API_TOKEN", "category": "env", "sensitive": true}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/ws-app-prod/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-4",
+							"type": "vars",
+							"attributes": {"key": "replicas", "value": "5", "category": "terraform"}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	var requests []string
+	record := func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		fmt.Fprint(w, `{"data":{"id":"var-new","type":"vars","attributes":{"key":"k"}}}`)
+	}
+	mux.HandleFunc("POST /api/v2/workspaces/{workspace_id}/vars", record)
+	mux.HandleFunc("PATCH /api/v2/workspaces/{workspace_id}/vars/{var_id}", record)
+
+	result := runCommand(t, client, "--from", "myorg/app-staging", "--to", "app-prod", "--yes")
+
+	test.Buffer(t, result.ErrBuf, "Skipped 1 variables that differ in myorg/app-prod: use --overwrite to update them\n")
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		  + API_TOKEN (env) = (sensitive value)
+		  + region (terraform) = "us-east-1"
+
+		Plan: 2 to create, 0 to update, 0 to delete, 0 unchanged.
+
+		Copied 2 variables from myorg/app-staging to myorg/app-prod: 2 created, 0 updated.
+
+		Sensitive variables created with placeholder values that need to be set:
+		  API_TOKEN (env)
+	`))
+
+	test.StringSlice(t, requests, []string{
+		"POST /api/v2/workspaces/ws-app-prod/vars",
+		"POST /api/v2/workspaces/ws-app-prod/vars",
+	})
+}
+
+func TestCopy_keys_overwrite(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"data":{"id":"ws-%s","type":"workspaces","attributes":{"name":%q}}}`,
+				r.PathValue("workspace"), r.PathValue("workspace"),
+			)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/ws-app-staging/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-1",
+							"type": "vars",
+							"attributes": {"key": "region", "value": "us-east-1", "category": "terraform"}
+						},
+						{
+							"id": "var-2",
+							"type": "vars",
+							"attributes": {"key": "replicas", "value": "2", "category": "terraform"}
+						},
+						{
+							"id": "var-3",
+							"type": "vars",
+							"attributes": {"key": "API_TOKEN", "category": "env", "sensitive": true}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/ws-app-prod/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-4",
+							"type": "vars",
+							"attributes": {"key": "replicas", "value": "5", "category": "terraform"}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	var requests []string
+	record := func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		fmt.Fprint(w, `{"data":{"id":"var-new","type":"vars","attributes":{"key":"k"}}}`)
+	}
+	mux.HandleFunc("POST /api/v2/workspaces/{workspace_id}/vars", record)
+	mux.HandleFunc("PATCH /api/v2/workspaces/{workspace_id}/vars/{var_id}", record)
+
+	result := runCommand(t, client,
+		"--from", "myorg/app-staging", "--to", "app-prod", "--keys", "replicas,region", "--overwrite", "--yes",
+	)
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		  + region (terraform) = "us-east-1"
+		  ~ replicas (terraform) = "5" -> "2"
+
+		Plan: 1 to create, 1 to update, 0 to delete, 0 unchanged.
+
+		Copied 2 variables from myorg/app-staging to myorg/app-prod: 1 created, 1 updated.
+	`))
+
+	sort.Strings(requests)
+	test.StringSlice(t, requests, []string{
+		"PATCH /api/v2/workspaces/ws-app-prod/vars/var-4",
+		"POST /api/v2/workspaces/ws-app-prod/vars",
+	})
+}
+
+func TestCopy_unknown_key(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"data":{"id":"ws-%s","type":"workspaces","attributes":{"name":%q}}}`,
+				r.PathValue("workspace"), r.PathValue("workspace"),
+			)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/ws-app-staging/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-1",
+							"type": "vars",
+							"attributes": {"key": "region", "value": "us-east-1", "category": "terraform"}
+						},
+						{
+							"id": "var-2",
+							"type": "vars",
+							"attributes": {"key": "replicas", "value": "2", "category": "terraform"}
+						},
+						{
+							"id": "var-3",
+							"type": "vars",
+							"attributes": {"key": "API_TOKEN", "category": "env", "sensitive": true}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/ws-app-prod/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-4",
+							"type": "vars",
+							"attributes": {"key": "replicas", "value": "5", "category": "terraform"}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	result := runCommand(t, client, "--from", "myorg/app-staging", "--to", "app-prod", "--keys", "missing")
+
+	test.Buffer(t, result.ErrBuf, "variable \"missing\" not found in myorg/app-staging\n")
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+		Prompter:        func() *cmdutil.Prompter { return cmdutil.NewPrompter(ios) },
+	}
+
+	cmd := copyvars.NewCmdCopy(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
@@ -87,7 +87,7 @@ func (opts *Options) Run(ctx context.Context) error {
 	}
 
 	// Try to find the variable by name or ID
-	vars, _, err := client.Variables.List(ctx, ws.ID, &tfc.VariableListOptions{ListOptions: tfc.ListOptions{Limit: 1000}})
+	vars, err := client.Variables.ListAll(ctx, ws.ID)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,141 @@
+package diff
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+	"github.com/zkhvan/tfc/pkg/varplan"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	WorkspaceID cmdutil.WorkspaceIdentifier
+	Against     cmdutil.WorkspaceIdentifier
+}
+
+func NewCmdDiff(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "diff --against <ORG/WORKSPACE>",
+		Short: "Compare the variables of two workspaces",
+		Long: text.Heredoc(`
+			Compare the variables of two workspaces.
+
+			Variables are matched by key and category. Variables only in the
+			--against workspace are shown as added, those only in the -W
+			workspace as removed, and those whose value, HCL or sensitive flag
+			or description differ as changed.
+
+			The values of sensitive variables can't be read back, so variables
+			that are sensitive in either workspace are compared by presence
+			only.
+
+			If --against doesn't include an organization, the organization of
+			the -W workspace is used. If -W/--workspace is not specified and
+			state.tf is present, the organization and workspace will be read
+			from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Compare production with staging
+			$ tfc workspaces variables diff -W myorg/app-prod --against app-staging
+		`),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceFlag(cmd, &opts.WorkspaceID, opts.TFEClient)
+
+	cmd.Flags().StringVar(&opts.Against.Raw, "against", "", "Workspace to compare with in ORG/WORKSPACE format")
+
+	_ = cmd.MarkFlagRequired("against")
+	_ = cmd.RegisterFlagCompletionFunc("against", cmdutil.CompletionOrgWorkspace(opts.TFEClient))
+	_ = cmdutil.MarkFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, _ []string) {
+	cmdutil.CompleteWorkspaceIdentifierSilent(cmd, &opts.WorkspaceID, opts.TerraformConfig)
+
+	opts.Against.OrgWorkspace = tfc.ParseOrgWorkspace(opts.Against.Raw)
+	if !opts.Against.HasOrg() {
+		opts.Against.Org = opts.WorkspaceID.Org
+	}
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := opts.WorkspaceID.Validate(); err != nil {
+		return fmt.Errorf("workspace required: use -W ORG/WORKSPACE or ensure state.tf exists")
+	}
+
+	if err := opts.Against.Validate(); err != nil {
+		return fmt.Errorf("invalid --against workspace: %w", err)
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	current, err := listVariables(ctx, client, opts.WorkspaceID.OrgWorkspace)
+	if err != nil {
+		return err
+	}
+
+	other, err := listVariables(ctx, client, opts.Against.OrgWorkspace)
+	if err != nil {
+		return err
+	}
+
+	plan := varplan.New(current, varplan.FromVariables(other, current), varplan.Options{Prune: true})
+
+	fmt.Fprintf(opts.IO.Out, "--- %s\n+++ %s\n", opts.WorkspaceID.String(), opts.Against.String())
+
+	if !plan.HasChanges() {
+		fmt.Fprintln(opts.IO.Out, "\nNo differences.")
+		return nil
+	}
+
+	plan.RenderChanges(opts.IO.Out, false)
+
+	fmt.Fprintf(opts.IO.Out, "%d added, %d removed, %d changed, %d identical.\n",
+		plan.Count(varplan.ActionCreate),
+		plan.Count(varplan.ActionDelete),
+		plan.Count(varplan.ActionUpdate),
+		plan.Count(varplan.ActionUnchanged),
+	)
+
+	return nil
+}
+
+func listVariables(ctx context.Context, client *tfc.Client, id tfc.OrgWorkspace) ([]*tfc.Variable, error) {
+	ws, err := client.Workspaces.Read(ctx, id.Org, id.Workspace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read workspace %s: %w", id.String(), err)
+	}
+
+	vars, err := client.Variables.ListAll(ctx, ws.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list variables for %s: %w", id.String(), err)
+	}
+
+	return vars, nil
+}
@@ -0,0 +1,141 @@
+package diff_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/zkhvan/tfc/cmd/tfc/workspace/variables/diff"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+func TestDiff(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, r *http.Request) {
+			test.PathValue(t, r, "organization", "myorg")
+			fmt.Fprintf(w, `{"data":{"id":"ws-%s","type":"workspaces","attributes":{"name":%q}}}`,
+				r.PathValue("workspace"), r.PathValue("workspace"),
+			)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/ws-app-prod/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-1",
+							"type": "vars",
+							"attributes": {"key": "region", "value": "us-east-1", "category": "terraform"}
+						},
+						{
+							"id": "var-2",
+							"type": "vars",
+							"attributes": {"key": "replicas", "value": "5", "category": "terraform"}
+						},
+						{
+							"id": "var-3",
+							"type": "vars",
+							"attributes": {"key": "DB_PASSWORD", "category": "env", "sensitive": true}
+						},
+						{
+							"id": "var-4",
+							"type": "vars",
+							"attributes": {"key": "legacy", "value": "x", "category": "terraform"}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/ws-app-staging/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-5",
+							"type": "vars",
+							"attributes": {"key": "region", "value": "us-east-1", "category": "terraform"}
+						},
+						{
+							"id": "var-6",
+							"type": "vars",
+							"attributes": {"key": "replicas", "value": "2", "category": "terraform"}
+						},
+						{
+							"id": "var-7",
+							"type": "vars",
+							"attributes": {"key": "DB_PASSWORD", "category": "env", "sensitive": true}
+						},
+						{
+							"id": "var-8",
+							"type": "vars",
+							"attributes": {"key": "API_TOKEN", "category": "env", "sensitive": true}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	result := runCommand(t, client, "-W", "myorg/app-prod", "--against", "app-staging")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		--- myorg/app-prod
+		+++ myorg/app-staging
+		  + API_TOKEN (env) = (sensitive value)
+		  - legacy (terraform)
+		  ~ replicas (terraform) = "5" -> "2"
+
+		1 added, 1 removed, 1 changed, 2 identical.
+	`))
+}
+
+func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, _, stdout, stderr := iolib.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+	}
+
+	cmd := diff.NewCmdDiff(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
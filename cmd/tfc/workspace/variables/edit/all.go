@@ -0,0 +1,145 @@
+package edit
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/varfile"
+	"github.com/zkhvan/tfc/pkg/varplan"
+)
+
+const errorPrefix = "# ERROR: "
+
+var allHeader = text.Heredoc(`
+	# Variables of %s.
+	#
+	# Each variable is preceded by a marker comment with its category,
+	# @terraform or @env, optionally followed by @hcl and @sensitive.
+	# Values of @hcl variables are HCL expressions, other values are strings.
+	#
+	# Sensitive values can't be read back, so they're commented out; uncomment
+	# one and set a value to change it. Remove a variable to delete it and add
+	# one to create it. Empty the file to cancel.
+
+`)
+
+// runAll edits every variable in a single annotated document, reopening the
+// editor with the error inline until the document can be parsed.
+func (opts *Options) runAll(ctx context.Context, client *tfc.Client, workspaceID string, vars []*tfc.Variable) error {
+	var doc bytes.Buffer
+	fmt.Fprintf(&doc, allHeader, opts.WorkspaceID.String())
+	if err := varfile.WriteAnnotated(&doc, vars); err != nil {
+		return fmt.Errorf("variables can't be edited with --all: %w", err)
+	}
+
+	tempDir, err := os.MkdirTemp("", "tfc-variables-*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tempDir)
+
+	path := filepath.Join(tempDir, "variables.tfvars")
+
+	var (
+		content = doc.String()
+		desired []varplan.Variable
+	)
+	for {
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			return err
+		}
+
+		if err := opts.Editor().Edit(ctx, path); err != nil {
+			return fmt.Errorf("failed to launch editor: %w", err)
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		edited := stripErrors(string(data))
+		if strings.TrimSpace(edited) == "" {
+			fmt.Fprintln(opts.IO.Out, "Edit cancelled")
+			return nil
+		}
+
+		desired, err = varfile.ParseAnnotated(filepath.Base(path), []byte(edited))
+		if err == nil {
+			break
+		}
+
+		// Saving the document without fixing the error gives up, so a
+		// misbehaving editor can't loop forever.
+		if edited == stripErrors(content) {
+			return fmt.Errorf("failed to parse variables: %w", err)
+		}
+
+		content = withError(edited, err)
+	}
+
+	plan := varplan.New(vars, desired, varplan.Options{
+		Prune:          true,
+		ForceSensitive: true,
+	})
+
+	if !plan.HasChanges() {
+		fmt.Fprintln(opts.IO.Out, "No changes made to variables")
+		return nil
+	}
+
+	plan.Render(opts.IO.Out, false)
+
+	if !opts.Yes {
+		prompter := opts.Prompter()
+		if !prompter.CanPrompt() {
+			return fmt.Errorf("confirmation required: use --yes to apply the changes without prompting")
+		}
+
+		ok, err := prompter.Confirm(fmt.Sprintf("\nApply these changes to %s?", opts.WorkspaceID.String()))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Fprintln(opts.IO.Out, "Edit cancelled")
+			return nil
+		}
+	}
+
+	if err := plan.Apply(ctx, client, workspaceID); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(opts.IO.Out, "\nApplied: %d created, %d updated, %d deleted.\n",
+		plan.Count(varplan.ActionCreate), plan.Count(varplan.ActionUpdate), plan.Count(varplan.ActionDelete),
+	)
+
+	return nil
+}
+
+// withError prepends an error to a document as comments.
+func withError(doc string, err error) string {
+	var b strings.Builder
+	for _, line := range strings.Split(err.Error(), "\n") {
+		b.WriteString(errorPrefix + line + "\n")
+	}
+	b.WriteString(errorPrefix + "fix the error above, or empty the file to cancel\n")
+	b.WriteString(doc)
+	return b.String()
+}
+
+// stripErrors removes the error comments added by withError.
+func stripErrors(doc string) string {
+	lines := strings.SplitAfter(doc, "\n")
+	i := 0
+	for i < len(lines) && strings.HasPrefix(lines[i], errorPrefix) {
+		i++
+	}
+	return strings.Join(lines[i:], "")
+}
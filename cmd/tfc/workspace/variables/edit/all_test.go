@@ -0,0 +1,240 @@
+package edit_test
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+// createSequenceEditorScript creates an editor that saves the document it
+// receives and replaces it with the next of the given documents on each run.
+func createSequenceEditorScript(t *testing.T, docs ...string) (script, dir string) {
+	t.Helper()
+
+	dir = t.TempDir()
+	for i, doc := range docs {
+		if err := os.WriteFile(filepath.Join(dir, fmt.Sprintf("edit%d", i+1)), []byte(doc), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	script = filepath.Join(dir, "editor.sh")
+	content := text.Heredoc(`
+		#!/bin/sh
+		dir=$(dirname "$0")
+		n=$(( $(cat "$dir/count" 2>/dev/null || echo 0) + 1 ))
+		echo "$n" > "$dir/count"
+		cp "$1" "$dir/received$n"
+		cp "$dir/edit$n" "$1"
+	`)
+	if err := os.WriteFile(script, []byte(content), 0o700); err != nil {
+		t.Fatal(err)
+	}
+
+	return script, dir
+}
+
+func TestEdit_all(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("skipping interactive editor test on Windows")
+	}
+
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	var requests []string
+	record := func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		fmt.Fprint(w, `{"data":{"id":"var-new","type":"vars","attributes":{"key":"k"}}}`)
+	}
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ws-123","type":"workspaces","attributes":{"name":"my-workspace"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-1",
+							"type": "vars",
+							"attributes": {"key": "region", "value": "us-west-2", "category": "terraform"}
+						},
+						{
+							"id": "var-2",
+							"type": "vars",
+							"attributes": {"key": "legacy", "value": "x", "category": "terraform"}
+						},
+						{
+							"id": "var-3",
+							"type": "vars",
+							"attributes": {"key": "API_TOKEN", "category": "env", "sensitive": true}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc("POST /api/v2/workspaces/{workspace_id}/vars", record)
+	mux.HandleFunc("PATCH /api/v2/workspaces/{workspace_id}/vars/{var_id}", record)
+	mux.HandleFunc("DELETE /api/v2/workspaces/{workspace_id}/vars/{var_id}", record)
+
+	edited := text.Heredoc(`
+		# @terraform
+		region = "us-east-1"
+
+		# @terraform @hcl
+		zones = ["a", "b"]
+
+		# @env @sensitive
+		# API_TOKEN = <sensitive>
+	`)
+
+	script, dir := createSequenceEditorScript(t, "region = \n", edited)
+
+	t.Setenv("TFC_EDITOR", "")
+	t.Setenv("VISUAL", "")
+	t.Setenv("EDITOR", script)
+
+	result := runCommand(t, client, "-W", "myorg/my-workspace", "--all", "--yes")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		  - legacy (terraform)
+		  ~ region (terraform) = "us-west-2" -> "us-east-1"
+		  + zones (terraform) = ["a", "b"]
+
+		Plan: 1 to create, 1 to update, 1 to delete, 1 unchanged.
+
+		Applied: 1 created, 1 updated, 1 deleted.
+	`))
+
+	first, err := os.ReadFile(filepath.Join(dir, "received1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"# @env @sensitive\n# API_TOKEN = <sensitive>\n",
+		"# @terraform\nlegacy = \"x\"\n",
+		"# @terraform\nregion = \"us-west-2\"\n",
+	} {
+		if !strings.Contains(string(first), want) {
+			t.Errorf("document doesn't contain %q:\n%s", want, first)
+		}
+	}
+
+	second, err := os.ReadFile(filepath.Join(dir, "received2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(second), "# ERROR: variables.tfvars:1,") ||
+		!strings.HasSuffix(string(second), "region = \n") {
+		t.Errorf("expected the error to be shown above the document, got:\n%s", second)
+	}
+
+	sort.Strings(requests)
+	test.StringSlice(t, requests, []string{
+		"DELETE /api/v2/workspaces/ws-123/vars/var-2",
+		"PATCH /api/v2/workspaces/ws-123/vars/var-1",
+		"POST /api/v2/workspaces/ws-123/vars",
+	})
+}
+
+func TestEdit_all_unfixed_error(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("skipping interactive editor test on Windows")
+	}
+
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	var requests []string
+	record := func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		fmt.Fprint(w, `{"data":{"id":"var-new","type":"vars","attributes":{"key":"k"}}}`)
+	}
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ws-123","type":"workspaces","attributes":{"name":"my-workspace"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-1",
+							"type": "vars",
+							"attributes": {"key": "region", "value": "us-west-2", "category": "terraform"}
+						},
+						{
+							"id": "var-2",
+							"type": "vars",
+							"attributes": {"key": "legacy", "value": "x", "category": "terraform"}
+						},
+						{
+							"id": "var-3",
+							"type": "vars",
+							"attributes": {"key": "API_TOKEN", "category": "env", "sensitive": true}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc("POST /api/v2/workspaces/{workspace_id}/vars", record)
+	mux.HandleFunc("PATCH /api/v2/workspaces/{workspace_id}/vars/{var_id}", record)
+	mux.HandleFunc("DELETE /api/v2/workspaces/{workspace_id}/vars/{var_id}", record)
+
+	script, dir := createSequenceEditorScript(t, "region = \n", "")
+	// The second run saves the document with the error unchanged.
+	if err := os.Remove(filepath.Join(dir, "edit2")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join(dir, "received2"), filepath.Join(dir, "edit2")); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("TFC_EDITOR", "")
+	t.Setenv("VISUAL", "")
+	t.Setenv("EDITOR", script)
+
+	result := runCommand(t, client, "-W", "myorg/my-workspace", "--all", "--yes")
+
+	if !strings.HasPrefix(result.ErrBuf.String(), "failed to parse variables: variables.tfvars:1,") {
+		t.Errorf("unexpected error: %s", result.ErrBuf.String())
+	}
+	if len(requests) != 0 {
+		t.Errorf("expected no changes, got %v", requests)
+	}
+}
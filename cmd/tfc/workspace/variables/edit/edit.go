@@ -21,9 +21,12 @@ type Options struct {
 	TFEClient       func() (*tfc.Client, error)
 	Editor          func() *cmdutil.Editor
 	TerraformConfig func() *tfconfig.TerraformConfig
+	Prompter        func() *cmdutil.Prompter
 
 	WorkspaceID cmdutil.WorkspaceIdentifier
 	Identifier  string // Variable name or ID
+	All         bool
+	Yes         bool
 }
 
 func NewCmdEdit(f *cmdutil.Factory) *cobra.Command {
@@ -32,10 +35,11 @@ func NewCmdEdit(f *cmdutil.Factory) *cobra.Command {
 		TFEClient:       f.TFEClient,
 		Editor:          f.Editor,
 		TerraformConfig: f.TerraformConfig,
+		Prompter:        f.Prompter,
 	}
 
 	cmd := &cobra.Command{
-		Use:   "edit <NAME|ID>",
+		Use:   "edit [<NAME|ID> | --all]",
 		Short: "Edit a workspace variable interactively",
 		Long: text.Heredoc(`
 			Edit a workspace variable interactively.
@@ -45,10 +49,29 @@ func NewCmdEdit(f *cmdutil.Factory) *cobra.Command {
 			preferred editor. After saving and closing the editor, the variable
 			will be updated with the new contents.
 
+			With --all, every variable is loaded into an HCL document where
+			each variable is preceded by a marker comment with its category
+			and flags, like "# @env @sensitive". Variables can be changed,
+			added and removed; the resulting creates, updates and deletes are
+			shown and applied after confirmation. If the document can't be
+			parsed, the editor is reopened with the error at the top.
+
 			If -W/--workspace is not specified and state.tf is present,
 			the organization and workspace will be read from state.tf.
 		`),
-		Args:              cobra.ExactArgs(1),
+		Example: text.Heredoc(`
+			# Edit a single variable
+			$ tfc workspaces variables edit region
+
+			# Edit every variable at once
+			$ tfc workspaces variables edit --all
+		`),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if opts.All {
+				return cobra.NoArgs(cmd, args)
+			}
+			return cobra.ExactArgs(1)(cmd, args)
+		},
 		ValidArgsFunction: cmdutil.CompletionVariableNamesFromWorkspaceFlag(opts.TFEClient, opts.TerraformConfig),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Complete(cmd, args)
@@ -58,13 +81,18 @@ func NewCmdEdit(f *cmdutil.Factory) *cobra.Command {
 
 	cmdutil.AddWorkspaceFlag(cmd, &opts.WorkspaceID, opts.TFEClient)
 
+	cmd.Flags().BoolVar(&opts.All, "all", false, "Edit every variable in a single document")
+	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Apply the changes of --all without asking for confirmation")
+
 	_ = cmdutil.MarkFlagsWithNoFileCompletions(cmd)
 
 	return cmd
 }
 
 func (opts *Options) Complete(cmd *cobra.Command, args []string) {
-	opts.Identifier = args[0]
+	if len(args) > 0 {
+		opts.Identifier = args[0]
+	}
 	cmdutil.CompleteWorkspaceIdentifierSilent(cmd, &opts.WorkspaceID, opts.TerraformConfig)
 }
 
@@ -83,11 +111,15 @@ func (opts *Options) Run(ctx context.Context) error {
 		return err
 	}
 
-	vars, _, err := client.Variables.List(ctx, ws.ID, &tfc.VariableListOptions{ListOptions: tfc.ListOptions{Limit: 1000}})
+	vars, err := client.Variables.ListAll(ctx, ws.ID)
 	if err != nil {
 		return err
 	}
 
+	if opts.All {
+		return opts.runAll(ctx, client, ws.ID, vars)
+	}
+
 	var targetVar *tfe.Variable
 	for _, v := range vars {
 		if v.ID == opts.Identifier || v.Key == opts.Identifier {
@@ -0,0 +1,114 @@
+package export
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+	"github.com/zkhvan/tfc/pkg/varfile"
+)
+
+var Formats = []string{
+	varfile.FormatTFVars,
+	varfile.FormatEnv,
+	varfile.FormatJSON,
+	varfile.FormatShell,
+}
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+
+	WorkspaceID cmdutil.WorkspaceIdentifier
+	Format      string
+}
+
+func NewCmdExport(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export a workspace's variables",
+		Long: text.Heredoc(`
+			Export a workspace's variables as a .tfvars, .env, JSON or shell
+			file.
+
+			The tfvars format writes the terraform variables and the env
+			format writes the env variables. The shell format writes export
+			statements for the env variables, and for the terraform variables
+			as TF_VAR_ variables. The JSON format writes every variable, with
+			its category, HCL flag and description.
+
+			The API never returns the values of sensitive variables, so they
+			are written as commented placeholders, or without a value in JSON.
+			Importing the file with "variables import" keeps them as they are.
+
+			If -W/--workspace is not specified and state.tf is present,
+			the organization and workspace will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Export the terraform variables to a .tfvars file
+			$ tfc workspaces variables export > prod.tfvars
+
+			# Export the env variables to a .env file
+			$ tfc workspaces variables export --format env > .env
+
+			# Load the variables into the current shell
+			$ eval "$(tfc workspaces variables export --format shell)"
+		`),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceFlag(cmd, &opts.WorkspaceID, opts.TFEClient)
+
+	_ = cmdutil.FlagStringEnum(cmd, &opts.Format, "format", varfile.FormatTFVars, "Output format", Formats)
+	_ = cmdutil.MarkFlagsWithNoFileCompletions(cmd)
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, _ []string) {
+	cmdutil.CompleteWorkspaceIdentifierSilent(cmd, &opts.WorkspaceID, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := opts.WorkspaceID.Validate(); err != nil {
+		return fmt.Errorf("workspace required: use -W ORG/WORKSPACE or ensure state.tf exists")
+	}
+
+	if err := cmdutil.ValidateEnum("format", opts.Format, Formats); err != nil {
+		return err
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	ws, err := client.Workspaces.Read(ctx, opts.WorkspaceID.Org, opts.WorkspaceID.Workspace)
+	if err != nil {
+		return fmt.Errorf("failed to read workspace %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	vars, err := client.Variables.ListAll(ctx, ws.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list variables for %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	return varfile.Write(opts.IO.Out, vars, opts.Format)
+}
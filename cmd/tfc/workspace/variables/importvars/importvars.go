@@ -0,0 +1,254 @@
+package importvars
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+	"github.com/zkhvan/tfc/pkg/varfile"
+	"github.com/zkhvan/tfc/pkg/varplan"
+)
+
+type Options struct {
+	IO              *iolib.IOStreams
+	TFEClient       func() (*tfc.Client, error)
+	TerraformConfig func() *tfconfig.TerraformConfig
+	Prompter        func() *cmdutil.Prompter
+
+	WorkspaceID   cmdutil.WorkspaceIdentifier
+	File          string
+	Format        string
+	SensitiveKeys []string
+	Category      string
+	Prune         bool
+	Yes           bool
+}
+
+func NewCmdImport(f *cmdutil.Factory) *cobra.Command {
+	opts := &Options{
+		IO:              f.IOStreams,
+		TFEClient:       f.TFEClient,
+		TerraformConfig: f.TerraformConfig,
+		Prompter:        f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "import <FILE>",
+		Short: "Import workspace variables from a file",
+		Long: text.Heredoc(`
+			Import workspace variables from a .tfvars, .env or JSON file.
+
+			Variables in .tfvars files are imported as terraform variables.
+			Strings, numbers and bools are imported as plain values, while
+			lists, maps and other expressions are imported as HCL variables.
+			Variables in .env files are imported as env variables. JSON files
+			are either an object of terraform variables, like .tfvars.json,
+			or a list of variables as written by "variables export".
+
+			Commented placeholders of sensitive variables, as written by
+			"variables export", keep the existing variables as they are.
+
+			With --prune, variables that aren't in the file are deleted. Only
+			variables of the categories the file can hold are pruned: env
+			variables for .env files and terraform variables for .tfvars files.
+
+			The changes are shown as a plan of creates, updates and unchanged
+			variables, and applied after confirmation. Values of sensitive
+			variables can't be compared, so they're always updated.
+
+			If -W/--workspace is not specified and state.tf is present,
+			the organization and workspace will be read from state.tf.
+		`),
+		Example: text.Heredoc(`
+			# Import terraform variables from a .tfvars file
+			$ tfc workspaces variables import prod.tfvars
+
+			# Import env variables, marking secrets as sensitive
+			$ tfc workspaces variables import .env --sensitive-keys '*_SECRET*,*_TOKEN'
+
+			# Make the workspace's variables match the file exactly
+			$ tfc workspaces variables import prod.tfvars --prune --yes
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Complete(cmd, args)
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmdutil.AddWorkspaceFlag(cmd, &opts.WorkspaceID, opts.TFEClient)
+
+	_ = cmdutil.FlagStringEnum(cmd, &opts.Format, "format", "",
+		"File format: tfvars, env or json (detected from the file name by default)",
+		[]string{varfile.FormatTFVars, varfile.FormatEnv, varfile.FormatJSON},
+	)
+	cmd.Flags().StringSliceVar(&opts.SensitiveKeys, "sensitive-keys", []string{},
+		"Glob patterns of keys to mark as sensitive",
+	)
+	_ = cmdutil.FlagStringEnum(cmd, &opts.Category, "category", "",
+		"Import every variable with this category: terraform or env",
+		[]string{string(tfe.CategoryTerraform), string(tfe.CategoryEnv)},
+	)
+	cmd.Flags().BoolVar(&opts.Prune, "prune", false,
+		"Delete variables of the file's category that aren't in the file",
+	)
+	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Apply the changes without asking for confirmation")
+
+	_ = cmdutil.MarkFlagsWithNoFileCompletions(cmd, "sensitive-keys", "prune", "yes")
+
+	return cmd
+}
+
+func (opts *Options) Complete(cmd *cobra.Command, args []string) {
+	opts.File = args[0]
+	cmdutil.CompleteWorkspaceIdentifierSilent(cmd, &opts.WorkspaceID, opts.TerraformConfig)
+}
+
+func (opts *Options) Run(ctx context.Context) error {
+	if err := opts.WorkspaceID.Validate(); err != nil {
+		return fmt.Errorf("workspace required: use -W ORG/WORKSPACE or ensure state.tf exists")
+	}
+
+	if opts.Category != "" {
+		categories := []string{string(tfe.CategoryTerraform), string(tfe.CategoryEnv)}
+		if err := cmdutil.ValidateEnum("category", opts.Category, categories); err != nil {
+			return err
+		}
+	}
+
+	if opts.Format != "" {
+		formats := []string{varfile.FormatTFVars, varfile.FormatEnv, varfile.FormatJSON}
+		if err := cmdutil.ValidateEnum("format", opts.Format, formats); err != nil {
+			return err
+		}
+	}
+
+	desired, format, err := opts.readFile()
+	if err != nil {
+		return err
+	}
+
+	client, err := opts.TFEClient()
+	if err != nil {
+		return err
+	}
+
+	ws, err := client.Workspaces.Read(ctx, opts.WorkspaceID.Org, opts.WorkspaceID.Workspace)
+	if err != nil {
+		return fmt.Errorf("failed to read workspace %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	current, err := client.Variables.ListAll(ctx, ws.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list variables for %s: %w", opts.WorkspaceID.String(), err)
+	}
+
+	plan := varplan.New(current, desired, varplan.Options{
+		Prune:           opts.Prune,
+		PruneCategories: opts.pruneCategories(format),
+		ForceSensitive:  true,
+	})
+
+	plan.Render(opts.IO.Out, true)
+
+	if !plan.HasChanges() {
+		fmt.Fprintln(opts.IO.Out, "\nNo changes.")
+		return nil
+	}
+
+	if !opts.Yes {
+		prompter := opts.Prompter()
+		if !prompter.CanPrompt() {
+			return fmt.Errorf("confirmation required: use --yes to apply the changes without prompting")
+		}
+
+		ok, err := prompter.Confirm(fmt.Sprintf("\nApply these changes to %s?", opts.WorkspaceID.String()))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Fprintln(opts.IO.Out, "Import cancelled")
+			return nil
+		}
+	}
+
+	if err := plan.Apply(ctx, client, ws.ID); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(opts.IO.Out, "\nApplied: %d created, %d updated, %d deleted.\n",
+		plan.Count(varplan.ActionCreate), plan.Count(varplan.ActionUpdate), plan.Count(varplan.ActionDelete),
+	)
+
+	return nil
+}
+
+// readFile parses the file and applies the category and sensitive overrides.
+// It returns the variables along with the format of the file.
+func (opts *Options) readFile() ([]varplan.Variable, string, error) {
+	format := opts.Format
+	if format == "" {
+		var err error
+		if format, err = varfile.DetectFormat(opts.File); err != nil {
+			return nil, "", err
+		}
+	}
+
+	data, err := os.ReadFile(opts.File)
+	if err != nil {
+		return nil, "", err
+	}
+
+	vars, err := varfile.Parse(opts.File, data, format)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to parse %s: %w", opts.File, err)
+	}
+
+	for _, pattern := range opts.SensitiveKeys {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, "", fmt.Errorf("invalid sensitive key pattern %q: %w", pattern, err)
+		}
+	}
+
+	for i := range vars {
+		if opts.Category != "" {
+			vars[i].Category = tfe.CategoryType(opts.Category)
+		}
+
+		for _, pattern := range opts.SensitiveKeys {
+			if ok, _ := path.Match(pattern, vars[i].Key); ok {
+				vars[i].Sensitive = true
+			}
+		}
+	}
+
+	return vars, format, nil
+}
+
+// pruneCategories returns the categories of variables the file can hold, so
+// --prune leaves the variables of other categories alone: a .env file can't
+// remove terraform variables, nor a .tfvars file env variables. JSON files
+// can hold both categories.
+func (opts *Options) pruneCategories(format string) []tfe.CategoryType {
+	if opts.Category != "" {
+		return []tfe.CategoryType{tfe.CategoryType(opts.Category)}
+	}
+
+	switch format {
+	case varfile.FormatEnv:
+		return []tfe.CategoryType{tfe.CategoryEnv}
+	case varfile.FormatTFVars:
+		return []tfe.CategoryType{tfe.CategoryTerraform}
+	default:
+		return nil
+	}
+}
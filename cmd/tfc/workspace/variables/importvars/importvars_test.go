@@ -0,0 +1,333 @@
+package importvars_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/zkhvan/tfc/cmd/tfc/workspace/variables/importvars"
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/internal/tfc/tfetest"
+	"github.com/zkhvan/tfc/pkg/cmdutil"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func TestImport_tfvars(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	var requests []string
+	record := func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		fmt.Fprint(w, `{"data":{"id":"var-new","type":"vars","attributes":{"key":"k"}}}`)
+	}
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ws-123","type":"workspaces","attributes":{"name":"my-workspace"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-1",
+							"type": "vars",
+							"attributes": {"key": "region", "value": "us-west-2", "category": "terraform"}
+						},
+						{
+							"id": "var-2",
+							"type": "vars",
+							"attributes": {"key": "replicas", "value": "3", "category": "terraform"}
+						},
+						{
+							"id": "var-3",
+							"type": "vars",
+							"attributes": {"key": "legacy", "value": "x", "category": "terraform"}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc("POST /api/v2/workspaces/{workspace_id}/vars", record)
+	mux.HandleFunc("PATCH /api/v2/workspaces/{workspace_id}/vars/{variable_id}", record)
+	mux.HandleFunc("DELETE /api/v2/workspaces/{workspace_id}/vars/{variable_id}", record)
+
+	file := writeFile(t, "prod.tfvars", text.Heredoc(`
+		region   = "us-east-1"
+		replicas = 3
+		zones    = ["a", "b"]
+	`))
+
+	result := runCommand(t, client, "", "-W", "myorg/my-workspace", file, "--prune", "--yes")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		  - legacy (terraform)
+		  ~ region (terraform) = "us-west-2" -> "us-east-1"
+		    replicas (terraform)
+		  + zones (terraform) = ["a", "b"]
+
+		Plan: 1 to create, 1 to update, 1 to delete, 1 unchanged.
+
+		Applied: 1 created, 1 updated, 1 deleted.
+	`))
+
+	sort.Strings(requests)
+	test.StringSlice(t, requests, []string{
+		"DELETE /api/v2/workspaces/ws-123/vars/var-3",
+		"PATCH /api/v2/workspaces/ws-123/vars/var-1",
+		"POST /api/v2/workspaces/ws-123/vars",
+	})
+}
+
+func TestImport_env_sensitive_keys_cancelled(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	var requests []string
+	record := func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		fmt.Fprint(w, `{"data":{"id":"var-new","type":"vars","attributes":{"key":"k"}}}`)
+	}
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ws-123","type":"workspaces","attributes":{"name":"my-workspace"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-1",
+							"type": "vars",
+							"attributes": {"key": "region", "value": "us-west-2", "category": "terraform"}
+						},
+						{
+							"id": "var-2",
+							"type": "vars",
+							"attributes": {"key": "replicas", "value": "3", "category": "terraform"}
+						},
+						{
+							"id": "var-3",
+							"type": "vars",
+							"attributes": {"key": "legacy", "value": "x", "category": "terraform"}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc("POST /api/v2/workspaces/{workspace_id}/vars", record)
+	mux.HandleFunc("PATCH /api/v2/workspaces/{workspace_id}/vars/{variable_id}", record)
+	mux.HandleFunc("DELETE /api/v2/workspaces/{workspace_id}/vars/{variable_id}", record)
+
+	file := writeFile(t, ".env", "API_TOKEN=abc\nLOG_LEVEL=debug\n")
+
+	result := runCommand(t, client, "n\n", "-W", "myorg/my-workspace", file, "--sensitive-keys", "*_TOKEN")
+
+	test.Buffer(t, result.ErrBuf, "\nApply these changes to myorg/my-workspace? [y/N] ")
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		  + API_TOKEN (env) = (sensitive value)
+		  + LOG_LEVEL (env) = "debug"
+
+		Plan: 2 to create, 0 to update, 0 to delete, 0 unchanged.
+		Import cancelled
+	`))
+
+	if len(requests) != 0 {
+		t.Errorf("expected no changes, got %v", requests)
+	}
+}
+
+func TestImport_env_prune_keeps_terraform_variables(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	var requests []string
+	record := func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		fmt.Fprint(w, `{"data":{"id":"var-new","type":"vars","attributes":{"key":"k"}}}`)
+	}
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ws-123","type":"workspaces","attributes":{"name":"my-workspace"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-1",
+							"type": "vars",
+							"attributes": {"key": "region", "value": "us-west-2", "category": "terraform"}
+						},
+						{
+							"id": "var-2",
+							"type": "vars",
+							"attributes": {"key": "LOG_LEVEL", "value": "info", "category": "env"}
+						},
+						{
+							"id": "var-3",
+							"type": "vars",
+							"attributes": {"key": "LEGACY", "value": "x", "category": "env"}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	mux.HandleFunc("POST /api/v2/workspaces/{workspace_id}/vars", record)
+	mux.HandleFunc("PATCH /api/v2/workspaces/{workspace_id}/vars/{variable_id}", record)
+	mux.HandleFunc("DELETE /api/v2/workspaces/{workspace_id}/vars/{variable_id}", record)
+
+	file := writeFile(t, ".env", "LOG_LEVEL=debug\n")
+
+	result := runCommand(t, client, "", "-W", "myorg/my-workspace", file, "--prune", "--yes")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, text.Heredoc(`
+		  - LEGACY (env)
+		  ~ LOG_LEVEL (env) = "info" -> "debug"
+
+		Plan: 0 to create, 1 to update, 1 to delete, 0 unchanged.
+
+		Applied: 0 created, 1 updated, 1 deleted.
+	`))
+
+	sort.Strings(requests)
+	test.StringSlice(t, requests, []string{
+		"DELETE /api/v2/workspaces/ws-123/vars/var-3",
+		"PATCH /api/v2/workspaces/ws-123/vars/var-2",
+	})
+}
+
+func TestImport_requires_confirmation(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ws-123","type":"workspaces","attributes":{"name":"my-workspace"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `
+				{
+					"data": [
+						{
+							"id": "var-1",
+							"type": "vars",
+							"attributes": {"key": "region", "value": "us-west-2", "category": "terraform"}
+						},
+						{
+							"id": "var-2",
+							"type": "vars",
+							"attributes": {"key": "replicas", "value": "3", "category": "terraform"}
+						},
+						{
+							"id": "var-3",
+							"type": "vars",
+							"attributes": {"key": "legacy", "value": "x", "category": "terraform"}
+						}
+					]
+				}
+			`)
+		},
+	)
+
+	file := writeFile(t, "prod.tfvars", "region = \"us-east-1\"\n")
+
+	result := runCommand(t, client, "", "-W", "myorg/my-workspace", file)
+
+	test.Buffer(t, result.ErrBuf, "confirmation required: use --yes to apply the changes without prompting\n")
+}
+
+func runCommand(t *testing.T, client *tfc.Client, stdin string, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, in, stdout, stderr := iolib.Test()
+	in.WriteString(stdin)
+	ios.SetStdinTTY(stdin != "")
+
+	f := &cmdutil.Factory{
+		IOStreams:       ios,
+		TFEClient:       func() (*tfc.Client, error) { return client, nil },
+		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+		Prompter:        func() *cmdutil.Prompter { return cmdutil.NewPrompter(ios) },
+	}
+
+	cmd := importvars.NewCmdImport(f)
+	cmd.SetArgs(args)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	_, err := cmd.ExecuteC()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return &tfetest.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/charmbracelet/lipgloss"
 	"github.com/hashicorp/go-tfe"
 	"github.com/spf13/cobra"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/zkhvan/tfc/pkg/iolib"
 	"github.com/zkhvan/tfc/pkg/text"
 	"github.com/zkhvan/tfc/pkg/tfconfig"
+	"github.com/zkhvan/tfc/pkg/vareffective"
 )
 
 const (
@@ -23,6 +25,7 @@ const (
 	ColumnSensitive   string = "SENSITIVE"
 	ColumnCategory    string = "CATEGORY"
 	ColumnHCL         string = "HCL"
+	ColumnSource      string = "SOURCE"
 )
 
 var (
@@ -39,6 +42,13 @@ var (
 		ColumnSensitive,
 		ColumnCategory,
 		ColumnHCL,
+		ColumnSource,
+	}
+	ColumnsEffective = []string{
+		ColumnKey,
+		ColumnValue,
+		ColumnCategory,
+		ColumnSource,
 	}
 )
 
@@ -50,6 +60,7 @@ type Options struct {
 
 	WorkspaceID cmdutil.WorkspaceIdentifier
 	Columns     []string
+	Effective   bool
 }
 
 func NewCmdList(f *cmdutil.Factory) *cobra.Command {
@@ -67,11 +78,38 @@ func NewCmdList(f *cmdutil.Factory) *cobra.Command {
 		Long: text.Heredoc(`
 			List a workspace's variables.
 
+			Use --effective to also include the variables of the variable sets
+			applied to the workspace, and show the value each variable
+			resolves to with its SOURCE. Precedence, from highest to lowest:
+
+			  1. Priority variable sets
+			  2. Workspace variables
+			  3. Variable sets applied to the workspace
+			  4. Variable sets applied to the workspace's project
+			  5. Global variable sets
+
+			Within priority variable sets, those applied to the workspace come
+			before those applied to its project, then global ones. When
+			variable sets of the same precedence conflict, the one whose name
+			sorts first wins. Overridden definitions are shown faded beneath
+			the effective variable.
+
 			If -W/--workspace is not specified and state.tf is present,
 			the organization and workspace will be read from state.tf.
 		`),
+		Example: text.Heredoc(`
+			# List the variables of the workspace
+			$ tfc workspaces variables list -W myorg/myworkspace
+
+			# Show where each variable's value comes from
+			$ tfc workspaces variables list -W myorg/myworkspace --effective
+		`),
 		ValidArgsFunction: cobra.NoFileCompletions,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Effective && !cmd.Flags().Changed("columns") {
+				opts.Columns = ColumnsEffective
+			}
+
 			opts.Complete(cmd, args)
 			return opts.Run(cmd.Context())
 		},
@@ -79,6 +117,10 @@ func NewCmdList(f *cmdutil.Factory) *cobra.Command {
 
 	cmdutil.AddWorkspaceFlag(cmd, &opts.WorkspaceID, opts.TFEClient)
 
+	cmd.Flags().BoolVar(&opts.Effective, "effective", false,
+		"Include variable sets and show the effective value of each variable",
+	)
+
 	_ = cmdutil.FlagStringEnumSliceP(cmd, &opts.Columns, "columns", "c", ColumnsDefault, "Columns to show.", ColumnsAll)
 	_ = cmdutil.MarkFlagsWithNoFileCompletions(cmd)
 
@@ -104,6 +146,10 @@ func (opts *Options) Run(ctx context.Context) error {
 		return err
 	}
 
+	if opts.Effective {
+		return opts.runEffective(ctx, client, ws)
+	}
+
 	vars, _, err := client.Variables.List(ctx, ws.ID, &tfc.VariableListOptions{})
 	if err != nil {
 		return err
@@ -127,7 +173,49 @@ func (opts *Options) extractFields(v *tfe.Variable) map[string]string {
 		ColumnCategory:    string(v.Category),
 		ColumnHCL:         strconv.FormatBool(v.HCL),
 		ColumnSensitive:   strconv.FormatBool(v.Sensitive),
+		ColumnSource:      "workspace",
 	}
 
 	return out
 }
+
+func (opts *Options) runEffective(ctx context.Context, client *tfc.Client, ws *tfc.Workspace) error {
+	defs, err := vareffective.Load(ctx, client, ws)
+	if err != nil {
+		return err
+	}
+
+	faintStyle := lipgloss.NewStyle().Faint(true)
+
+	p := cmdutil.FieldPrinter(opts.IO, opts.Columns...)
+	for _, v := range vareffective.Resolve(defs) {
+		p.Write(extractDefinitionFields(v.Definition))
+
+		for _, d := range v.Overridden {
+			fields := extractDefinitionFields(d)
+			fields[ColumnKey] = "  " + fields[ColumnKey]
+			fields[ColumnSource] = "overridden: " + fields[ColumnSource]
+
+			for k, f := range fields {
+				fields[k] = faintStyle.Render(f)
+			}
+			p.Write(fields)
+		}
+	}
+	p.Flush()
+
+	return nil
+}
+
+func extractDefinitionFields(d vareffective.Definition) map[string]string {
+	return map[string]string{
+		ColumnID:          d.ID,
+		ColumnKey:         d.Key,
+		ColumnValue:       d.Value,
+		ColumnDescription: d.Description,
+		ColumnCategory:    string(d.Category),
+		ColumnHCL:         strconv.FormatBool(d.HCL),
+		ColumnSensitive:   strconv.FormatBool(d.Sensitive),
+		ColumnSource:      d.Source.String(),
+	}
+}
@@ -13,16 +13,18 @@ import (
 	"github.com/zkhvan/tfc/pkg/ptr"
 	"github.com/zkhvan/tfc/pkg/text"
 	"github.com/zkhvan/tfc/pkg/tfconfig"
+	"github.com/zkhvan/tfc/pkg/varfile"
 )
 
 type Options struct {
 	IO              *iolib.IOStreams
 	TFEClient       func() (*tfc.Client, error)
 	TerraformConfig func() *tfconfig.TerraformConfig
+	Prompter        func() *cmdutil.Prompter
 
 	WorkspaceID cmdutil.WorkspaceIdentifier
 	Identifier  string // Variable name or ID
-	Value       string
+	Value       cmdutil.ValueFlags
 	Description string
 	Category    string
 	HCL         bool
@@ -34,6 +36,7 @@ func NewCmdSet(f *cmdutil.Factory) *cobra.Command {
 		IO:              f.IOStreams,
 		TFEClient:       f.TFEClient,
 		TerraformConfig: f.TerraformConfig,
+		Prompter:        f.Prompter,
 	}
 
 	cmd := &cobra.Command{
@@ -45,6 +48,15 @@ func NewCmdSet(f *cmdutil.Factory) *cobra.Command {
 			The variable can be identified by either its name or ID. If the
 			variable does not exist, it will be created.
 
+			To keep secrets out of the shell history, read the value from
+			standard input with --value -, from a file with --value-file, or
+			from the output of a shell command with --value-from-cmd. When
+			standard input is a terminal, the value is prompted for without
+			being echoed. A single trailing newline is removed.
+
+			Values of --hcl variables are checked to be valid HCL expressions
+			before they're set.
+
 			If -W/--workspace is not specified and state.tf is present,
 			the organization and workspace will be read from state.tf.
 		`),
@@ -63,6 +75,15 @@ func NewCmdSet(f *cmdutil.Factory) *cobra.Command {
 
 			# Set a variable by ID
 			$ tfc workspaces variables set var-abc123 --value "new-value"
+
+			# Prompt for a sensitive value without echoing it
+			$ tfc workspaces variables set AWS_SECRET --value - --sensitive
+
+			# Set a sensitive value from a password manager
+			$ tfc workspaces variables set AWS_SECRET --value-from-cmd "op read op://vault/aws/secret" --sensitive
+
+			# Set an HCL variable from a file
+			$ tfc workspaces variables set tags --value-file tags.hcl --hcl
 		`),
 		Args:              cobra.ExactArgs(1),
 		ValidArgsFunction: cmdutil.CompletionVariableNamesFromWorkspaceFlag(opts.TFEClient, opts.TerraformConfig),
@@ -74,14 +95,13 @@ func NewCmdSet(f *cmdutil.Factory) *cobra.Command {
 
 	cmdutil.AddWorkspaceFlag(cmd, &opts.WorkspaceID, opts.TFEClient)
 
-	cmd.Flags().StringVarP(&opts.Value, "value", "v", "", "Variable value (required)")
+	cmdutil.AddValueFlags(cmd, &opts.Value)
 	cmd.Flags().StringVarP(&opts.Description, "description", "d", "", "Variable description")
 	cmd.Flags().StringVarP(&opts.Category, "category", "c", "terraform", "Variable category: terraform or env")
 	cmd.Flags().BoolVar(&opts.HCL, "hcl", false, "Parse the value as HCL")
 	cmd.Flags().BoolVar(&opts.Sensitive, "sensitive", false, "Mark the variable as sensitive")
 
-	_ = cmd.MarkFlagRequired("value")
-	_ = cmdutil.MarkFlagsWithNoFileCompletions(cmd)
+	_ = cmdutil.MarkFlagsWithNoFileCompletions(cmd, "description", "category", "hcl", "sensitive")
 
 	return cmd
 }
@@ -96,6 +116,17 @@ func (opts *Options) Run(ctx context.Context) error {
 		return fmt.Errorf("workspace required: use -W ORG/WORKSPACE or ensure state.tf exists")
 	}
 
+	value, err := opts.Value.Read(ctx, opts.IO, opts.Prompter, opts.Identifier)
+	if err != nil {
+		return err
+	}
+
+	if opts.HCL {
+		if err := varfile.ValidateHCL(opts.Identifier, value); err != nil {
+			return err
+		}
+	}
+
 	client, err := opts.TFEClient()
 	if err != nil {
 		return err
@@ -107,7 +138,7 @@ func (opts *Options) Run(ctx context.Context) error {
 	}
 
 	// Try to find the variable by name or ID
-	vars, _, err := client.Variables.List(ctx, ws.ID, &tfc.VariableListOptions{ListOptions: tfc.ListOptions{Limit: 1000}})
+	vars, err := client.Variables.ListAll(ctx, ws.ID)
 	if err != nil {
 		return err
 	}
@@ -127,7 +158,7 @@ func (opts *Options) Run(ctx context.Context) error {
 
 	if existingVar != nil {
 		updateOpts := tfe.VariableUpdateOptions{
-			Value:     ptr.String(opts.Value),
+			Value:     ptr.String(value),
 			HCL:       ptr.Bool(opts.HCL),
 			Sensitive: ptr.Bool(opts.Sensitive),
 			Category:  &category,
@@ -146,7 +177,7 @@ func (opts *Options) Run(ctx context.Context) error {
 	} else {
 		createOpts := tfe.VariableCreateOptions{
 			Key:       ptr.String(opts.Identifier),
-			Value:     ptr.String(opts.Value),
+			Value:     ptr.String(value),
 			Category:  &category,
 			HCL:       ptr.Bool(opts.HCL),
 			Sensitive: ptr.Bool(opts.Sensitive),
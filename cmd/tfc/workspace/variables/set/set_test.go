@@ -2,8 +2,11 @@ package set_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/zkhvan/tfc/cmd/tfc/workspace/variables/set"
@@ -12,6 +15,7 @@ import (
 	"github.com/zkhvan/tfc/internal/tfc/tfetest"
 	"github.com/zkhvan/tfc/pkg/cmdutil"
 	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/text"
 	"github.com/zkhvan/tfc/pkg/tfconfig"
 )
 
@@ -396,18 +400,351 @@ func TestSet_with_env_category(t *testing.T) {
 func runCommand(t *testing.T, client *tfc.Client, args ...string) *tfetest.CmdOut {
 	t.Helper()
 
-	ios, _, stdout, stderr := iolib.Test()
+	return runCommandWithStdin(t, client, "", args...)
+}
+
+func TestSet_value_file(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ws-123","type":"workspaces","attributes":{"name":"my-workspace"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":[]}`)
+		},
+	)
+
+	var values []string
+	mux.HandleFunc(
+		"POST /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Data struct {
+					Attributes struct {
+						Key   string `json:"key"`
+						Value string `json:"value"`
+					} `json:"attributes"`
+				} `json:"data"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				values = append(values, "invalid request: "+err.Error())
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			values = append(values, body.Data.Attributes.Value)
+			fmt.Fprintf(w, `{"data":{"id":"var-new","type":"vars","attributes":{"key":%q}}}`, body.Data.Attributes.Key)
+		},
+	)
+
+	path := filepath.Join(t.TempDir(), "secret")
+	if err := os.WriteFile(path, []byte("s3cr3t\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	result := runCommand(t, client, "-W", "myorg/my-workspace", "SECRET", "--value-file", path)
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.Buffer(t, result.OutBuf, "Variable \"SECRET\" created successfully\n")
+	test.StringSlice(t, values, []string{"s3cr3t"})
+}
+
+func TestSet_value_from_cmd(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ws-123","type":"workspaces","attributes":{"name":"my-workspace"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":[]}`)
+		},
+	)
+
+	var values []string
+	mux.HandleFunc(
+		"POST /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Data struct {
+					Attributes struct {
+						Key   string `json:"key"`
+						Value string `json:"value"`
+					} `json:"attributes"`
+				} `json:"data"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				values = append(values, "invalid request: "+err.Error())
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			values = append(values, body.Data.Attributes.Value)
+			fmt.Fprintf(w, `{"data":{"id":"var-new","type":"vars","attributes":{"key":%q}}}`, body.Data.Attributes.Key)
+		},
+	)
+
+	result := runCommand(t, client, "-W", "myorg/my-workspace", "SECRET", "--value-from-cmd", "printf 'from\\ncmd\\n'")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.StringSlice(t, values, []string{"from\ncmd"})
+}
+
+func TestSet_value_from_cmd_failure(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ws-123","type":"workspaces","attributes":{"name":"my-workspace"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":[]}`)
+		},
+	)
+
+	var values []string
+	mux.HandleFunc(
+		"POST /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Data struct {
+					Attributes struct {
+						Key   string `json:"key"`
+						Value string `json:"value"`
+					} `json:"attributes"`
+				} `json:"data"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				values = append(values, "invalid request: "+err.Error())
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			values = append(values, body.Data.Attributes.Value)
+			fmt.Fprintf(w, `{"data":{"id":"var-new","type":"vars","attributes":{"key":%q}}}`, body.Data.Attributes.Key)
+		},
+	)
+
+	result := runCommandWithStdin(t, client, "",
+		"-W", "myorg/my-workspace", "SECRET", "--value-from-cmd", "echo denied >&2; exit 3",
+	)
+
+	test.Buffer(t, result.ErrBuf, "denied\ncommand \"echo denied >&2; exit 3\" failed: exit status 3\n")
+	test.StringSlice(t, values, nil)
+}
+
+func TestSet_value_stdin(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ws-123","type":"workspaces","attributes":{"name":"my-workspace"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":[]}`)
+		},
+	)
+
+	var values []string
+	mux.HandleFunc(
+		"POST /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Data struct {
+					Attributes struct {
+						Key   string `json:"key"`
+						Value string `json:"value"`
+					} `json:"attributes"`
+				} `json:"data"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				values = append(values, "invalid request: "+err.Error())
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			values = append(values, body.Data.Attributes.Value)
+			fmt.Fprintf(w, `{"data":{"id":"var-new","type":"vars","attributes":{"key":%q}}}`, body.Data.Attributes.Key)
+		},
+	)
+
+	result := runCommandWithStdin(t, client, "piped value\n", "-W", "myorg/my-workspace", "SECRET", "--value", "-")
+
+	test.BufferEmpty(t, result.ErrBuf)
+	test.StringSlice(t, values, []string{"piped value"})
+}
+
+func TestSet_value_stdin_prompt(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ws-123","type":"workspaces","attributes":{"name":"my-workspace"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":[]}`)
+		},
+	)
+
+	var values []string
+	mux.HandleFunc(
+		"POST /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Data struct {
+					Attributes struct {
+						Key   string `json:"key"`
+						Value string `json:"value"`
+					} `json:"attributes"`
+				} `json:"data"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				values = append(values, "invalid request: "+err.Error())
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			values = append(values, body.Data.Attributes.Value)
+			fmt.Fprintf(w, `{"data":{"id":"var-new","type":"vars","attributes":{"key":%q}}}`, body.Data.Attributes.Key)
+		},
+	)
+
+	ios, in, stdout, stderr := iolib.Test()
+	in.WriteString("typed value\n")
+	ios.SetStdinTTY(true)
+
+	result := execute(t, ios, stdout, stderr, client, "-W", "myorg/my-workspace", "SECRET", "--value", "-")
+
+	test.Buffer(t, result.ErrBuf, "Value for SECRET: ")
+	test.StringSlice(t, values, []string{"typed value"})
+}
+
+func TestSet_value_exclusive(t *testing.T) {
+	client, _, teardown := tfetest.Setup()
+	defer teardown()
+
+	result := runCommand(t, client, "-W", "myorg/my-workspace", "SECRET", "--value", "a", "--value-file", "b")
+
+	test.Buffer(t, result.ErrBuf,
+		"if any flags in the group [value value-file value-from-cmd] are set none of the others can be; "+
+			"[value value-file] were all set\n",
+	)
+}
+
+func TestSet_invalid_hcl(t *testing.T) {
+	client, mux, teardown := tfetest.Setup()
+	defer teardown()
+
+	mux.HandleFunc(
+		"GET /api/v2/organizations/{organization}/workspaces/{workspace}",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ws-123","type":"workspaces","attributes":{"name":"my-workspace"}}}`)
+		},
+	)
+
+	mux.HandleFunc(
+		"GET /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":[]}`)
+		},
+	)
+
+	var values []string
+	mux.HandleFunc(
+		"POST /api/v2/workspaces/{workspace_id}/vars",
+		func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Data struct {
+					Attributes struct {
+						Key   string `json:"key"`
+						Value string `json:"value"`
+					} `json:"attributes"`
+				} `json:"data"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				values = append(values, "invalid request: "+err.Error())
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			values = append(values, body.Data.Attributes.Value)
+			fmt.Fprintf(w, `{"data":{"id":"var-new","type":"vars","attributes":{"key":%q}}}`, body.Data.Attributes.Key)
+		},
+	)
+
+	result := runCommand(t, client, "-W", "myorg/my-workspace", "tags", "--value", `{ team = "platform", }}`, "--hcl")
+
+	test.Buffer(t, result.ErrBuf, text.Heredoc(`
+		invalid HCL value at tags:1,23-24
+		Error: Extra characters after expression
+
+		  on tags line 1:
+		   1: { team = "platform", }}
+
+		An expression was successfully parsed, but extra characters were found after it.
+	`))
+	test.StringSlice(t, values, nil)
+}
+
+func runCommandWithStdin(t *testing.T, client *tfc.Client, stdin string, args ...string) *tfetest.CmdOut {
+	t.Helper()
+
+	ios, in, stdout, stderr := iolib.Test()
+	in.WriteString(stdin)
+
+	return execute(t, ios, stdout, stderr, client, args...)
+}
+
+func execute(
+	t *testing.T,
+	ios *iolib.IOStreams,
+	stdout, stderr *bytes.Buffer,
+	client *tfc.Client,
+	args ...string,
+) *tfetest.CmdOut {
+	t.Helper()
 
 	f := &cmdutil.Factory{
 		IOStreams:       ios,
 		TFEClient:       func() (*tfc.Client, error) { return client, nil },
 		TerraformConfig: func() *tfconfig.TerraformConfig { return nil },
+		Prompter:        func() *cmdutil.Prompter { return cmdutil.NewPrompter(ios) },
 	}
 
 	cmd := set.NewCmdSet(f)
 	cmd.SetArgs(args)
 
-	cmd.SetIn(&bytes.Buffer{})
 	cmd.SetOut(stdout)
 	cmd.SetErr(stderr)
 
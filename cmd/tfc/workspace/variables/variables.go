@@ -3,8 +3,13 @@ package variables
 import (
 	"github.com/spf13/cobra"
 
+	applyCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/variables/apply"
+	copyCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/variables/copyvars"
 	deleteCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/variables/delete"
+	diffCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/variables/diff"
 	editCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/variables/edit"
+	exportCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/variables/export"
+	importCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/variables/importvars"
 	listCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/variables/list"
 	setCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/variables/set"
 	"github.com/zkhvan/tfc/pkg/cmdutil"
@@ -25,6 +30,11 @@ func NewCmdVariables(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(setCmd.NewCmdSet(f))
 	cmd.AddCommand(deleteCmd.NewCmdDelete(f))
 	cmd.AddCommand(editCmd.NewCmdEdit(f))
+	cmd.AddCommand(importCmd.NewCmdImport(f))
+	cmd.AddCommand(exportCmd.NewCmdExport(f))
+	cmd.AddCommand(applyCmd.NewCmdApply(f))
+	cmd.AddCommand(diffCmd.NewCmdDiff(f))
+	cmd.AddCommand(copyCmd.NewCmdCopy(f))
 
 	return cmd
 }
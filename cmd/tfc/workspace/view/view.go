@@ -6,6 +6,7 @@ import (
 	"os"
 	"os/exec"
 	"runtime"
+	"sort"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/hashicorp/go-tfe"
@@ -111,10 +112,25 @@ func (opts *Options) Run(ctx context.Context) error {
 		return opts.openWorkspaceInBrowser(ctx, ws)
 	}
 
-	return opts.displayWorkspace(ws)
+	// The remote state consumers only apply without global remote state.
+	// Listing them needs more permissions than reading the workspace, so
+	// they're shown as unknown when they can't be listed.
+	var consumers []*tfc.Workspace
+	consumersKnown := true
+	if !ws.GlobalRemoteState {
+		consumers, err = client.Workspaces.ListRemoteStateConsumers(ctx, ws.ID)
+		if err != nil {
+			fmt.Fprintf(opts.IO.ErrOut, "Warning: failed to list remote state consumers for %s: %v\n",
+				opts.WorkspaceID.String(), err,
+			)
+			consumersKnown = false
+		}
+	}
+
+	return opts.displayWorkspace(ws, consumers, consumersKnown)
 }
 
-func (opts *Options) displayWorkspace(ws *tfc.Workspace) error {
+func (opts *Options) displayWorkspace(ws *tfc.Workspace, consumers []*tfc.Workspace, consumersKnown bool) error {
 	url := buildWorkspaceURL(ws.Organization.Name, ws.Name)
 	out := opts.IO.Out
 
@@ -154,10 +170,10 @@ func (opts *Options) displayWorkspace(ws *tfc.Workspace) error {
 
 	// Automation Section
 	fmt.Fprintf(out, "\n%s\n", headerStyle.Render("AUTOMATION"))
-	fmt.Fprintf(out, "  Auto Apply:           %s\n", formatBool(ws.AutoApply))
-	fmt.Fprintf(out, "  Auto Apply Triggers:  %s\n", formatBool(ws.AutoApplyRunTrigger))
-	fmt.Fprintf(out, "  Queue All Runs:       %s\n", formatBool(ws.QueueAllRuns))
-	fmt.Fprintf(out, "  Speculative Plans:    %s\n", formatBool(ws.SpeculativeEnabled))
+	fmt.Fprintf(out, "  Auto Apply:           %s\n", cmdutil.FormatBool(ws.AutoApply))
+	fmt.Fprintf(out, "  Auto Apply Triggers:  %s\n", cmdutil.FormatBool(ws.AutoApplyRunTrigger))
+	fmt.Fprintf(out, "  Queue All Runs:       %s\n", cmdutil.FormatBool(ws.QueueAllRuns))
+	fmt.Fprintf(out, "  Speculative Plans:    %s\n", cmdutil.FormatBool(ws.SpeculativeEnabled))
 
 	// VCS Connection Section (only if VCS is configured)
 	if ws.VCSRepo != nil {
@@ -174,7 +190,7 @@ func (opts *Options) displayWorkspace(ws *tfc.Workspace) error {
 			fmt.Fprintf(out, "  Provider:             %s\n", ws.VCSRepo.ServiceProvider)
 		}
 
-		fmt.Fprintf(out, "  File Triggers:        %s\n", formatBool(ws.FileTriggersEnabled))
+		fmt.Fprintf(out, "  File Triggers:        %s\n", cmdutil.FormatBool(ws.FileTriggersEnabled))
 
 		if len(ws.TriggerPrefixes) > 0 {
 			fmt.Fprintf(out, "  Trigger Prefixes:     %s\n", formatList(ws.TriggerPrefixes))
@@ -206,9 +222,7 @@ func (opts *Options) displayWorkspace(ws *tfc.Workspace) error {
 		fmt.Fprintf(out, "  Locked:               No\n")
 	}
 
-	if ws.GlobalRemoteState {
-		fmt.Fprintf(out, "  Global Remote State:  Yes\n")
-	}
+	fmt.Fprintf(out, "  Remote State:         %s\n", formatRemoteState(ws, consumers, consumersKnown))
 
 	// Current Run Section (only if there's a current run)
 	if ws.CurrentRun != nil {
@@ -240,12 +254,33 @@ func (opts *Options) displayWorkspace(ws *tfc.Workspace) error {
 	return nil
 }
 
-// formatBool formats a boolean value as a colored yes/no string
-func formatBool(v bool) string {
-	if v {
-		return lipgloss.NewStyle().Foreground(lipgloss.Color("2")).Render("Yes")
+// formatRemoteState formats which workspaces can read the state of a
+// workspace, unknown if its remote state consumers couldn't be listed.
+func formatRemoteState(ws *tfc.Workspace, consumers []*tfc.Workspace, consumersKnown bool) string {
+	if ws.GlobalRemoteState {
+		return "shared with the organization (global remote state)"
+	}
+
+	if !consumersKnown {
+		return "unknown"
+	}
+
+	if len(consumers) == 0 {
+		return "not shared"
+	}
+
+	const maxNames = 5
+
+	var names []string
+	for _, c := range consumers {
+		names = append(names, c.Name)
+	}
+	sort.Strings(names)
+
+	if len(names) > maxNames {
+		return fmt.Sprintf("shared with %s and %d more", formatList(names[:maxNames]), len(names)-maxNames)
 	}
-	return lipgloss.NewStyle().Foreground(lipgloss.Color("8")).Render("No")
+	return "shared with " + formatList(names)
 }
 
 // formatList formats a slice of strings as a comma-separated list
@@ -3,7 +3,15 @@ package workspace
 import (
 	"github.com/spf13/cobra"
 
+	accessCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/access"
+	cloneCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/clone"
 	listCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/list"
+	notificationsCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/notifications"
+	remotestateconsumersCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/remotestateconsumers"
+	resourcesCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/resources"
+	runtasksCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/runtasks"
+	runtriggersCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/runtriggers"
+	tagsCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/tags"
 	updatebranchCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/updatebranch"
 	variablesCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/variables"
 	viewCmd "github.com/zkhvan/tfc/cmd/tfc/workspace/view"
@@ -23,7 +31,15 @@ func NewCmdWorkspace(f *cmdutil.Factory) *cobra.Command {
 		`),
 	}
 
+	cmd.AddCommand(accessCmd.NewCmdAccess(f))
+	cmd.AddCommand(cloneCmd.NewCmdClone(f))
 	cmd.AddCommand(listCmd.NewCmdList(f))
+	cmd.AddCommand(notificationsCmd.NewCmdNotifications(f))
+	cmd.AddCommand(remotestateconsumersCmd.NewCmdRemoteStateConsumers(f))
+	cmd.AddCommand(resourcesCmd.NewCmdResources(f))
+	cmd.AddCommand(runtasksCmd.NewCmdRunTasks(f))
+	cmd.AddCommand(runtriggersCmd.NewCmdRunTriggers(f))
+	cmd.AddCommand(tagsCmd.NewCmdTags(f))
 	cmd.AddCommand(updatebranchCmd.NewCmdUpdateBranch(f))
 	cmd.AddCommand(variablesCmd.NewCmdVariables(f))
 	cmd.AddCommand(viewCmd.NewCmdView(f))
@@ -0,0 +1,74 @@
+package tfc
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-tfe"
+
+	"github.com/zkhvan/tfc/internal/tfc/tfepaging"
+)
+
+// AgentsService provides methods for working with the agents of an agent
+// pool.
+type AgentsService service
+
+type Agent = tfe.Agent
+
+// The statuses of an agent.
+const (
+	AgentIdle    = "idle"
+	AgentBusy    = "busy"
+	AgentUnknown = "unknown"
+	AgentErrored = "errored"
+	AgentExited  = "exited"
+)
+
+type AgentListOptions struct {
+	ListOptions
+}
+
+// List lists the agents of an agent pool.
+func (s *AgentsService) List(
+	ctx context.Context,
+	poolID string,
+	opts *AgentListOptions,
+) ([]*Agent, *Pagination, error) {
+	limit := opts.Limit
+	if limit == 0 {
+		limit = 20
+	}
+
+	f := func(lo tfe.ListOptions) ([]*Agent, *tfe.Pagination, error) {
+		result, err := s.tfe.Agents.List(ctx, poolID, &tfe.AgentListOptions{
+			ListOptions: lo,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return result.Items, result.Pagination, nil
+	}
+
+	current := Pagination{}
+	pager := tfepaging.New(f)
+
+	var agents []*Agent
+	for i, a := range pager.All() {
+		current.Pagination = *pager.Current()
+
+		if limit <= len(agents) {
+			if i < current.TotalCount {
+				current.ReachedLimit = true
+			}
+			break
+		}
+
+		agents = append(agents, a)
+	}
+
+	if err := pager.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return agents, &current, nil
+}
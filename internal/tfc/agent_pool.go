@@ -0,0 +1,131 @@
+package tfc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+
+	"github.com/zkhvan/tfc/internal/tfc/tfepaging"
+)
+
+// AgentPoolsService provides methods for working with the agent pools of an
+// organization.
+type AgentPoolsService service
+
+type AgentPool = tfe.AgentPool
+
+type AgentPoolListOptions struct {
+	ListOptions
+
+	// Optional: A query string to search agent pools by name.
+	Query string
+}
+
+// List lists the agent pools of an organization.
+func (s *AgentPoolsService) List(
+	ctx context.Context,
+	org string,
+	opts *AgentPoolListOptions,
+) ([]*AgentPool, *Pagination, error) {
+	o := tfe.AgentPoolListOptions{
+		Query: opts.Query,
+	}
+
+	limit := opts.Limit
+	if limit == 0 {
+		limit = 20
+	}
+
+	f := func(lo tfe.ListOptions) ([]*AgentPool, *tfe.Pagination, error) {
+		o.ListOptions = lo
+		result, err := s.tfe.AgentPools.List(ctx, org, &o)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return result.Items, result.Pagination, nil
+	}
+
+	current := Pagination{}
+	pager := tfepaging.New(f)
+
+	var pools []*AgentPool
+	for i, p := range pager.All() {
+		current.Pagination = *pager.Current()
+
+		if limit <= len(pools) {
+			if i < current.TotalCount {
+				current.ReachedLimit = true
+			}
+			break
+		}
+
+		pools = append(pools, p)
+	}
+
+	if err := pager.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return pools, &current, nil
+}
+
+// Read reads an agent pool by its ID.
+func (s *AgentPoolsService) Read(ctx context.Context, id string) (*AgentPool, error) {
+	return s.tfe.AgentPools.Read(ctx, id)
+}
+
+// ReadByName reads an agent pool of an organization by its exact name.
+func (s *AgentPoolsService) ReadByName(ctx context.Context, org, name string) (*AgentPool, error) {
+	pools, _, err := s.List(ctx, org, &AgentPoolListOptions{
+		ListOptions: ListOptions{Limit: 100},
+		Query:       name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range pools {
+		if p.Name == name {
+			return p, nil
+		}
+	}
+
+	return nil, fmt.Errorf("agent pool %q not found in organization %q", name, org)
+}
+
+// ReadByNameOrID reads an agent pool by its ID, or by its exact name within
+// an organization.
+func (s *AgentPoolsService) ReadByNameOrID(ctx context.Context, org, identifier string) (*AgentPool, error) {
+	if strings.HasPrefix(identifier, "apool-") {
+		return s.Read(ctx, identifier)
+	}
+
+	if org == "" {
+		return nil, fmt.Errorf("organization required to find agent pool %q by name", identifier)
+	}
+
+	return s.ReadByName(ctx, org, identifier)
+}
+
+// ReadWithWorkspaces reads an agent pool by its ID, including the
+// workspaces that use it.
+func (s *AgentPoolsService) ReadWithWorkspaces(ctx context.Context, id string) (*AgentPool, error) {
+	return s.tfe.AgentPools.ReadWithOptions(ctx, id, &tfe.AgentPoolReadOptions{
+		Include: []tfe.AgentPoolIncludeOpt{tfe.AgentPoolWorkspaces},
+	})
+}
+
+func (s *AgentPoolsService) Create(
+	ctx context.Context,
+	org string,
+	options tfe.AgentPoolCreateOptions,
+) (*AgentPool, error) {
+	return s.tfe.AgentPools.Create(ctx, org, options)
+}
+
+func (s *AgentPoolsService) Delete(ctx context.Context, id string) error {
+	return s.tfe.AgentPools.Delete(ctx, id)
+}
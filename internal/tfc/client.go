@@ -10,10 +10,27 @@ type Client struct {
 	// Re-use a common struct for each service.
 	common service
 
-	Organizations *OrganizationsService
-	Runs          *RunsService
-	Variables     *VariablesService
-	Workspaces    *WorkspacesService
+	AgentPools                 *AgentPoolsService
+	Agents                     *AgentsService
+	ConfigurationVersions      *ConfigurationVersionsService
+	NotificationConfigurations *NotificationConfigurationsService
+	Organizations              *OrganizationsService
+	PolicyChecks               *PolicyChecksService
+	PolicyEvaluations          *PolicyEvaluationsService
+	PolicySets                 *PolicySetsService
+	Projects                   *ProjectsService
+	RunTasks                   *RunTasksService
+	RunTriggers                *RunTriggersService
+	Runs                       *RunsService
+	StateVersions              *StateVersionsService
+	TaskStages                 *TaskStagesService
+	TeamAccess                 *TeamAccessService
+	Teams                      *TeamsService
+	Tokens                     *TokensService
+	VariableSets               *VariableSetsService
+	Variables                  *VariablesService
+	WorkspaceResources         *WorkspaceResourcesService
+	Workspaces                 *WorkspacesService
 }
 
 func NewClient(tfeClient *tfe.Client) *Client {
@@ -23,9 +40,26 @@ func NewClient(tfeClient *tfe.Client) *Client {
 	c.common.tfc = c
 	c.common.tfe = tfeClient
 
+	c.AgentPools = (*AgentPoolsService)(&c.common)
+	c.Agents = (*AgentsService)(&c.common)
+	c.ConfigurationVersions = (*ConfigurationVersionsService)(&c.common)
+	c.NotificationConfigurations = (*NotificationConfigurationsService)(&c.common)
 	c.Organizations = (*OrganizationsService)(&c.common)
+	c.PolicyChecks = (*PolicyChecksService)(&c.common)
+	c.PolicyEvaluations = (*PolicyEvaluationsService)(&c.common)
+	c.PolicySets = (*PolicySetsService)(&c.common)
+	c.Projects = (*ProjectsService)(&c.common)
+	c.RunTasks = (*RunTasksService)(&c.common)
+	c.RunTriggers = (*RunTriggersService)(&c.common)
 	c.Runs = (*RunsService)(&c.common)
+	c.StateVersions = (*StateVersionsService)(&c.common)
+	c.TaskStages = (*TaskStagesService)(&c.common)
+	c.TeamAccess = (*TeamAccessService)(&c.common)
+	c.Teams = (*TeamsService)(&c.common)
+	c.Tokens = (*TokensService)(&c.common)
+	c.VariableSets = (*VariableSetsService)(&c.common)
 	c.Variables = (*VariablesService)(&c.common)
+	c.WorkspaceResources = (*WorkspaceResourcesService)(&c.common)
 	c.Workspaces = (*WorkspacesService)(&c.common)
 
 	return c
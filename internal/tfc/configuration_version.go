@@ -0,0 +1,19 @@
+package tfc
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-tfe"
+)
+
+// ConfigurationVersionsService provides methods for working with the
+// configuration versions of workspaces.
+type ConfigurationVersionsService service
+
+type ConfigurationVersion = tfe.ConfigurationVersion
+
+// Download downloads the configuration files of a configuration version, as
+// a gzipped tarball. Only uploaded configuration versions can be downloaded.
+func (s *ConfigurationVersionsService) Download(ctx context.Context, id string) ([]byte, error) {
+	return s.tfe.ConfigurationVersions.Download(ctx, id)
+}
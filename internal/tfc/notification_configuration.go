@@ -0,0 +1,140 @@
+package tfc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+
+	"github.com/zkhvan/tfc/internal/tfc/tfepaging"
+)
+
+// NotificationConfigurationsService provides methods for working with the
+// notification configurations of workspaces.
+type NotificationConfigurationsService service
+
+type NotificationConfiguration = tfe.NotificationConfiguration
+
+// NotificationDestinationTypes are the destinations notifications can be
+// sent to.
+var NotificationDestinationTypes = []tfe.NotificationDestinationType{
+	tfe.NotificationDestinationTypeGeneric,
+	tfe.NotificationDestinationTypeSlack,
+	tfe.NotificationDestinationTypeMicrosoftTeams,
+	tfe.NotificationDestinationTypeEmail,
+}
+
+// NotificationTriggers are the events of a workspace that trigger
+// notifications.
+var NotificationTriggers = []tfe.NotificationTriggerType{
+	tfe.NotificationTriggerCreated,
+	tfe.NotificationTriggerPlanning,
+	tfe.NotificationTriggerNeedsAttention,
+	tfe.NotificationTriggerApplying,
+	tfe.NotificationTriggerCompleted,
+	tfe.NotificationTriggerErrored,
+	tfe.NotificationTriggerAssessmentDrifted,
+	tfe.NotificationTriggerAssessmentFailed,
+	tfe.NotificationTriggerAssessmentCheckFailed,
+	tfe.NotificationTriggerWorkspaceAutoDestroyReminder,
+	tfe.NotificationTriggerWorkspaceAutoDestroyRunResults,
+}
+
+// LastDeliveryResponse returns the latest response of the destination of a
+// notification configuration, or nil when nothing was delivered yet.
+func LastDeliveryResponse(nc *NotificationConfiguration) *tfe.DeliveryResponse {
+	var last *tfe.DeliveryResponse
+	for _, r := range nc.DeliveryResponses {
+		if last == nil || !r.SentAt.Before(last.SentAt) {
+			last = r
+		}
+	}
+	return last
+}
+
+// ListForWorkspace lists the notification configurations of a workspace.
+func (s *NotificationConfigurationsService) ListForWorkspace(
+	ctx context.Context,
+	workspaceID string,
+) ([]*NotificationConfiguration, error) {
+	f := func(lo tfe.ListOptions) ([]*NotificationConfiguration, *tfe.Pagination, error) {
+		result, err := s.tfe.NotificationConfigurations.List(ctx, workspaceID, &tfe.NotificationConfigurationListOptions{
+			ListOptions: lo,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return result.Items, result.Pagination, nil
+	}
+
+	pager := tfepaging.New(f)
+
+	var configs []*NotificationConfiguration
+	for _, nc := range pager.All() {
+		configs = append(configs, nc)
+	}
+
+	if err := pager.Err(); err != nil {
+		return nil, err
+	}
+
+	return configs, nil
+}
+
+// Read reads a notification configuration by its ID.
+func (s *NotificationConfigurationsService) Read(ctx context.Context, id string) (*NotificationConfiguration, error) {
+	return s.tfe.NotificationConfigurations.Read(ctx, id)
+}
+
+// ReadByNameOrID reads a notification configuration by its ID, or by its
+// exact name within a workspace.
+func (s *NotificationConfigurationsService) ReadByNameOrID(
+	ctx context.Context,
+	workspaceID, identifier string,
+) (*NotificationConfiguration, error) {
+	if strings.HasPrefix(identifier, "nc-") {
+		return s.Read(ctx, identifier)
+	}
+
+	configs, err := s.ListForWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, nc := range configs {
+		if nc.Name == identifier {
+			return nc, nil
+		}
+	}
+
+	return nil, fmt.Errorf("notification configuration %q not found", identifier)
+}
+
+func (s *NotificationConfigurationsService) Create(
+	ctx context.Context,
+	workspaceID string,
+	options tfe.NotificationConfigurationCreateOptions,
+) (*NotificationConfiguration, error) {
+	return s.tfe.NotificationConfigurations.Create(ctx, workspaceID, options)
+}
+
+func (s *NotificationConfigurationsService) Update(
+	ctx context.Context,
+	id string,
+	options tfe.NotificationConfigurationUpdateOptions,
+) (*NotificationConfiguration, error) {
+	return s.tfe.NotificationConfigurations.Update(ctx, id, options)
+}
+
+func (s *NotificationConfigurationsService) Delete(ctx context.Context, id string) error {
+	return s.tfe.NotificationConfigurations.Delete(ctx, id)
+}
+
+// Verify sends a verification notification to the destination of a
+// notification configuration. The response of the destination is the
+// last delivery response of the returned configuration.
+func (s *NotificationConfigurationsService) Verify(ctx context.Context, id string) (*NotificationConfiguration, error) {
+	return s.tfe.NotificationConfigurations.Verify(ctx, id)
+}
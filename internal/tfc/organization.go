@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/hashicorp/go-tfe"
 
@@ -25,6 +26,42 @@ func (s *OrganizationsService) Read(
 	return s.tfe.Organizations.Read(ctx, name)
 }
 
+// ReadMember reads a user of an organization by their ID, username or
+// email address.
+func (s *OrganizationsService) ReadMember(ctx context.Context, org, identifier string) (*tfe.User, error) {
+	if strings.HasPrefix(identifier, "user-") {
+		return &tfe.User{ID: identifier}, nil
+	}
+
+	result, err := s.tfe.OrganizationMemberships.List(ctx, org, &tfe.OrganizationMembershipListOptions{
+		ListOptions: tfe.ListOptions{PageSize: 100},
+		Include:     []tfe.OrgMembershipIncludeOpt{tfe.OrgMembershipUser},
+		Query:       identifier,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range result.Items {
+		if m.User == nil {
+			continue
+		}
+		if m.User.Username == identifier || strings.EqualFold(m.Email, identifier) {
+			return m.User, nil
+		}
+	}
+
+	return nil, fmt.Errorf("user %q not found in organization %q", identifier, org)
+}
+
+// ReadEntitlements reads the features an organization is entitled to.
+func (s *OrganizationsService) ReadEntitlements(
+	ctx context.Context,
+	name string,
+) (*tfe.Entitlements, error) {
+	return s.tfe.Organizations.ReadEntitlements(ctx, name)
+}
+
 type OrganizationListOptions struct {
 	ListOptions
 
@@ -0,0 +1,142 @@
+package tfc
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/hashicorp/go-tfe"
+
+	"github.com/zkhvan/tfc/internal/tfc/tfepaging"
+)
+
+// PolicyChecksService provides methods for working with the Sentinel
+// policy checks of runs, the policy checks of policy sets that don't use
+// the agent-based policy evaluations.
+type PolicyChecksService service
+
+type PolicyCheck = tfe.PolicyCheck
+
+// SentinelPolicy is the result of a Sentinel policy in a policy check.
+type SentinelPolicy struct {
+	PolicySet        string `json:"policy_set"`
+	Policy           string `json:"policy"`
+	EnforcementLevel string `json:"enforcement_level"`
+	Passed           bool   `json:"passed"`
+	Output           string `json:"output,omitempty"`
+	Error            string `json:"error,omitempty"`
+}
+
+// List lists the policy checks of a run.
+func (s *PolicyChecksService) List(ctx context.Context, runID string) ([]*PolicyCheck, error) {
+	f := func(lo tfe.ListOptions) ([]*PolicyCheck, *tfe.Pagination, error) {
+		result, err := s.tfe.PolicyChecks.List(ctx, runID, &tfe.PolicyCheckListOptions{
+			ListOptions: lo,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return result.Items, result.Pagination, nil
+	}
+
+	pager := tfepaging.New(f)
+
+	var checks []*PolicyCheck
+	for _, pc := range pager.All() {
+		checks = append(checks, pc)
+	}
+
+	if err := pager.Err(); err != nil {
+		return nil, err
+	}
+
+	return checks, nil
+}
+
+// Override overrides the soft-mandatory failures of a policy check.
+func (s *PolicyChecksService) Override(ctx context.Context, id string) (*PolicyCheck, error) {
+	return s.tfe.PolicyChecks.Override(ctx, id)
+}
+
+// sentinelResult is the part of the Sentinel JSON output of a policy check
+// that describes the result of each policy.
+type sentinelResult struct {
+	Data map[string]struct {
+		CanOverride bool `json:"can-override"`
+		Policies    []struct {
+			AllowedFailure   bool   `json:"allowed-failure"`
+			EnforcementLevel string `json:"enforcement-level"`
+			Error            any    `json:"error"`
+			Policy           string `json:"policy"`
+			Result           bool   `json:"result"`
+			Trace            struct {
+				Print string `json:"print"`
+			} `json:"trace"`
+		} `json:"policies"`
+	} `json:"data"`
+}
+
+// SentinelPolicies returns the result of each policy of a policy check,
+// sorted by policy set and policy.
+//
+// The Sentinel output doesn't always carry the enforcement level of a
+// policy. It's then derived from whether a failure of the policy is allowed
+// (advisory) and whether its policy set can be overridden (soft-mandatory).
+func SentinelPolicies(pc *PolicyCheck) ([]SentinelPolicy, error) {
+	if pc.Result == nil || pc.Result.Sentinel == nil {
+		return nil, nil
+	}
+
+	// The Sentinel output is decoded as a generic value, re-encode it to
+	// read it as a typed value.
+	raw, err := json.Marshal(pc.Result.Sentinel)
+	if err != nil {
+		return nil, err
+	}
+
+	var result sentinelResult
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, err
+	}
+
+	var policies []SentinelPolicy
+	for set, data := range result.Data {
+		for _, p := range data.Policies {
+			level := p.EnforcementLevel
+			switch {
+			case level != "":
+			case p.AllowedFailure:
+				level = string(tfe.EnforcementAdvisory)
+			case data.CanOverride:
+				level = string(tfe.EnforcementSoft)
+			default:
+				level = string(tfe.EnforcementHard)
+			}
+
+			policy := SentinelPolicy{
+				PolicySet:        set,
+				Policy:           p.Policy,
+				EnforcementLevel: level,
+				Passed:           p.Result,
+				Output:           p.Trace.Print,
+			}
+			if p.Error != nil {
+				if b, err := json.Marshal(p.Error); err == nil {
+					policy.Error = string(b)
+				}
+			}
+
+			policies = append(policies, policy)
+		}
+	}
+
+	sort.Slice(policies, func(i, j int) bool {
+		if policies[i].PolicySet != policies[j].PolicySet {
+			return policies[i].PolicySet < policies[j].PolicySet
+		}
+		return policies[i].Policy < policies[j].Policy
+	})
+
+	return policies, nil
+}
@@ -0,0 +1,105 @@
+package tfc
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/go-tfe"
+
+	"github.com/zkhvan/tfc/internal/tfc/tfepaging"
+)
+
+// PolicyEvaluationsService provides methods for working with the policy
+// evaluations of task stages, which evaluate the OPA and agent-based
+// Sentinel policy sets of a run.
+type PolicyEvaluationsService service
+
+type PolicyEvaluation = tfe.PolicyEvaluation
+
+// PolicySetOutcome is the result of the policies of a policy set in a
+// policy evaluation.
+type PolicySetOutcome struct {
+	ID                   string                `jsonapi:"primary,policy-set-outcomes"`
+	Outcomes             []PolicyOutcome       `jsonapi:"attr,outcomes"`
+	Error                string                `jsonapi:"attr,error"`
+	Overridable          *bool                 `jsonapi:"attr,overridable"`
+	PolicySetName        string                `jsonapi:"attr,policy-set-name"`
+	PolicySetDescription string                `jsonapi:"attr,policy-set-description"`
+	ResultCount          tfe.PolicyResultCount `jsonapi:"attr,result_count"`
+}
+
+// PolicyOutcome is the result of a policy. Unlike tfe.Outcome, it keeps the
+// output of the policy.
+type PolicyOutcome struct {
+	EnforcementLevel tfe.EnforcementLevel `jsonapi:"attr,enforcement_level"`
+	Query            string               `jsonapi:"attr,query"`
+	Status           string               `jsonapi:"attr,status"`
+	PolicyName       string               `jsonapi:"attr,policy_name"`
+	Description      string               `jsonapi:"attr,description"`
+	Output           any                  `jsonapi:"attr,output"`
+}
+
+type policySetOutcomeList struct {
+	*tfe.Pagination
+	Items []*PolicySetOutcome
+}
+
+// List lists the policy evaluations of a task stage.
+func (s *PolicyEvaluationsService) List(ctx context.Context, taskStageID string) ([]*PolicyEvaluation, error) {
+	f := func(lo tfe.ListOptions) ([]*PolicyEvaluation, *tfe.Pagination, error) {
+		result, err := s.tfe.PolicyEvaluations.List(ctx, taskStageID, &tfe.PolicyEvaluationListOptions{
+			ListOptions: lo,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return result.Items, result.Pagination, nil
+	}
+
+	pager := tfepaging.New(f)
+
+	var evaluations []*PolicyEvaluation
+	for _, pe := range pager.All() {
+		evaluations = append(evaluations, pe)
+	}
+
+	if err := pager.Err(); err != nil {
+		return nil, err
+	}
+
+	return evaluations, nil
+}
+
+// ListOutcomes lists the outcomes of the policy sets of a policy
+// evaluation.
+func (s *PolicyEvaluationsService) ListOutcomes(ctx context.Context, id string) ([]*PolicySetOutcome, error) {
+	f := func(lo tfe.ListOptions) ([]*PolicySetOutcome, *tfe.Pagination, error) {
+		u := fmt.Sprintf("policy-evaluations/%s/policy-set-outcomes", url.PathEscape(id))
+		req, err := s.tfe.NewRequest("GET", u, &lo)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var l policySetOutcomeList
+		if err := req.Do(ctx, &l); err != nil {
+			return nil, nil, err
+		}
+
+		return l.Items, l.Pagination, nil
+	}
+
+	pager := tfepaging.New(f)
+
+	var outcomes []*PolicySetOutcome
+	for _, o := range pager.All() {
+		outcomes = append(outcomes, o)
+	}
+
+	if err := pager.Err(); err != nil {
+		return nil, err
+	}
+
+	return outcomes, nil
+}
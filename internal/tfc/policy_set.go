@@ -0,0 +1,166 @@
+package tfc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+
+	"github.com/zkhvan/tfc/internal/tfc/tfepaging"
+)
+
+// PolicySetsService provides methods for working with the policy sets of an
+// organization.
+type PolicySetsService service
+
+type PolicySet = tfe.PolicySet
+
+type PolicySetListOptions struct {
+	ListOptions
+
+	// Optional: A search string to find policy sets by name.
+	Query string
+
+	// Optional: Only list the policy sets of a kind, sentinel or opa.
+	Kind tfe.PolicyKind
+}
+
+// List lists the policy sets of an organization.
+func (s *PolicySetsService) List(
+	ctx context.Context,
+	org string,
+	opts *PolicySetListOptions,
+) ([]*PolicySet, *Pagination, error) {
+	o := tfe.PolicySetListOptions{
+		Search: opts.Query,
+		Kind:   opts.Kind,
+	}
+
+	limit := opts.Limit
+	if limit == 0 {
+		limit = 20
+	}
+
+	f := func(lo tfe.ListOptions) ([]*PolicySet, *tfe.Pagination, error) {
+		o.ListOptions = lo
+		result, err := s.tfe.PolicySets.List(ctx, org, &o)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return result.Items, result.Pagination, nil
+	}
+
+	current := Pagination{}
+	pager := tfepaging.New(f)
+
+	var sets []*PolicySet
+	for i, ps := range pager.All() {
+		current.Pagination = *pager.Current()
+
+		if limit <= len(sets) {
+			if i < current.TotalCount {
+				current.ReachedLimit = true
+			}
+			break
+		}
+
+		sets = append(sets, ps)
+	}
+
+	if err := pager.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return sets, &current, nil
+}
+
+// Read reads a policy set by its ID, including the workspaces and projects
+// it's attached to.
+func (s *PolicySetsService) Read(ctx context.Context, id string) (*PolicySet, error) {
+	return s.tfe.PolicySets.ReadWithOptions(ctx, id, &tfe.PolicySetReadOptions{
+		Include: []tfe.PolicySetIncludeOpt{
+			tfe.PolicySetWorkspaces,
+			tfe.PolicySetProjects,
+			tfe.PolicySetWorkspaceExclusions,
+		},
+	})
+}
+
+// ReadByName reads a policy set of an organization by its exact name.
+func (s *PolicySetsService) ReadByName(ctx context.Context, org, name string) (*PolicySet, error) {
+	sets, _, err := s.List(ctx, org, &PolicySetListOptions{
+		ListOptions: ListOptions{Limit: 100},
+		Query:       name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ps := range sets {
+		if ps.Name == name {
+			return s.Read(ctx, ps.ID)
+		}
+	}
+
+	return nil, fmt.Errorf("policy set %q not found in organization %q", name, org)
+}
+
+// ReadByNameOrID reads a policy set by its ID, or by its exact name within
+// an organization.
+func (s *PolicySetsService) ReadByNameOrID(ctx context.Context, org, identifier string) (*PolicySet, error) {
+	if strings.HasPrefix(identifier, "polset-") {
+		return s.Read(ctx, identifier)
+	}
+
+	if org == "" {
+		return nil, fmt.Errorf("organization required to find policy set %q by name", identifier)
+	}
+
+	return s.ReadByName(ctx, org, identifier)
+}
+
+func (s *PolicySetsService) Create(
+	ctx context.Context,
+	org string,
+	options tfe.PolicySetCreateOptions,
+) (*PolicySet, error) {
+	return s.tfe.PolicySets.Create(ctx, org, options)
+}
+
+// AddWorkspaces attaches a policy set to workspaces by their IDs.
+func (s *PolicySetsService) AddWorkspaces(ctx context.Context, id string, workspaceIDs ...string) error {
+	o := tfe.PolicySetAddWorkspacesOptions{}
+	for _, wsID := range workspaceIDs {
+		o.Workspaces = append(o.Workspaces, &tfe.Workspace{ID: wsID})
+	}
+	return s.tfe.PolicySets.AddWorkspaces(ctx, id, o)
+}
+
+// RemoveWorkspaces detaches a policy set from workspaces by their IDs.
+func (s *PolicySetsService) RemoveWorkspaces(ctx context.Context, id string, workspaceIDs ...string) error {
+	o := tfe.PolicySetRemoveWorkspacesOptions{}
+	for _, wsID := range workspaceIDs {
+		o.Workspaces = append(o.Workspaces, &tfe.Workspace{ID: wsID})
+	}
+	return s.tfe.PolicySets.RemoveWorkspaces(ctx, id, o)
+}
+
+// AddProjects attaches a policy set to projects by their IDs.
+func (s *PolicySetsService) AddProjects(ctx context.Context, id string, projectIDs ...string) error {
+	o := tfe.PolicySetAddProjectsOptions{}
+	for _, prjID := range projectIDs {
+		o.Projects = append(o.Projects, &tfe.Project{ID: prjID})
+	}
+	return s.tfe.PolicySets.AddProjects(ctx, id, o)
+}
+
+// RemoveProjects detaches a policy set from projects by their IDs.
+func (s *PolicySetsService) RemoveProjects(ctx context.Context, id string, projectIDs ...string) error {
+	o := tfe.PolicySetRemoveProjectsOptions{}
+	for _, prjID := range projectIDs {
+		o.Projects = append(o.Projects, &tfe.Project{ID: prjID})
+	}
+	return s.tfe.PolicySets.RemoveProjects(ctx, id, o)
+}
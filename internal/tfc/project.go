@@ -0,0 +1,162 @@
+package tfc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+
+	"github.com/zkhvan/tfc/internal/tfc/tfepaging"
+)
+
+// ProjectsService provides methods for working with the projects of an
+// organization.
+type ProjectsService service
+
+type Project = tfe.Project
+
+type TeamProjectAccess = tfe.TeamProjectAccess
+
+type ProjectListOptions struct {
+	ListOptions
+
+	// Optional: A query string to search projects by name.
+	Query string
+}
+
+// List lists the projects of an organization.
+func (s *ProjectsService) List(
+	ctx context.Context,
+	org string,
+	opts *ProjectListOptions,
+) ([]*Project, *Pagination, error) {
+	o := tfe.ProjectListOptions{
+		Query: opts.Query,
+	}
+
+	limit := opts.Limit
+	if limit == 0 {
+		limit = 20
+	}
+
+	f := func(lo tfe.ListOptions) ([]*Project, *tfe.Pagination, error) {
+		o.ListOptions = lo
+		result, err := s.tfe.Projects.List(ctx, org, &o)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return result.Items, result.Pagination, nil
+	}
+
+	current := Pagination{}
+	pager := tfepaging.New(f)
+
+	var projects []*Project
+	for i, p := range pager.All() {
+		current.Pagination = *pager.Current()
+
+		if limit <= len(projects) {
+			if i < current.TotalCount {
+				current.ReachedLimit = true
+			}
+			break
+		}
+
+		projects = append(projects, p)
+	}
+
+	if err := pager.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return projects, &current, nil
+}
+
+// ReadByName reads a project of an organization by its exact name.
+func (s *ProjectsService) ReadByName(ctx context.Context, org, name string) (*Project, error) {
+	o := tfe.ProjectListOptions{
+		Name: name,
+	}
+
+	result, err := s.tfe.Projects.List(ctx, org, &o)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range result.Items {
+		if p.Name == name {
+			return p, nil
+		}
+	}
+
+	return nil, fmt.Errorf("project %q not found in organization %q", name, org)
+}
+
+// Read reads a project by its ID.
+func (s *ProjectsService) Read(ctx context.Context, id string) (*Project, error) {
+	return s.tfe.Projects.Read(ctx, id)
+}
+
+// ReadByNameOrID reads a project by its ID, or by its exact name within an
+// organization.
+func (s *ProjectsService) ReadByNameOrID(ctx context.Context, org, identifier string) (*Project, error) {
+	if strings.HasPrefix(identifier, "prj-") {
+		return s.Read(ctx, identifier)
+	}
+
+	if org == "" {
+		return nil, fmt.Errorf("organization required to find project %q by name", identifier)
+	}
+
+	return s.ReadByName(ctx, org, identifier)
+}
+
+func (s *ProjectsService) Create(
+	ctx context.Context,
+	org string,
+	options tfe.ProjectCreateOptions,
+) (*Project, error) {
+	return s.tfe.Projects.Create(ctx, org, options)
+}
+
+func (s *ProjectsService) Update(
+	ctx context.Context,
+	id string,
+	options tfe.ProjectUpdateOptions,
+) (*Project, error) {
+	return s.tfe.Projects.Update(ctx, id, options)
+}
+
+func (s *ProjectsService) Delete(ctx context.Context, id string) error {
+	return s.tfe.Projects.Delete(ctx, id)
+}
+
+// ListTeamAccess lists every team access of a project.
+func (s *ProjectsService) ListTeamAccess(ctx context.Context, id string) ([]*TeamProjectAccess, error) {
+	f := func(lo tfe.ListOptions) ([]*TeamProjectAccess, *tfe.Pagination, error) {
+		result, err := s.tfe.TeamProjectAccess.List(ctx, tfe.TeamProjectAccessListOptions{
+			ListOptions: lo,
+			ProjectID:   id,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return result.Items, result.Pagination, nil
+	}
+
+	pager := tfepaging.New(f)
+
+	var access []*TeamProjectAccess
+	for _, a := range pager.All() {
+		access = append(access, a)
+	}
+
+	if err := pager.Err(); err != nil {
+		return nil, err
+	}
+
+	return access, nil
+}
@@ -80,6 +80,12 @@ func RunStatusesInGroup(x RunStatusGroup) []RunStatus {
 	return statuses
 }
 
+// RunStatusGroupOf returns the group of a run status, or
+// RunStatusGroupUnknown for statuses that aren't grouped.
+func RunStatusGroupOf(status RunStatus) RunStatusGroup {
+	return runStatusGroups[status]
+}
+
 type RunGroup string
 
 const (
@@ -89,6 +95,7 @@ const (
 )
 
 type RunCreateOptions = tfe.RunCreateOptions
+type Plan = tfe.Plan
 
 type WorkspaceRunListOptions struct {
 	ListOptions tfe.ListOptions
@@ -103,6 +110,20 @@ func (s *RunsService) Create(ctx context.Context, options RunCreateOptions) (*Ru
 	return s.tfe.Runs.Create(ctx, options)
 }
 
+// Read reads a run by its ID, including its workspace, plan and the user
+// who created it.
+func (s *RunsService) Read(ctx context.Context, id string) (*Run, error) {
+	return s.tfe.Runs.ReadWithOptions(ctx, id, &tfe.RunReadOptions{
+		Include: []tfe.RunIncludeOpt{tfe.RunWorkspace, tfe.RunPlan, tfe.RunCreatedBy},
+	})
+}
+
+// AddComment adds a comment to a run.
+func (s *RunsService) AddComment(ctx context.Context, id, body string) error {
+	_, err := s.tfe.Comments.Create(ctx, id, tfe.CommentCreateOptions{Body: body})
+	return err
+}
+
 // List lists all runs for a given workspace.
 func (s *RunsService) List(
 	ctx context.Context, workspaceID string, options *WorkspaceRunListOptions,
@@ -0,0 +1,180 @@
+package tfc
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+
+	"github.com/zkhvan/tfc/internal/tfc/tfepaging"
+)
+
+// RunTasksService provides methods for working with the run tasks of an
+// organization and the workspaces they are attached to.
+type RunTasksService service
+
+type RunTask = tfe.RunTask
+type WorkspaceRunTask = tfe.WorkspaceRunTask
+
+type RunTaskListOptions struct {
+	ListOptions
+}
+
+// List lists the run tasks of an organization.
+func (s *RunTasksService) List(
+	ctx context.Context,
+	org string,
+	opts *RunTaskListOptions,
+) ([]*RunTask, *Pagination, error) {
+	limit := opts.Limit
+	if limit == 0 {
+		limit = 20
+	}
+
+	f := func(lo tfe.ListOptions) ([]*RunTask, *tfe.Pagination, error) {
+		result, err := s.tfe.RunTasks.List(ctx, org, &tfe.RunTaskListOptions{
+			ListOptions: lo,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return result.Items, result.Pagination, nil
+	}
+
+	current := Pagination{}
+	pager := tfepaging.New(f)
+
+	var tasks []*RunTask
+	for i, t := range pager.All() {
+		current.Pagination = *pager.Current()
+
+		if limit <= len(tasks) {
+			if i < current.TotalCount {
+				current.ReachedLimit = true
+			}
+			break
+		}
+
+		tasks = append(tasks, t)
+	}
+
+	if err := pager.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return tasks, &current, nil
+}
+
+// Read reads a run task by its ID.
+func (s *RunTasksService) Read(ctx context.Context, id string) (*RunTask, error) {
+	return s.tfe.RunTasks.Read(ctx, id)
+}
+
+// ReadByName reads a run task of an organization by its exact name.
+func (s *RunTasksService) ReadByName(ctx context.Context, org, name string) (*RunTask, error) {
+	// Run tasks can't be searched by name.
+	tasks, _, err := s.List(ctx, org, &RunTaskListOptions{
+		ListOptions: ListOptions{Limit: math.MaxInt},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range tasks {
+		if t.Name == name {
+			return t, nil
+		}
+	}
+
+	return nil, fmt.Errorf("run task %q not found in organization %q", name, org)
+}
+
+// ReadByNameOrID reads a run task by its ID, or by its exact name within an
+// organization.
+func (s *RunTasksService) ReadByNameOrID(ctx context.Context, org, identifier string) (*RunTask, error) {
+	if strings.HasPrefix(identifier, "task-") {
+		return s.Read(ctx, identifier)
+	}
+
+	if org == "" {
+		return nil, fmt.Errorf("organization required to find run task %q by name", identifier)
+	}
+
+	return s.ReadByName(ctx, org, identifier)
+}
+
+func (s *RunTasksService) Create(
+	ctx context.Context,
+	org string,
+	options tfe.RunTaskCreateOptions,
+) (*RunTask, error) {
+	return s.tfe.RunTasks.Create(ctx, org, options)
+}
+
+func (s *RunTasksService) Delete(ctx context.Context, id string) error {
+	return s.tfe.RunTasks.Delete(ctx, id)
+}
+
+// ListForWorkspace lists the run tasks attached to a workspace.
+func (s *RunTasksService) ListForWorkspace(ctx context.Context, workspaceID string) ([]*WorkspaceRunTask, error) {
+	f := func(lo tfe.ListOptions) ([]*WorkspaceRunTask, *tfe.Pagination, error) {
+		result, err := s.tfe.WorkspaceRunTasks.List(ctx, workspaceID, &tfe.WorkspaceRunTaskListOptions{
+			ListOptions: lo,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return result.Items, result.Pagination, nil
+	}
+
+	pager := tfepaging.New(f)
+
+	var tasks []*WorkspaceRunTask
+	for _, t := range pager.All() {
+		tasks = append(tasks, t)
+	}
+
+	if err := pager.Err(); err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+// Attach attaches a run task to a workspace, running it in the given
+// stages.
+func (s *RunTasksService) Attach(
+	ctx context.Context,
+	workspaceID, taskID string,
+	level tfe.TaskEnforcementLevel,
+	stages []tfe.Stage,
+) (*WorkspaceRunTask, error) {
+	return s.tfe.WorkspaceRunTasks.Create(ctx, workspaceID, tfe.WorkspaceRunTaskCreateOptions{
+		EnforcementLevel: level,
+		RunTask:          &tfe.RunTask{ID: taskID},
+		Stages:           &stages,
+	})
+}
+
+// UpdateAttachment updates the stages and enforcement level of a run task
+// attached to a workspace.
+func (s *RunTasksService) UpdateAttachment(
+	ctx context.Context,
+	workspaceID, workspaceTaskID string,
+	level tfe.TaskEnforcementLevel,
+	stages []tfe.Stage,
+) (*WorkspaceRunTask, error) {
+	return s.tfe.WorkspaceRunTasks.Update(ctx, workspaceID, workspaceTaskID, tfe.WorkspaceRunTaskUpdateOptions{
+		EnforcementLevel: level,
+		Stages:           &stages,
+	})
+}
+
+// Detach detaches a run task from a workspace.
+func (s *RunTasksService) Detach(ctx context.Context, workspaceID, workspaceTaskID string) error {
+	return s.tfe.WorkspaceRunTasks.Delete(ctx, workspaceID, workspaceTaskID)
+}
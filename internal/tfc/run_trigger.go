@@ -0,0 +1,72 @@
+package tfc
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-tfe"
+
+	"github.com/zkhvan/tfc/internal/tfc/tfepaging"
+)
+
+// RunTriggersService provides methods for working with the run triggers
+// between workspaces. A run trigger queues a run in its workspace after a
+// successful apply in its source workspace.
+type RunTriggersService service
+
+type RunTrigger = tfe.RunTrigger
+
+// ListInbound lists the run triggers of a workspace, whose source
+// workspaces queue runs in the workspace.
+func (s *RunTriggersService) ListInbound(ctx context.Context, workspaceID string) ([]*RunTrigger, error) {
+	return s.list(ctx, workspaceID, tfe.RunTriggerInbound)
+}
+
+// ListOutbound lists the run triggers whose source is a workspace, which
+// queue runs in other workspaces after the workspace applies.
+func (s *RunTriggersService) ListOutbound(ctx context.Context, workspaceID string) ([]*RunTrigger, error) {
+	return s.list(ctx, workspaceID, tfe.RunTriggerOutbound)
+}
+
+func (s *RunTriggersService) list(
+	ctx context.Context,
+	workspaceID string,
+	filter tfe.RunTriggerFilterOp,
+) ([]*RunTrigger, error) {
+	f := func(lo tfe.ListOptions) ([]*RunTrigger, *tfe.Pagination, error) {
+		result, err := s.tfe.RunTriggers.List(ctx, workspaceID, &tfe.RunTriggerListOptions{
+			ListOptions:    lo,
+			RunTriggerType: filter,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return result.Items, result.Pagination, nil
+	}
+
+	pager := tfepaging.New(f)
+
+	var triggers []*RunTrigger
+	for _, t := range pager.All() {
+		triggers = append(triggers, t)
+	}
+
+	if err := pager.Err(); err != nil {
+		return nil, err
+	}
+
+	return triggers, nil
+}
+
+// Create creates a run trigger that queues a run in a workspace after a
+// successful apply in the source workspace.
+func (s *RunTriggersService) Create(ctx context.Context, workspaceID, sourceWorkspaceID string) (*RunTrigger, error) {
+	return s.tfe.RunTriggers.Create(ctx, workspaceID, tfe.RunTriggerCreateOptions{
+		Sourceable: &tfe.Workspace{ID: sourceWorkspaceID},
+	})
+}
+
+// Delete deletes a run trigger by its ID.
+func (s *RunTriggersService) Delete(ctx context.Context, id string) error {
+	return s.tfe.RunTriggers.Delete(ctx, id)
+}
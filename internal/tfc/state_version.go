@@ -0,0 +1,228 @@
+package tfc
+
+import (
+	"context"
+	"crypto/md5" // #nosec G501 -- the API requires an MD5 checksum of the state
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+
+	"github.com/zkhvan/tfc/internal/tfc/tfepaging"
+)
+
+// StateVersionsService provides methods for working with a workspace's
+// state versions.
+type StateVersionsService service
+
+type StateVersion = tfe.StateVersion
+
+type StateVersionListOptions struct {
+	ListOptions
+
+	// Optional: A list of relations to include. See available resources:
+	// https://developer.hashicorp.com/terraform/cloud-docs/api-docs/state-versions#available-related-resources
+	Include []tfe.StateVersionIncludeOpt `url:"include,omitempty"`
+}
+
+// List lists the state versions of a workspace, newest first.
+func (s *StateVersionsService) List(
+	ctx context.Context,
+	org string,
+	workspace string,
+	opts *StateVersionListOptions,
+) ([]*StateVersion, *Pagination, error) {
+	// The upstream list options don't support includes, so the request is
+	// built by hand.
+	o := struct {
+		tfe.ListOptions
+		Organization string                       `url:"filter[organization][name]"`
+		Workspace    string                       `url:"filter[workspace][name]"`
+		Include      []tfe.StateVersionIncludeOpt `url:"include,omitempty"`
+	}{
+		Organization: org,
+		Workspace:    workspace,
+		Include:      opts.Include,
+	}
+
+	limit := opts.Limit
+	if limit == 0 {
+		limit = 20
+	}
+
+	f := func(lo tfe.ListOptions) ([]*StateVersion, *tfe.Pagination, error) {
+		o.ListOptions = lo
+
+		req, err := s.tfe.NewRequest("GET", "state-versions", &o)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var svl tfe.StateVersionList
+		if err := req.Do(ctx, &svl); err != nil {
+			return nil, nil, err
+		}
+
+		return svl.Items, svl.Pagination, nil
+	}
+
+	current := Pagination{}
+	pager := tfepaging.New(f)
+
+	var versions []*StateVersion
+	for i, sv := range pager.All() {
+		current.Pagination = *pager.Current()
+
+		if limit <= len(versions) {
+			if i < current.TotalCount {
+				current.ReachedLimit = true
+			}
+			break
+		}
+
+		versions = append(versions, sv)
+	}
+
+	if err := pager.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return versions, &current, nil
+}
+
+// Read reads a state version by its ID.
+func (s *StateVersionsService) Read(ctx context.Context, id string) (*StateVersion, error) {
+	return s.tfe.StateVersions.Read(ctx, id)
+}
+
+// ReadCurrent reads the current state version of a workspace.
+func (s *StateVersionsService) ReadCurrent(ctx context.Context, workspaceID string) (*StateVersion, error) {
+	return s.tfe.StateVersions.ReadCurrent(ctx, workspaceID)
+}
+
+// Resolve finds a state version of a workspace from a user supplied
+// reference. The reference may be a state version ID ("sv-..."), a serial
+// number, or empty for the current state version. A state version ID of
+// another workspace is an error.
+func (s *StateVersionsService) Resolve(
+	ctx context.Context,
+	org string,
+	ws *Workspace,
+	ref string,
+) (*StateVersion, error) {
+	if ref == "" {
+		return s.ReadCurrent(ctx, ws.ID)
+	}
+
+	if strings.HasPrefix(ref, "sv-") {
+		// State versions don't reference their workspace, so look for the
+		// ID in the state versions of the workspace.
+		sv, err := s.find(ctx, org, ws, func(sv *StateVersion) bool { return sv.ID == ref })
+		if err != nil {
+			return nil, err
+		}
+		if sv == nil {
+			return nil, fmt.Errorf("state version %s not found in workspace %s/%s", ref, org, ws.Name)
+		}
+		return sv, nil
+	}
+
+	serial, err := strconv.ParseInt(ref, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid state version %q: expected an ID or serial", ref)
+	}
+
+	sv, err := s.find(ctx, org, ws, func(sv *StateVersion) bool { return sv.Serial == serial })
+	if err != nil {
+		return nil, err
+	}
+	if sv == nil {
+		return nil, fmt.Errorf("state version with serial %d not found", serial)
+	}
+	return sv, nil
+}
+
+// find returns the first state version of a workspace that matches, or nil
+// if none does.
+func (s *StateVersionsService) find(
+	ctx context.Context,
+	org string,
+	ws *Workspace,
+	match func(*StateVersion) bool,
+) (*StateVersion, error) {
+	f := func(lo tfe.ListOptions) ([]*StateVersion, *tfe.Pagination, error) {
+		result, err := s.tfe.StateVersions.List(ctx, &tfe.StateVersionListOptions{
+			ListOptions:  lo,
+			Organization: org,
+			Workspace:    ws.Name,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return result.Items, result.Pagination, nil
+	}
+
+	pager := tfepaging.New(f).SetPageSize(100)
+	for _, sv := range pager.All() {
+		if match(sv) {
+			return sv, nil
+		}
+	}
+
+	if err := pager.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// Download downloads the raw state of a state version.
+func (s *StateVersionsService) Download(ctx context.Context, sv *StateVersion) ([]byte, error) {
+	if sv.DownloadURL == "" {
+		return nil, fmt.Errorf("state version %s has no downloadable state", sv.ID)
+	}
+
+	return s.tfe.StateVersions.Download(ctx, sv.DownloadURL)
+}
+
+// StateVersionUploadOptions represents the options for uploading a raw state
+// file as a new state version.
+type StateVersionUploadOptions struct {
+	// Required: The raw state file.
+	State []byte
+
+	// Required: The serial of the state. It must be greater than the serial
+	// of the workspace's current state version.
+	Serial int64
+
+	// Optional: The lineage of the state.
+	Lineage string
+}
+
+// Upload creates a new state version for a workspace from a raw state file.
+// The workspace must be locked by the caller.
+func (s *StateVersionsService) Upload(
+	ctx context.Context,
+	workspaceID string,
+	opts StateVersionUploadOptions,
+) (*StateVersion, error) {
+	sum := md5.Sum(opts.State) // #nosec G401 -- the API requires an MD5 checksum of the state
+	md5sum := hex.EncodeToString(sum[:])
+	state := base64.StdEncoding.EncodeToString(opts.State)
+
+	o := tfe.StateVersionCreateOptions{
+		MD5:    &md5sum,
+		Serial: &opts.Serial,
+		State:  &state,
+	}
+
+	if opts.Lineage != "" {
+		o.Lineage = &opts.Lineage
+	}
+
+	return s.tfe.StateVersions.Create(ctx, workspaceID, o)
+}
@@ -0,0 +1,61 @@
+package tfc
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-tfe"
+
+	"github.com/zkhvan/tfc/internal/tfc/tfepaging"
+)
+
+// TaskStagesService provides methods for working with the task stages of
+// runs, the points of a run where run tasks and policy evaluations run.
+type TaskStagesService service
+
+type TaskStage = tfe.TaskStage
+type TaskResult = tfe.TaskResult
+
+// List lists the task stages of a run.
+func (s *TaskStagesService) List(ctx context.Context, runID string) ([]*TaskStage, error) {
+	f := func(lo tfe.ListOptions) ([]*TaskStage, *tfe.Pagination, error) {
+		result, err := s.tfe.TaskStages.List(ctx, runID, &tfe.TaskStageListOptions{
+			ListOptions: lo,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return result.Items, result.Pagination, nil
+	}
+
+	pager := tfepaging.New(f)
+
+	var stages []*TaskStage
+	for _, ts := range pager.All() {
+		stages = append(stages, ts)
+	}
+
+	if err := pager.Err(); err != nil {
+		return nil, err
+	}
+
+	return stages, nil
+}
+
+// ReadWithResults reads a task stage by its ID, including the results of
+// its run tasks.
+func (s *TaskStagesService) ReadWithResults(ctx context.Context, id string) (*TaskStage, error) {
+	return s.tfe.TaskStages.Read(ctx, id, &tfe.TaskStageReadOptions{
+		Include: []tfe.TaskStageIncludeOpt{tfe.TaskStageTaskResults},
+	})
+}
+
+// Override overrides the failures of a task stage awaiting an override,
+// with a comment explaining why.
+func (s *TaskStagesService) Override(ctx context.Context, id, comment string) (*TaskStage, error) {
+	o := tfe.TaskStageOverrideOptions{}
+	if comment != "" {
+		o.Comment = &comment
+	}
+	return s.tfe.TaskStages.Override(ctx, id, o)
+}
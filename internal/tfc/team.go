@@ -0,0 +1,148 @@
+package tfc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+
+	"github.com/zkhvan/tfc/internal/tfc/tfepaging"
+)
+
+// TeamsService provides methods for working with the teams of an
+// organization.
+type TeamsService service
+
+type Team = tfe.Team
+
+type User = tfe.User
+
+type TeamListOptions struct {
+	ListOptions
+
+	// Optional: A query string to search teams by name.
+	Query string
+
+	// Optional: Related resources to include.
+	Include []tfe.TeamIncludeOpt
+}
+
+// List lists the teams of an organization.
+func (s *TeamsService) List(
+	ctx context.Context,
+	org string,
+	opts *TeamListOptions,
+) ([]*Team, *Pagination, error) {
+	o := tfe.TeamListOptions{
+		Query:   opts.Query,
+		Include: opts.Include,
+	}
+
+	limit := opts.Limit
+	if limit == 0 {
+		limit = 20
+	}
+
+	f := func(lo tfe.ListOptions) ([]*Team, *tfe.Pagination, error) {
+		o.ListOptions = lo
+		result, err := s.tfe.Teams.List(ctx, org, &o)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return result.Items, result.Pagination, nil
+	}
+
+	current := Pagination{}
+	pager := tfepaging.New(f)
+
+	var teams []*Team
+	for i, t := range pager.All() {
+		current.Pagination = *pager.Current()
+
+		if limit <= len(teams) {
+			if i < current.TotalCount {
+				current.ReachedLimit = true
+			}
+			break
+		}
+
+		teams = append(teams, t)
+	}
+
+	if err := pager.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return teams, &current, nil
+}
+
+// Read reads a team by its ID.
+func (s *TeamsService) Read(ctx context.Context, id string) (*Team, error) {
+	return s.tfe.Teams.Read(ctx, id)
+}
+
+// ReadByName reads a team of an organization by its exact name.
+func (s *TeamsService) ReadByName(ctx context.Context, org, name string) (*Team, error) {
+	teams, _, err := s.List(ctx, org, &TeamListOptions{
+		ListOptions: ListOptions{Limit: 100},
+		Query:       name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range teams {
+		if t.Name == name {
+			return t, nil
+		}
+	}
+
+	return nil, fmt.Errorf("team %q not found in organization %q", name, org)
+}
+
+// ReadByNameOrID reads a team by its ID, or by its exact name within an
+// organization.
+func (s *TeamsService) ReadByNameOrID(ctx context.Context, org, identifier string) (*Team, error) {
+	if strings.HasPrefix(identifier, "team-") {
+		return s.Read(ctx, identifier)
+	}
+
+	if org == "" {
+		return nil, fmt.Errorf("organization required to find team %q by name", identifier)
+	}
+
+	return s.ReadByName(ctx, org, identifier)
+}
+
+func (s *TeamsService) Create(
+	ctx context.Context,
+	org string,
+	options tfe.TeamCreateOptions,
+) (*Team, error) {
+	return s.tfe.Teams.Create(ctx, org, options)
+}
+
+func (s *TeamsService) Delete(ctx context.Context, id string) error {
+	return s.tfe.Teams.Delete(ctx, id)
+}
+
+// ListMembers lists the users of a team.
+func (s *TeamsService) ListMembers(ctx context.Context, id string) ([]*User, error) {
+	return s.tfe.TeamMembers.ListUsers(ctx, id)
+}
+
+// AddMembers adds users to a team by their usernames.
+func (s *TeamsService) AddMembers(ctx context.Context, id string, usernames ...string) error {
+	return s.tfe.TeamMembers.Add(ctx, id, tfe.TeamMemberAddOptions{
+		Usernames: usernames,
+	})
+}
+
+// RemoveMembers removes users from a team by their usernames.
+func (s *TeamsService) RemoveMembers(ctx context.Context, id string, usernames ...string) error {
+	return s.tfe.TeamMembers.Remove(ctx, id, tfe.TeamMemberRemoveOptions{
+		Usernames: usernames,
+	})
+}
@@ -0,0 +1,59 @@
+package tfc
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-tfe"
+
+	"github.com/zkhvan/tfc/internal/tfc/tfepaging"
+)
+
+// TeamAccessService provides methods for working with the access of teams
+// to workspaces.
+type TeamAccessService service
+
+type TeamAccess = tfe.TeamAccess
+
+// ListForWorkspace lists every team access of a workspace.
+func (s *TeamAccessService) ListForWorkspace(ctx context.Context, workspaceID string) ([]*TeamAccess, error) {
+	f := func(lo tfe.ListOptions) ([]*TeamAccess, *tfe.Pagination, error) {
+		result, err := s.tfe.TeamAccess.List(ctx, &tfe.TeamAccessListOptions{
+			ListOptions: lo,
+			WorkspaceID: workspaceID,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return result.Items, result.Pagination, nil
+	}
+
+	pager := tfepaging.New(f)
+
+	var access []*TeamAccess
+	for _, a := range pager.All() {
+		access = append(access, a)
+	}
+
+	if err := pager.Err(); err != nil {
+		return nil, err
+	}
+
+	return access, nil
+}
+
+func (s *TeamAccessService) Add(ctx context.Context, options tfe.TeamAccessAddOptions) (*TeamAccess, error) {
+	return s.tfe.TeamAccess.Add(ctx, options)
+}
+
+func (s *TeamAccessService) Update(
+	ctx context.Context,
+	id string,
+	options tfe.TeamAccessUpdateOptions,
+) (*TeamAccess, error) {
+	return s.tfe.TeamAccess.Update(ctx, id, options)
+}
+
+func (s *TeamAccessService) Remove(ctx context.Context, id string) error {
+	return s.tfe.TeamAccess.Remove(ctx, id)
+}
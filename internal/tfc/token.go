@@ -0,0 +1,237 @@
+package tfc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+
+	"github.com/zkhvan/tfc/internal/tfc/tfepaging"
+)
+
+// TokensService provides methods for working with the API tokens of users,
+// teams and organizations.
+type TokensService service
+
+// Token is an API token of a user, a team or an organization. The secret
+// value is only set on tokens that were just created.
+type Token struct {
+	ID          string    `json:"id"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	LastUsedAt  time.Time `json:"last_used_at"`
+	ExpiredAt   time.Time `json:"expired_at"`
+	Token       string    `json:"-"`
+}
+
+// TokenCreateOptions are the options to create a token.
+type TokenCreateOptions struct {
+	// Optional: A description of the token, not supported by organization
+	// tokens.
+	Description string
+
+	// Optional: The time the token expires, the token never expires when
+	// nil.
+	ExpiredAt *time.Time
+}
+
+// ParseTokenExpiry parses how long a token is valid for: a Go duration such
+// as 12h, or a number of days or weeks such as 30d or 2w.
+func ParseTokenExpiry(s string) (time.Duration, error) {
+	var d time.Duration
+	var err error
+
+	switch {
+	case strings.HasSuffix(s, "d"):
+		var n int
+		n, err = strconv.Atoi(strings.TrimSuffix(s, "d"))
+		d = time.Duration(n) * 24 * time.Hour
+	case strings.HasSuffix(s, "w"):
+		var n int
+		n, err = strconv.Atoi(strings.TrimSuffix(s, "w"))
+		d = time.Duration(n) * 7 * 24 * time.Hour
+	default:
+		d, err = time.ParseDuration(s)
+	}
+
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid expiry %q: use a duration such as 12h, 30d or 2w", s)
+	}
+
+	return d, nil
+}
+
+// ListForCurrentUser lists the tokens of the authenticated user.
+func (s *TokensService) ListForCurrentUser(ctx context.Context) ([]*Token, error) {
+	user, err := s.tfe.Users.ReadCurrent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.tfe.UserTokens.List(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]*Token, 0, len(result.Items))
+	for _, t := range result.Items {
+		tokens = append(tokens, fromUserToken(t))
+	}
+
+	return tokens, nil
+}
+
+// CreateForCurrentUser creates a token for the authenticated user.
+func (s *TokensService) CreateForCurrentUser(ctx context.Context, opts TokenCreateOptions) (*Token, error) {
+	user, err := s.tfe.Users.ReadCurrent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := s.tfe.UserTokens.Create(ctx, user.ID, tfe.UserTokenCreateOptions{
+		Description: opts.Description,
+		ExpiredAt:   opts.ExpiredAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return fromUserToken(t), nil
+}
+
+// DeleteForUser deletes a token of a user by its ID.
+func (s *TokensService) DeleteForUser(ctx context.Context, id string) error {
+	return s.tfe.UserTokens.Delete(ctx, id)
+}
+
+// ListForTeam lists the tokens of a team.
+func (s *TokensService) ListForTeam(ctx context.Context, org, teamID string) ([]*Token, error) {
+	f := func(lo tfe.ListOptions) ([]*tfe.TeamToken, *tfe.Pagination, error) {
+		result, err := s.tfe.TeamTokens.List(ctx, org, &tfe.TeamTokenListOptions{
+			ListOptions: lo,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return result.Items, result.Pagination, nil
+	}
+
+	pager := tfepaging.New(f)
+
+	var tokens []*Token
+	for _, t := range pager.All() {
+		if t.Team == nil || t.Team.ID != teamID {
+			continue
+		}
+		tokens = append(tokens, fromTeamToken(t))
+	}
+
+	if err := pager.Err(); err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// CreateForTeam creates a token for a team.
+func (s *TokensService) CreateForTeam(ctx context.Context, teamID string, opts TokenCreateOptions) (*Token, error) {
+	o := tfe.TeamTokenCreateOptions{
+		ExpiredAt: opts.ExpiredAt,
+	}
+	if opts.Description != "" {
+		o.Description = &opts.Description
+	}
+
+	t, err := s.tfe.TeamTokens.CreateWithOptions(ctx, teamID, o)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromTeamToken(t), nil
+}
+
+// DeleteForTeam deletes a token of a team by its ID.
+func (s *TokensService) DeleteForTeam(ctx context.Context, id string) error {
+	return s.tfe.TeamTokens.DeleteByID(ctx, id)
+}
+
+// ListForOrganization lists the token of an organization, an organization
+// has at most one token.
+func (s *TokensService) ListForOrganization(ctx context.Context, org string) ([]*Token, error) {
+	t, err := s.tfe.OrganizationTokens.Read(ctx, org)
+	if errors.Is(err, tfe.ErrResourceNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return []*Token{fromOrganizationToken(t)}, nil
+}
+
+// CreateForOrganization creates the token of an organization, replacing the
+// existing one.
+func (s *TokensService) CreateForOrganization(
+	ctx context.Context,
+	org string,
+	opts TokenCreateOptions,
+) (*Token, error) {
+	if opts.Description != "" {
+		return nil, errors.New("organization tokens don't have a description")
+	}
+
+	t, err := s.tfe.OrganizationTokens.CreateWithOptions(ctx, org, tfe.OrganizationTokenCreateOptions{
+		ExpiredAt: opts.ExpiredAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return fromOrganizationToken(t), nil
+}
+
+// DeleteForOrganization deletes the token of an organization.
+func (s *TokensService) DeleteForOrganization(ctx context.Context, org string) error {
+	return s.tfe.OrganizationTokens.Delete(ctx, org)
+}
+
+func fromUserToken(t *tfe.UserToken) *Token {
+	return &Token{
+		ID:          t.ID,
+		Description: t.Description,
+		CreatedAt:   t.CreatedAt,
+		LastUsedAt:  t.LastUsedAt,
+		ExpiredAt:   t.ExpiredAt,
+		Token:       t.Token,
+	}
+}
+
+func fromTeamToken(t *tfe.TeamToken) *Token {
+	token := &Token{
+		ID:         t.ID,
+		CreatedAt:  t.CreatedAt,
+		LastUsedAt: t.LastUsedAt,
+		ExpiredAt:  t.ExpiredAt,
+		Token:      t.Token,
+	}
+	if t.Description != nil {
+		token.Description = *t.Description
+	}
+	return token
+}
+
+func fromOrganizationToken(t *tfe.OrganizationToken) *Token {
+	return &Token{
+		ID:          t.ID,
+		Description: t.Description,
+		CreatedAt:   t.CreatedAt,
+		LastUsedAt:  t.LastUsedAt,
+		ExpiredAt:   t.ExpiredAt,
+		Token:       t.Token,
+	}
+}
@@ -2,6 +2,7 @@ package tfc
 
 import (
 	"context"
+	"math"
 
 	"github.com/hashicorp/go-tfe"
 
@@ -61,6 +62,14 @@ func (s *VariablesService) List(
 	return variables, &current, nil
 }
 
+// ListAll lists every variable of a workspace.
+func (s *VariablesService) ListAll(ctx context.Context, workspaceID string) ([]*Variable, error) {
+	vars, _, err := s.List(ctx, workspaceID, &VariableListOptions{
+		ListOptions: ListOptions{Limit: math.MaxInt},
+	})
+	return vars, err
+}
+
 func (s *VariablesService) Read(
 	ctx context.Context,
 	workspaceID string,
@@ -0,0 +1,281 @@
+package tfc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+
+	"github.com/zkhvan/tfc/internal/tfc/tfepaging"
+)
+
+// VariableSetsService provides methods for working with the variable sets of
+// an organization and their variables.
+type VariableSetsService service
+
+type VariableSet = tfe.VariableSet
+
+type VariableSetVariable = tfe.VariableSetVariable
+
+type VariableSetListOptions struct {
+	ListOptions
+
+	// Optional: A query string to search variable sets by name.
+	Query string
+
+	// Optional: Related resources to include.
+	Include []tfe.VariableSetIncludeOpt
+}
+
+// List lists the variable sets of an organization.
+func (s *VariableSetsService) List(
+	ctx context.Context,
+	org string,
+	opts *VariableSetListOptions,
+) ([]*VariableSet, *Pagination, error) {
+	return s.list(opts, func(o *tfe.VariableSetListOptions) (*tfe.VariableSetList, error) {
+		return s.tfe.VariableSets.List(ctx, org, o)
+	})
+}
+
+// ListForWorkspace lists the variable sets applied to a workspace, including
+// global ones and those applied through its project.
+func (s *VariableSetsService) ListForWorkspace(
+	ctx context.Context,
+	workspaceID string,
+	opts *VariableSetListOptions,
+) ([]*VariableSet, *Pagination, error) {
+	return s.list(opts, func(o *tfe.VariableSetListOptions) (*tfe.VariableSetList, error) {
+		return s.tfe.VariableSets.ListForWorkspace(ctx, workspaceID, o)
+	})
+}
+
+// ListForProject lists the variable sets applied to a project.
+func (s *VariableSetsService) ListForProject(
+	ctx context.Context,
+	projectID string,
+	opts *VariableSetListOptions,
+) ([]*VariableSet, *Pagination, error) {
+	return s.list(opts, func(o *tfe.VariableSetListOptions) (*tfe.VariableSetList, error) {
+		return s.tfe.VariableSets.ListForProject(ctx, projectID, o)
+	})
+}
+
+func (s *VariableSetsService) list(
+	opts *VariableSetListOptions,
+	fetch func(*tfe.VariableSetListOptions) (*tfe.VariableSetList, error),
+) ([]*VariableSet, *Pagination, error) {
+	o := tfe.VariableSetListOptions{
+		Query: opts.Query,
+	}
+
+	if len(opts.Include) > 0 {
+		include := make([]string, 0, len(opts.Include))
+		for _, i := range opts.Include {
+			include = append(include, string(i))
+		}
+		o.Include = strings.Join(include, ",")
+	}
+
+	limit := opts.Limit
+	if limit == 0 {
+		limit = 20
+	}
+
+	f := func(lo tfe.ListOptions) ([]*VariableSet, *tfe.Pagination, error) {
+		o.ListOptions = lo
+		result, err := fetch(&o)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return result.Items, result.Pagination, nil
+	}
+
+	current := Pagination{}
+	pager := tfepaging.New(f)
+
+	var sets []*VariableSet
+	for i, vs := range pager.All() {
+		current.Pagination = *pager.Current()
+
+		if limit <= len(sets) {
+			if i < current.TotalCount {
+				current.ReachedLimit = true
+			}
+			break
+		}
+
+		sets = append(sets, vs)
+	}
+
+	if err := pager.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return sets, &current, nil
+}
+
+// Read reads a variable set by its ID.
+func (s *VariableSetsService) Read(
+	ctx context.Context,
+	id string,
+	include ...tfe.VariableSetIncludeOpt,
+) (*VariableSet, error) {
+	o := &tfe.VariableSetReadOptions{}
+	if len(include) > 0 {
+		o.Include = &include
+	}
+
+	return s.tfe.VariableSets.Read(ctx, id, o)
+}
+
+// ReadByName reads a variable set of an organization by its exact name.
+func (s *VariableSetsService) ReadByName(
+	ctx context.Context,
+	org, name string,
+	include ...tfe.VariableSetIncludeOpt,
+) (*VariableSet, error) {
+	sets, _, err := s.List(ctx, org, &VariableSetListOptions{
+		ListOptions: ListOptions{Limit: 100},
+		Query:       name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, vs := range sets {
+		if vs.Name == name {
+			if len(include) == 0 {
+				return vs, nil
+			}
+			return s.Read(ctx, vs.ID, include...)
+		}
+	}
+
+	return nil, fmt.Errorf("variable set %q not found in organization %q", name, org)
+}
+
+// ReadByNameOrID reads a variable set by its ID, or by its exact name within
+// an organization.
+func (s *VariableSetsService) ReadByNameOrID(
+	ctx context.Context,
+	org, identifier string,
+	include ...tfe.VariableSetIncludeOpt,
+) (*VariableSet, error) {
+	if strings.HasPrefix(identifier, "varset-") {
+		return s.Read(ctx, identifier, include...)
+	}
+
+	if org == "" {
+		return nil, fmt.Errorf("organization required to find variable set %q by name", identifier)
+	}
+
+	return s.ReadByName(ctx, org, identifier, include...)
+}
+
+func (s *VariableSetsService) Create(
+	ctx context.Context,
+	org string,
+	options tfe.VariableSetCreateOptions,
+) (*VariableSet, error) {
+	return s.tfe.VariableSets.Create(ctx, org, &options)
+}
+
+func (s *VariableSetsService) Update(
+	ctx context.Context,
+	id string,
+	options tfe.VariableSetUpdateOptions,
+) (*VariableSet, error) {
+	return s.tfe.VariableSets.Update(ctx, id, &options)
+}
+
+func (s *VariableSetsService) Delete(ctx context.Context, id string) error {
+	return s.tfe.VariableSets.Delete(ctx, id)
+}
+
+// ApplyToWorkspaces attaches a variable set to workspaces.
+func (s *VariableSetsService) ApplyToWorkspaces(ctx context.Context, id string, workspaceIDs ...string) error {
+	o := tfe.VariableSetApplyToWorkspacesOptions{}
+	for _, wsID := range workspaceIDs {
+		o.Workspaces = append(o.Workspaces, &tfe.Workspace{ID: wsID})
+	}
+
+	return s.tfe.VariableSets.ApplyToWorkspaces(ctx, id, &o)
+}
+
+// RemoveFromWorkspaces detaches a variable set from workspaces.
+func (s *VariableSetsService) RemoveFromWorkspaces(ctx context.Context, id string, workspaceIDs ...string) error {
+	o := tfe.VariableSetRemoveFromWorkspacesOptions{}
+	for _, wsID := range workspaceIDs {
+		o.Workspaces = append(o.Workspaces, &tfe.Workspace{ID: wsID})
+	}
+
+	return s.tfe.VariableSets.RemoveFromWorkspaces(ctx, id, &o)
+}
+
+// ApplyToProjects attaches a variable set to projects.
+func (s *VariableSetsService) ApplyToProjects(ctx context.Context, id string, projectIDs ...string) error {
+	o := tfe.VariableSetApplyToProjectsOptions{}
+	for _, pID := range projectIDs {
+		o.Projects = append(o.Projects, &tfe.Project{ID: pID})
+	}
+
+	return s.tfe.VariableSets.ApplyToProjects(ctx, id, o)
+}
+
+// RemoveFromProjects detaches a variable set from projects.
+func (s *VariableSetsService) RemoveFromProjects(ctx context.Context, id string, projectIDs ...string) error {
+	o := tfe.VariableSetRemoveFromProjectsOptions{}
+	for _, pID := range projectIDs {
+		o.Projects = append(o.Projects, &tfe.Project{ID: pID})
+	}
+
+	return s.tfe.VariableSets.RemoveFromProjects(ctx, id, o)
+}
+
+// ListVariables lists every variable of a variable set.
+func (s *VariableSetsService) ListVariables(ctx context.Context, id string) ([]*VariableSetVariable, error) {
+	f := func(lo tfe.ListOptions) ([]*VariableSetVariable, *tfe.Pagination, error) {
+		result, err := s.tfe.VariableSetVariables.List(ctx, id, &tfe.VariableSetVariableListOptions{ListOptions: lo})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return result.Items, result.Pagination, nil
+	}
+
+	pager := tfepaging.New(f)
+
+	var vars []*VariableSetVariable
+	for _, v := range pager.All() {
+		vars = append(vars, v)
+	}
+
+	if err := pager.Err(); err != nil {
+		return nil, err
+	}
+
+	return vars, nil
+}
+
+func (s *VariableSetsService) CreateVariable(
+	ctx context.Context,
+	id string,
+	options tfe.VariableSetVariableCreateOptions,
+) (*VariableSetVariable, error) {
+	return s.tfe.VariableSetVariables.Create(ctx, id, &options)
+}
+
+func (s *VariableSetsService) UpdateVariable(
+	ctx context.Context,
+	id, variableID string,
+	options tfe.VariableSetVariableUpdateOptions,
+) (*VariableSetVariable, error) {
+	return s.tfe.VariableSetVariables.Update(ctx, id, variableID, &options)
+}
+
+func (s *VariableSetsService) DeleteVariable(ctx context.Context, id, variableID string) error {
+	return s.tfe.VariableSetVariables.Delete(ctx, id, variableID)
+}
@@ -74,6 +74,15 @@ func ParseOrgWorkspace(input string) OrgWorkspace {
 	}
 }
 
+// WorkspaceOrgWorkspace returns the org/workspace identifier of a workspace.
+func WorkspaceOrgWorkspace(ws *Workspace) OrgWorkspace {
+	ow := OrgWorkspace{Workspace: ws.Name}
+	if ws.Organization != nil {
+		ow.Org = ws.Organization.Name
+	}
+	return ow
+}
+
 type WorkspaceListOptions struct {
 	ListOptions
 
@@ -116,6 +125,9 @@ type WorkspaceListOptions struct {
 
 	// Optional: A list of VCS repositories to filter by (client-side).
 	VCSRepos []string
+
+	// Optional: A function to filter the results by (client-side).
+	Match func(*Workspace) bool
 }
 
 func (s *WorkspacesService) Read(
@@ -190,6 +202,10 @@ func (s *WorkspacesService) List(
 			}
 		}
 
+		if opts.Match != nil && !opts.Match(ws) {
+			continue
+		}
+
 		workspaces = append(workspaces, ws)
 	}
 
@@ -200,6 +216,15 @@ func (s *WorkspacesService) List(
 	return workspaces, &current, nil
 }
 
+// Create creates a workspace in an organization.
+func (s *WorkspacesService) Create(
+	ctx context.Context,
+	org string,
+	opts tfe.WorkspaceCreateOptions,
+) (*Workspace, error) {
+	return s.tfe.Workspaces.Create(ctx, org, opts)
+}
+
 func (s *WorkspacesService) Update(
 	ctx context.Context,
 	org string,
@@ -208,3 +233,80 @@ func (s *WorkspacesService) Update(
 ) (*Workspace, error) {
 	return s.tfe.Workspaces.Update(ctx, org, workspace, opts)
 }
+
+// Lock locks a workspace by its ID.
+func (s *WorkspacesService) Lock(
+	ctx context.Context,
+	workspaceID string,
+	reason string,
+) (*Workspace, error) {
+	return s.tfe.Workspaces.Lock(ctx, workspaceID, tfe.WorkspaceLockOptions{
+		Reason: &reason,
+	})
+}
+
+// Unlock unlocks a workspace by its ID.
+func (s *WorkspacesService) Unlock(ctx context.Context, workspaceID string) (*Workspace, error) {
+	return s.tfe.Workspaces.Unlock(ctx, workspaceID)
+}
+
+// ListRemoteStateConsumers lists the workspaces allowed to read the state of
+// a workspace. The list only applies when the workspace doesn't share its
+// state with the whole organization through global remote state.
+func (s *WorkspacesService) ListRemoteStateConsumers(ctx context.Context, workspaceID string) ([]*Workspace, error) {
+	f := func(lo tfe.ListOptions) ([]*Workspace, *tfe.Pagination, error) {
+		result, err := s.tfe.Workspaces.ListRemoteStateConsumers(ctx, workspaceID, &tfe.RemoteStateConsumersListOptions{
+			ListOptions: lo,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return result.Items, result.Pagination, nil
+	}
+
+	pager := tfepaging.New(f)
+
+	var consumers []*Workspace
+	for _, ws := range pager.All() {
+		consumers = append(consumers, ws)
+	}
+
+	if err := pager.Err(); err != nil {
+		return nil, err
+	}
+
+	return consumers, nil
+}
+
+// AddRemoteStateConsumers allows workspaces to read the state of a
+// workspace.
+func (s *WorkspacesService) AddRemoteStateConsumers(
+	ctx context.Context,
+	workspaceID string,
+	consumerIDs []string,
+) error {
+	return s.tfe.Workspaces.AddRemoteStateConsumers(ctx, workspaceID, tfe.WorkspaceAddRemoteStateConsumersOptions{
+		Workspaces: workspaceRefs(consumerIDs),
+	})
+}
+
+// RemoveRemoteStateConsumers stops allowing workspaces to read the state of
+// a workspace.
+func (s *WorkspacesService) RemoveRemoteStateConsumers(
+	ctx context.Context,
+	workspaceID string,
+	consumerIDs []string,
+) error {
+	return s.tfe.Workspaces.RemoveRemoteStateConsumers(ctx, workspaceID, tfe.WorkspaceRemoveRemoteStateConsumersOptions{
+		Workspaces: workspaceRefs(consumerIDs),
+	})
+}
+
+func workspaceRefs(ids []string) []*tfe.Workspace {
+	refs := make([]*tfe.Workspace, 0, len(ids))
+	for _, id := range ids {
+		refs = append(refs, &tfe.Workspace{ID: id})
+	}
+	return refs
+}
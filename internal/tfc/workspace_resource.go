@@ -0,0 +1,66 @@
+package tfc
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-tfe"
+
+	"github.com/zkhvan/tfc/internal/tfc/tfepaging"
+)
+
+// WorkspaceResourcesService provides methods for working with the resources
+// managed by a workspace.
+type WorkspaceResourcesService service
+
+type WorkspaceResource = tfe.WorkspaceResource
+
+type WorkspaceResourceListOptions struct {
+	ListOptions
+}
+
+// List lists the resources managed by a workspace.
+func (s *WorkspaceResourcesService) List(
+	ctx context.Context,
+	workspaceID string,
+	opts *WorkspaceResourceListOptions,
+) ([]*WorkspaceResource, *Pagination, error) {
+	o := tfe.WorkspaceResourceListOptions{}
+
+	limit := opts.Limit
+	if limit == 0 {
+		limit = 20
+	}
+
+	f := func(lo tfe.ListOptions) ([]*WorkspaceResource, *tfe.Pagination, error) {
+		o.ListOptions = lo
+		result, err := s.tfe.WorkspaceResources.List(ctx, workspaceID, &o)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return result.Items, result.Pagination, nil
+	}
+
+	current := Pagination{}
+	pager := tfepaging.New(f).SetPageSize(100)
+
+	var resources []*WorkspaceResource
+	for i, r := range pager.All() {
+		current.Pagination = *pager.Current()
+
+		if limit <= len(resources) {
+			if i < current.TotalCount {
+				current.ReachedLimit = true
+			}
+			break
+		}
+
+		resources = append(resources, r)
+	}
+
+	if err := pager.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return resources, &current, nil
+}
@@ -0,0 +1,210 @@
+package tfc
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+)
+
+type (
+	Tag                 = tfe.Tag
+	TagBinding          = tfe.TagBinding
+	EffectiveTagBinding = tfe.EffectiveTagBinding
+)
+
+// TagSet holds a mix of legacy tag names and key/value tag bindings.
+type TagSet struct {
+	Names    []string
+	Bindings []*TagBinding
+}
+
+// ParseTags parses tags given on the command line. Tags containing "=" are
+// key/value tag bindings, anything else is a legacy tag name.
+//
+// Examples:
+//   - "prod" -> legacy tag "prod"
+//   - "env=prod" -> tag binding {Key: "env", Value: "prod"}
+//   - "team=" -> tag binding {Key: "team", Value: ""}
+func ParseTags(args []string) (TagSet, error) {
+	var ts TagSet
+	for _, arg := range args {
+		key, value, isBinding := strings.Cut(arg, "=")
+		if key == "" {
+			return TagSet{}, fmt.Errorf("invalid tag %q: name cannot be empty", arg)
+		}
+
+		if isBinding {
+			ts.Bindings = append(ts.Bindings, &TagBinding{Key: key, Value: value})
+		} else {
+			ts.Names = append(ts.Names, key)
+		}
+	}
+	return ts, nil
+}
+
+// IsEmpty returns true if the set has neither tag names nor bindings.
+func (ts TagSet) IsEmpty() bool {
+	return len(ts.Names) == 0 && len(ts.Bindings) == 0
+}
+
+// String returns the tags in the same format accepted by ParseTags.
+func (ts TagSet) String() string {
+	parts := slices.Clone(ts.Names)
+	for _, b := range ts.Bindings {
+		parts = append(parts, fmt.Sprintf("%s=%s", b.Key, b.Value))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// InheritedFrom returns the ID of the resource an effective tag binding is
+// inherited from, or an empty string if it is bound to the workspace itself.
+func InheritedFrom(b *EffectiveTagBinding) string {
+	switch v := b.Links["inherited-from"].(type) {
+	case string:
+		return path.Base(v)
+	case map[string]any:
+		if href, ok := v["href"].(string); ok {
+			return path.Base(href)
+		}
+	}
+	return ""
+}
+
+// ListTagBindings lists the tag bindings bound directly to a workspace.
+func (s *WorkspacesService) ListTagBindings(ctx context.Context, workspaceID string) ([]*TagBinding, error) {
+	return s.tfe.Workspaces.ListTagBindings(ctx, workspaceID)
+}
+
+// ListEffectiveTagBindings lists the tag bindings of a workspace including
+// those inherited from its project.
+func (s *WorkspacesService) ListEffectiveTagBindings(
+	ctx context.Context,
+	workspaceID string,
+) ([]*EffectiveTagBinding, error) {
+	return s.tfe.Workspaces.ListEffectiveTagBindings(ctx, workspaceID)
+}
+
+// AddTags adds legacy tags and tag bindings to a workspace. Existing tag
+// bindings with the same key are updated with the new value.
+func (s *WorkspacesService) AddTags(ctx context.Context, workspaceID string, tags TagSet) error {
+	if len(tags.Names) > 0 {
+		err := s.tfe.Workspaces.AddTags(ctx, workspaceID, tfe.WorkspaceAddTagsOptions{
+			Tags: tagsFromNames(tags.Names),
+		})
+		if err != nil {
+			return fmt.Errorf("add tags: %w", err)
+		}
+	}
+
+	if len(tags.Bindings) > 0 {
+		_, err := s.tfe.Workspaces.AddTagBindings(ctx, workspaceID, tfe.WorkspaceAddTagBindingsOptions{
+			TagBindings: tags.Bindings,
+		})
+		if err != nil {
+			return fmt.Errorf("add tag bindings: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// RemoveTags removes legacy tags and tag bindings from a workspace. Tag
+// bindings are removed by key, regardless of their value. Inherited tag
+// bindings can only be removed from the project they're inherited from.
+func (s *WorkspacesService) RemoveTags(ctx context.Context, workspaceID string, tags TagSet) error {
+	if len(tags.Names) > 0 {
+		err := s.tfe.Workspaces.RemoveTags(ctx, workspaceID, tfe.WorkspaceRemoveTagsOptions{
+			Tags: tagsFromNames(tags.Names),
+		})
+		if err != nil {
+			return fmt.Errorf("remove tags: %w", err)
+		}
+	}
+
+	if len(tags.Bindings) > 0 {
+		current, err := s.ListTagBindings(ctx, workspaceID)
+		if err != nil {
+			return fmt.Errorf("list tag bindings: %w", err)
+		}
+
+		remaining := slices.DeleteFunc(current, func(b *TagBinding) bool {
+			return slices.ContainsFunc(tags.Bindings, func(r *TagBinding) bool {
+				return r.Key == b.Key
+			})
+		})
+
+		if err := s.replaceTagBindings(ctx, workspaceID, remaining); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetTags replaces the legacy tags and tag bindings of a workspace with the
+// given set. Inherited tag bindings are not affected.
+func (s *WorkspacesService) SetTags(ctx context.Context, ws *Workspace, tags TagSet) error {
+	var add, remove []string
+	for _, name := range tags.Names {
+		if !slices.Contains(ws.TagNames, name) {
+			add = append(add, name)
+		}
+	}
+	for _, name := range ws.TagNames {
+		if !slices.Contains(tags.Names, name) {
+			remove = append(remove, name)
+		}
+	}
+
+	if len(add) > 0 {
+		if err := s.AddTags(ctx, ws.ID, TagSet{Names: add}); err != nil {
+			return err
+		}
+	}
+
+	if len(remove) > 0 {
+		if err := s.RemoveTags(ctx, ws.ID, TagSet{Names: remove}); err != nil {
+			return err
+		}
+	}
+
+	return s.replaceTagBindings(ctx, ws.ID, tags.Bindings)
+}
+
+func (s *WorkspacesService) replaceTagBindings(ctx context.Context, workspaceID string, bindings []*TagBinding) error {
+	// An empty list of bindings is omitted from an update request, so
+	// clearing them needs a dedicated call.
+	if len(bindings) == 0 {
+		if err := s.tfe.Workspaces.DeleteAllTagBindings(ctx, workspaceID); err != nil {
+			return fmt.Errorf("delete tag bindings: %w", err)
+		}
+		return nil
+	}
+
+	// Only the key and value may be sent when replacing bindings.
+	bs := make([]*TagBinding, 0, len(bindings))
+	for _, b := range bindings {
+		bs = append(bs, &TagBinding{Key: b.Key, Value: b.Value})
+	}
+
+	_, err := s.tfe.Workspaces.UpdateByID(ctx, workspaceID, tfe.WorkspaceUpdateOptions{
+		TagBindings: bs,
+	})
+	if err != nil {
+		return fmt.Errorf("update tag bindings: %w", err)
+	}
+
+	return nil
+}
+
+func tagsFromNames(names []string) []*Tag {
+	tags := make([]*Tag, 0, len(names))
+	for _, name := range names {
+		tags = append(tags, &Tag{Name: name})
+	}
+	return tags
+}
@@ -327,3 +327,15 @@ func CompletionVariableNamesFromWorkspaceFlag(
 		return completions, cobra.ShellCompDirectiveNoFileComp
 	}
 }
+
+func FlagStringEnum(
+	cmd *cobra.Command,
+	p *string,
+	name string,
+	defaultValue string,
+	usage string,
+	options []string,
+) error {
+	cmd.Flags().StringVar(p, name, defaultValue, usage)
+	return cmd.RegisterFlagCompletionFunc(name, cobra.FixedCompletions(options, cobra.ShellCompDirectiveNoFileComp))
+}
@@ -0,0 +1,14 @@
+package cmdutil
+
+import "fmt"
+
+// ExitError is returned by commands that exit with the exit code of a child
+// process. The child process reports its own errors, so the error isn't
+// printed.
+type ExitError struct {
+	Code int
+}
+
+func (e *ExitError) Error() string {
+	return fmt.Sprintf("exit status %d", e.Code)
+}
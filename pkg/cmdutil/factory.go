@@ -14,6 +14,15 @@ type Factory struct {
 	Clock     *Clock
 
 	Editor          func() *Editor
+	Prompter        func() *Prompter
 	TFEClient       func() (*tfc.Client, error)
 	TerraformConfig func() *tfconfig.TerraformConfig
+
+	// TFEClientForHost returns a client for another Terraform Enterprise
+	// host, authenticated with the host's token from the credentials file.
+	TFEClientForHost func(hostname string) (*tfc.Client, error)
+
+	// TFEClientWithToken returns a client for the same host as TFEClient,
+	// authenticated with the given token instead.
+	TFEClientWithToken func(token string) (*tfc.Client, error)
 }
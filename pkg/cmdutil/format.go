@@ -0,0 +1,38 @@
+package cmdutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/pkg/iolib"
+)
+
+const (
+	FormatTable string = "table"
+	FormatJSON  string = "json"
+)
+
+// AddFormatFlag adds the --format flag to a command that can print either a
+// table or JSON.
+func AddFormatFlag(cmd *cobra.Command, p *string) {
+	_ = FlagStringEnum(cmd, p, "format", FormatTable, "Output format: table or json.", []string{FormatTable, FormatJSON})
+}
+
+// ValidateEnum returns an error if value is not one of the options.
+func ValidateEnum(name, value string, options []string) error {
+	if !slices.Contains(options, value) {
+		return fmt.Errorf("invalid %s %q: must be one of %s", name, value, strings.Join(options, ", "))
+	}
+	return nil
+}
+
+// PrintJSON writes v to the output stream as indented JSON.
+func PrintJSON(streams *iolib.IOStreams, v any) error {
+	enc := json.NewEncoder(streams.Out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
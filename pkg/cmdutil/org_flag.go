@@ -0,0 +1,32 @@
+package cmdutil
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+// ErrOrgRequired is returned by commands that need an organization when
+// neither --org nor state.tf provide one.
+var ErrOrgRequired = errors.New("organization required: use --org or ensure state.tf exists")
+
+// AddOrgFlag adds the -o/--org flag to a command that works on the resources
+// of an organization.
+func AddOrgFlag(cmd *cobra.Command, org *string) {
+	cmd.Flags().StringVarP(org, "org", "o", "", "Organization name")
+}
+
+// CompleteOrg applies the state.tf fallback to the organization when --org
+// isn't specified. This should be called in the command's Complete()
+// function.
+func CompleteOrg(org *string, terraformConfig func() *tfconfig.TerraformConfig) {
+	if *org != "" {
+		return
+	}
+
+	if cfg := terraformConfig(); cfg != nil && cfg.IsValid() {
+		*org = cfg.Organization
+	}
+}
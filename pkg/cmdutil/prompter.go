@@ -0,0 +1,90 @@
+package cmdutil
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/term"
+)
+
+// ErrNoPrompt is returned when input is needed but standard input isn't a
+// terminal.
+var ErrNoPrompt = errors.New("cannot prompt for input: standard input is not a terminal")
+
+// Prompter asks the user for input on the terminal. Prompts are written to
+// standard error so they don't mix with the command's output.
+type Prompter struct {
+	io     *iolib.IOStreams
+	reader *bufio.Reader
+}
+
+// NewPrompter creates a new Prompter instance that uses the provided IOStreams.
+func NewPrompter(io *iolib.IOStreams) *Prompter {
+	return &Prompter{io: io}
+}
+
+// CanPrompt returns true if the user can be prompted for input.
+func (p *Prompter) CanPrompt() bool {
+	return p.io.IsStdinTTY()
+}
+
+// Input prompts for a single line of input.
+func (p *Prompter) Input(prompt string) (string, error) {
+	if !p.CanPrompt() {
+		return "", ErrNoPrompt
+	}
+
+	fmt.Fprint(p.io.ErrOut, prompt)
+	return p.readLine()
+}
+
+// Secret prompts for a single line of input without echoing it back to the
+// terminal.
+func (p *Prompter) Secret(prompt string) (string, error) {
+	if !p.CanPrompt() {
+		return "", ErrNoPrompt
+	}
+
+	fmt.Fprint(p.io.ErrOut, prompt)
+
+	if f, ok := p.io.In.(*os.File); ok && term.IsTerminal(f.Fd()) {
+		b, err := term.ReadPassword(f.Fd())
+		fmt.Fprintln(p.io.ErrOut)
+		return string(b), err
+	}
+
+	return p.readLine()
+}
+
+// Confirm prompts for a yes/no answer, defaulting to no.
+func (p *Prompter) Confirm(prompt string) (bool, error) {
+	answer, err := p.Input(prompt + " [y/N] ")
+	if err != nil {
+		return false, err
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+func (p *Prompter) readLine() (string, error) {
+	if p.reader == nil {
+		p.reader = bufio.NewReader(p.io.In)
+	}
+
+	line, err := p.reader.ReadString('\n')
+	if err != nil && !(errors.Is(err, io.EOF) && line != "") {
+		return "", err
+	}
+
+	return strings.TrimRight(line, "\r\n"), nil
+}
@@ -0,0 +1,96 @@
+package cmdutil
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/pkg/iolib"
+)
+
+// ValueStdin is the --value that reads the value from standard input.
+const ValueStdin = "-"
+
+// ValueFlags are the flags giving the value of a variable: on the command
+// line, from standard input, from a file or from the output of a command.
+// Reading the value from somewhere else keeps secrets out of the shell
+// history and process listings.
+type ValueFlags struct {
+	Value   string
+	File    string
+	Command string
+}
+
+// AddValueFlags adds the --value, --value-file and --value-from-cmd flags,
+// and requires exactly one of them.
+func AddValueFlags(cmd *cobra.Command, v *ValueFlags) {
+	cmd.Flags().StringVarP(&v.Value, "value", "v", "", "Variable value, or - to read it from standard input")
+	cmd.Flags().StringVar(&v.File, "value-file", "", "Read the variable value from a file")
+	cmd.Flags().StringVar(&v.Command, "value-from-cmd", "", "Read the variable value from the output of a shell command")
+
+	cmd.MarkFlagsOneRequired("value", "value-file", "value-from-cmd")
+	cmd.MarkFlagsMutuallyExclusive("value", "value-file", "value-from-cmd")
+
+	_ = cmd.RegisterFlagCompletionFunc("value-file", cobra.FixedCompletions(nil, cobra.ShellCompDirectiveDefault))
+	_ = MarkFlagsWithNoFileCompletions(cmd, "value", "value-from-cmd")
+}
+
+// Read reads the value from the flag that was given. A single trailing
+// newline is removed from values read from standard input, files and
+// commands. When standard input is a terminal, the value is prompted for
+// without being echoed.
+func (v *ValueFlags) Read(
+	ctx context.Context,
+	ios *iolib.IOStreams,
+	prompter func() *Prompter,
+	key string,
+) (string, error) {
+	switch {
+	case v.File != "":
+		data, err := os.ReadFile(v.File)
+		if err != nil {
+			return "", fmt.Errorf("failed to read value: %w", err)
+		}
+		return trimNewline(string(data)), nil
+
+	case v.Command != "":
+		var stdout bytes.Buffer
+
+		// The command's standard input and error are the terminal's, so
+		// password managers can ask for confirmation.
+		cmd := exec.CommandContext(ctx, "sh", "-c", v.Command)
+		cmd.Stdin = ios.In
+		cmd.Stdout = &stdout
+		cmd.Stderr = ios.ErrOut
+
+		if err := cmd.Run(); err != nil {
+			return "", fmt.Errorf("command %q failed: %w", v.Command, err)
+		}
+		return trimNewline(stdout.String()), nil
+
+	case v.Value == ValueStdin:
+		if p := prompter(); p.CanPrompt() {
+			return p.Secret(fmt.Sprintf("Value for %s: ", key))
+		}
+
+		data, err := io.ReadAll(ios.In)
+		if err != nil {
+			return "", fmt.Errorf("failed to read value from standard input: %w", err)
+		}
+		return trimNewline(string(data)), nil
+
+	default:
+		return v.Value, nil
+	}
+}
+
+func trimNewline(s string) string {
+	s = strings.TrimSuffix(s, "\n")
+	return strings.TrimSuffix(s, "\r")
+}
@@ -0,0 +1,36 @@
+package cmdutil
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// FormatBool formats a boolean value as a colored yes/no string.
+func FormatBool(v bool) string {
+	if v {
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("2")).Render("Yes")
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color("8")).Render("No")
+}
+
+// WriteNames writes a sorted list of names, one per line, or (none).
+func WriteNames(out io.Writer, names []string) {
+	if len(names) == 0 {
+		fmt.Fprintf(out, "  %s\n", lipgloss.NewStyle().Faint(true).Render("(none)"))
+		return
+	}
+
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(out, "  %s\n", name)
+	}
+}
+
+// LabelPadding returns the spaces aligning the value of a "  label:" line of
+// a view with the other values.
+func LabelPadding(label string) string {
+	return fmt.Sprintf("%*s", 21-len(label), "")
+}
@@ -0,0 +1,328 @@
+package cmdutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/go-version"
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+)
+
+// WorkspaceFilter holds the flags used to select workspaces across one or
+// more organizations, as used by `tfc workspaces list`.
+type WorkspaceFilter struct {
+	Organization      string
+	OrganizationExact bool
+	Name              string
+	Tags              []string
+	ExcludeTags       []string
+	VCSRepos          []string
+	Project           string
+	TagBindings       []string
+	Wildcard          string
+
+	// Filter the results based on various groups of run statuses.
+	Pending bool
+	Errored bool
+	Running bool
+	Holding bool
+	Applied bool
+
+	// Filter the results client-side.
+	TerraformVersion string
+	ExecutionMode    string
+	Locked           bool
+	Unlocked         bool
+	AgentPool        string
+	NoRunInDays      int
+
+	// Clock is used to evaluate NoRunInDays, defaults to the real time.
+	Clock *Clock
+
+	versionConstraints version.Constraints
+	tagBindings        []*tfc.TagBinding
+}
+
+var ExecutionModes = []string{"remote", "local", "agent"}
+
+// AddWorkspaceFilterFlags adds the workspace filter flags to a command.
+func AddWorkspaceFilterFlags(cmd *cobra.Command, f *WorkspaceFilter) {
+	cmd.Flags().StringVarP(&f.Name, "name", "n", "", "Search by the workspace name.")
+	cmd.Flags().StringVarP(&f.Organization, "org", "o", "", "Search by the organization name.")
+	cmd.Flags().StringSliceVarP(&f.Tags, "tags", "t", []string{}, "Search by the tags.")
+	cmd.Flags().StringSliceVarP(&f.ExcludeTags, "exclude-tags", "T", []string{}, "Search by excluding the tags.")
+	cmd.Flags().StringSliceVarP(&f.VCSRepos, "vcs-repos", "r", []string{}, "Search by the VCS repository name.")
+	cmd.Flags().StringVar(&f.Project, "project", "", "Search by the project name or ID.")
+	cmd.Flags().StringArrayVar(&f.TagBindings, "tag", []string{},
+		"Search by a KEY=VALUE tag binding, or KEY for any value.",
+	)
+	cmd.Flags().StringVar(&f.Wildcard, "wildcard", "",
+		"Search by a workspace name pattern with * wildcards, e.g. '*-prod'.",
+	)
+
+	cmd.Flags().BoolVar(&f.Pending, "pending", false, "Search for workspaces with pending runs.")
+	cmd.Flags().BoolVar(&f.Errored, "errored", false, "Search for workspaces with errored runs.")
+	cmd.Flags().BoolVar(&f.Running, "running", false, "Search for workspaces with running runs.")
+	cmd.Flags().BoolVar(&f.Holding, "holding", false, "Search for workspaces with holding runs.")
+	cmd.Flags().BoolVar(&f.Applied, "applied", false, "Search for workspaces with applied runs.")
+
+	cmd.Flags().StringVar(&f.TerraformVersion, "terraform-version", "",
+		"Search by a Terraform version constraint, e.g. '< 1.6'.",
+	)
+	_ = FlagStringEnum(cmd, &f.ExecutionMode, "execution-mode", "", "Search by the execution mode.", ExecutionModes)
+	cmd.Flags().BoolVar(&f.Locked, "locked", false, "Search for locked workspaces.")
+	cmd.Flags().BoolVar(&f.Unlocked, "unlocked", false, "Search for unlocked workspaces.")
+	cmd.Flags().StringVar(&f.AgentPool, "agent-pool", "", "Search by the agent pool name or ID.")
+	cmd.Flags().IntVar(&f.NoRunInDays, "no-run-in-days", 0, "Search for workspaces without a run in the last N days.")
+
+	cmd.MarkFlagsMutuallyExclusive("locked", "unlocked")
+}
+
+// Complete normalizes the filter after the flags have been parsed.
+func (f *WorkspaceFilter) Complete() {
+	// Check if there's any wildcard characters
+	if strings.ContainsAny(f.Organization, "%*") {
+		f.Organization = strings.ReplaceAll(f.Organization, "*", "%")
+	}
+
+	if len(f.Organization) > 0 && !strings.Contains(f.Organization, "%") {
+		f.OrganizationExact = true
+	}
+}
+
+// Validate checks and parses the filter values that can't be checked by the
+// flag parser. It must be called before ListOptions or Match.
+func (f *WorkspaceFilter) Validate() error {
+	if f.ExecutionMode != "" {
+		if err := ValidateEnum("execution-mode", f.ExecutionMode, ExecutionModes); err != nil {
+			return err
+		}
+	}
+
+	if f.TerraformVersion != "" {
+		c, err := version.NewConstraint(f.TerraformVersion)
+		if err != nil {
+			return fmt.Errorf("invalid terraform version constraint %q: %w", f.TerraformVersion, err)
+		}
+		f.versionConstraints = c
+	}
+
+	if f.NoRunInDays < 0 {
+		return fmt.Errorf("invalid number of days %d: must not be negative", f.NoRunInDays)
+	}
+
+	f.tagBindings = nil
+	for _, tb := range f.TagBindings {
+		key, value, _ := strings.Cut(tb, "=")
+		if key == "" {
+			return fmt.Errorf("invalid tag binding %q: key cannot be empty", tb)
+		}
+		f.tagBindings = append(f.tagBindings, &tfc.TagBinding{Key: key, Value: value})
+	}
+
+	return nil
+}
+
+// ListOptions returns the workspace list options for the filter in an
+// organization, resolving project and agent pool names to their IDs.
+func (f *WorkspaceFilter) ListOptions(
+	ctx context.Context,
+	client *tfc.Client,
+	org string,
+	limit int,
+) (tfc.WorkspaceListOptions, error) {
+	o := tfc.WorkspaceListOptions{
+		ListOptions: tfc.ListOptions{
+			Limit: limit,
+		},
+		Search:           f.Name,
+		Tags:             strings.Join(f.Tags, ","),
+		ExcludeTags:      strings.Join(f.ExcludeTags, ","),
+		WildcardName:     f.Wildcard,
+		CurrentRunStatus: f.RunStatus(),
+		TagBindings:      f.tagBindings,
+		VCSRepos:         f.VCSRepos,
+	}
+
+	if f.Project != "" {
+		o.ProjectID = f.Project
+		if !strings.HasPrefix(f.Project, "prj-") {
+			p, err := client.Projects.ReadByName(ctx, org, f.Project)
+			if err != nil {
+				return o, err
+			}
+			o.ProjectID = p.ID
+		}
+	}
+
+	agentPoolID := f.AgentPool
+	if f.AgentPool != "" && !strings.HasPrefix(f.AgentPool, "apool-") {
+		p, err := client.AgentPools.ReadByName(ctx, org, f.AgentPool)
+		if err != nil {
+			return o, err
+		}
+		agentPoolID = p.ID
+	}
+
+	if f.NoRunInDays > 0 {
+		o.Include = append(o.Include, tfe.WSCurrentRun)
+	}
+
+	if f.hasClientSideFilters() {
+		o.Match = func(ws *tfc.Workspace) bool {
+			return f.match(ws, agentPoolID)
+		}
+	}
+
+	return o, nil
+}
+
+func (f *WorkspaceFilter) hasClientSideFilters() bool {
+	return f.versionConstraints != nil ||
+		f.ExecutionMode != "" ||
+		f.Locked ||
+		f.Unlocked ||
+		f.AgentPool != "" ||
+		f.NoRunInDays > 0
+}
+
+func (f *WorkspaceFilter) match(ws *tfc.Workspace, agentPoolID string) bool {
+	if f.versionConstraints != nil {
+		v, err := version.NewVersion(ws.TerraformVersion)
+		if err != nil || !f.versionConstraints.Check(v) {
+			return false
+		}
+	}
+
+	if f.ExecutionMode != "" && ws.ExecutionMode != f.ExecutionMode {
+		return false
+	}
+
+	if f.Locked && !ws.Locked {
+		return false
+	}
+
+	if f.Unlocked && ws.Locked {
+		return false
+	}
+
+	if agentPoolID != "" && (ws.AgentPool == nil || ws.AgentPool.ID != agentPoolID) {
+		return false
+	}
+
+	if f.NoRunInDays > 0 && ws.CurrentRun != nil {
+		now := time.Now()
+		if f.Clock != nil {
+			now = f.Clock.Now()
+		}
+
+		cutoff := now.AddDate(0, 0, -f.NoRunInDays)
+		if ws.CurrentRun.CreatedAt.After(cutoff) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// RunStatus returns the comma-separated run statuses selected by the run
+// status group flags.
+func (f *WorkspaceFilter) RunStatus() string {
+	var statuses []tfe.RunStatus
+
+	if f.Pending {
+		statuses = append(statuses, tfc.RunStatusesInGroup(tfc.RunStatusGroupPending)...)
+	}
+	if f.Errored {
+		statuses = append(statuses, tfc.RunStatusesInGroup(tfc.RunStatusGroupErrored)...)
+	}
+	if f.Running {
+		statuses = append(statuses, tfc.RunStatusesInGroup(tfc.RunStatusGroupRunning)...)
+	}
+	if f.Holding {
+		statuses = append(statuses, tfc.RunStatusesInGroup(tfc.RunStatusGroupHolding)...)
+	}
+	if f.Applied {
+		statuses = append(statuses, tfc.RunStatusesInGroup(tfc.RunStatusGroupApplied)...)
+	}
+
+	result := make([]string, 0, len(statuses))
+	for _, s := range statuses {
+		result = append(result, string(s))
+	}
+	return strings.Join(result, ",")
+}
+
+// Organizations returns the organizations matched by the filter that the user
+// has access to.
+func (f *WorkspaceFilter) Organizations(ctx context.Context, client *tfc.Client) ([]*tfe.Organization, error) {
+	if f.OrganizationExact {
+		org, err := client.Organizations.Read(ctx, f.Organization)
+		if err != nil {
+			return nil, fmt.Errorf("get organization: %w", err)
+		}
+
+		return []*tfe.Organization{org}, nil
+	}
+
+	orgs, _, err := client.Organizations.List(ctx, &tfc.OrganizationListOptions{
+		Query: f.Organization,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return orgs, nil
+}
+
+// Workspaces returns the workspaces matched by the filter across every
+// matching organization, with at most limit workspaces per organization.
+// The organizations with more matching workspaces than the limit are returned
+// as truncated. Organizations that fail to list are reported in the returned
+// error while the workspaces of the others are still returned.
+func (f *WorkspaceFilter) Workspaces(
+	ctx context.Context,
+	client *tfc.Client,
+	limit int,
+) (workspaces []*tfc.Workspace, truncated []string, err error) {
+	if err := f.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	orgs, err := f.Organizations(ctx, client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(orgs) == 0 {
+		return nil, nil, fmt.Errorf("no matching organizations")
+	}
+
+	var errs []error
+	for _, org := range orgs {
+		o, err := f.ListOptions(ctx, client, org.Name, limit)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error listing workspaces for %q: %w", org.Name, err))
+			continue
+		}
+
+		wss, paging, err := client.Workspaces.List(ctx, org.Name, &o)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error listing workspaces for %q: %w", org.Name, err))
+			continue
+		}
+
+		workspaces = append(workspaces, wss...)
+		if paging != nil && paging.ReachedLimit {
+			truncated = append(truncated, org.Name)
+		}
+	}
+
+	return workspaces, truncated, errors.Join(errs...)
+}
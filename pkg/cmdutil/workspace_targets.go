@@ -0,0 +1,205 @@
+package cmdutil
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/iolib"
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+// WorkspaceTargets selects the workspaces a command acts on: either a single
+// workspace given by the -W/--workspace flag (or state.tf), or with --bulk
+// every workspace matched by the workspace filter flags.
+type WorkspaceTargets struct {
+	WorkspaceID WorkspaceIdentifier
+	Bulk        bool
+	Filter      WorkspaceFilter
+	Limit       int
+	Yes         bool
+
+	// truncated are the organizations with more matching workspaces than
+	// the limit.
+	truncated []string
+}
+
+// AddWorkspaceTargetFlags adds the -W/--workspace flag, the --bulk and --yes
+// flags and the workspace filter flags to a command.
+func AddWorkspaceTargetFlags(
+	cmd *cobra.Command,
+	t *WorkspaceTargets,
+	tfeClient func() (*tfc.Client, error),
+) {
+	AddWorkspaceFlag(cmd, &t.WorkspaceID, tfeClient)
+	AddWorkspaceFilterFlags(cmd, &t.Filter)
+
+	cmd.Flags().BoolVar(&t.Bulk, "bulk", false, "Apply to every workspace matched by the filter flags.")
+	cmd.Flags().IntVarP(&t.Limit, "limit", "l", 100, "Limit the number of workspaces per organization with --bulk.")
+	cmd.Flags().BoolVarP(
+		&t.Yes,
+		"yes",
+		"y",
+		false,
+		"Change the workspaces matched by --bulk without asking for confirmation.",
+	)
+
+	cmd.MarkFlagsMutuallyExclusive("workspace", "bulk")
+}
+
+// Complete parses the -W flag, or normalizes the filter in bulk mode. This
+// should be called in the command's Complete() function.
+func (t *WorkspaceTargets) Complete(cmd *cobra.Command, terraformConfig func() *tfconfig.TerraformConfig) {
+	if t.Bulk {
+		t.Filter.Complete()
+		return
+	}
+
+	CompleteWorkspaceIdentifierSilent(cmd, &t.WorkspaceID, terraformConfig)
+}
+
+// Workspaces returns the targeted workspaces. In bulk mode, workspaces that
+// could be listed are returned alongside any error.
+func (t *WorkspaceTargets) Workspaces(ctx context.Context, client *tfc.Client) ([]*tfc.Workspace, error) {
+	if t.Bulk {
+		// Guard against accidentally changing every workspace the user has
+		// access to.
+		if t.Filter.Organization == "" {
+			return nil, fmt.Errorf("organization required: use --org with --bulk")
+		}
+
+		workspaces, truncated, err := t.Filter.Workspaces(ctx, client, t.Limit)
+		t.truncated = truncated
+		return workspaces, err
+	}
+
+	if err := t.WorkspaceID.Validate(); err != nil {
+		return nil, fmt.Errorf("workspace required: use -W ORG/WORKSPACE, --bulk or ensure state.tf exists")
+	}
+
+	ws, err := client.Workspaces.Read(ctx, t.WorkspaceID.Org, t.WorkspaceID.Workspace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read workspace %s: %w", t.WorkspaceID.String(), err)
+	}
+
+	return []*tfc.Workspace{ws}, nil
+}
+
+// Confirm lists the workspaces matched by --bulk and asks for confirmation,
+// unless --yes is given. It returns whether to go on with the change; a single
+// workspace given by -W/--workspace is always confirmed.
+func (t *WorkspaceTargets) Confirm(
+	io *iolib.IOStreams,
+	prompter func() *Prompter,
+	workspaces []*tfc.Workspace,
+	prompt string,
+) (bool, error) {
+	if !t.Bulk {
+		return true, nil
+	}
+
+	if len(t.truncated) > 0 {
+		fmt.Fprintf(
+			io.ErrOut,
+			"Warning: only the first %d matching workspaces of %s are included: use --limit to include more\n",
+			t.Limit, strings.Join(t.truncated, ", "),
+		)
+	}
+
+	if len(workspaces) == 0 {
+		fmt.Fprintln(io.Out, "No matching workspaces.")
+		return false, nil
+	}
+
+	fmt.Fprintln(io.Out, "Matching workspaces:")
+	for _, ws := range workspaces {
+		fmt.Fprintf(io.Out, "  %s\n", tfc.WorkspaceOrgWorkspace(ws).String())
+	}
+
+	if !t.Yes {
+		p := prompter()
+		if !p.CanPrompt() {
+			return false, fmt.Errorf(
+				"confirmation required: use --yes to change the workspaces matched by --bulk without prompting",
+			)
+		}
+
+		ok, err := p.Confirm(fmt.Sprintf("\n%s %d workspaces?", prompt, len(workspaces)))
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			fmt.Fprintln(io.Out, "Cancelled")
+			return false, nil
+		}
+	}
+
+	fmt.Fprintln(io.Out)
+	return true, nil
+}
+
+// AddWorkspaceProjectFlags adds the -w/--workspace and --project flags
+// selecting the workspaces and projects a set, such as a variable or policy
+// set, applies to, and requires at least one of them.
+func AddWorkspaceProjectFlags(cmd *cobra.Command, workspaces, projects *[]string) {
+	cmd.Flags().StringSliceVarP(workspaces, "workspace", "w", []string{}, "Workspace names")
+	cmd.Flags().StringSliceVar(projects, "project", []string{}, "Project names or IDs")
+
+	cmd.MarkFlagsOneRequired("workspace", "project")
+}
+
+// WorkspaceProjectTargets are the workspaces and projects selected by the
+// flags of AddWorkspaceProjectFlags.
+type WorkspaceProjectTargets struct {
+	WorkspaceIDs []string
+	ProjectIDs   []string
+
+	names []string
+}
+
+// String returns the workspaces and projects, for messages.
+func (t WorkspaceProjectTargets) String() string {
+	return strings.Join(t.names, ", ")
+}
+
+// ResolveWorkspaceProjectTargets resolves workspace names and project names
+// or IDs of an organization to their IDs.
+func ResolveWorkspaceProjectTargets(
+	ctx context.Context,
+	client *tfc.Client,
+	org string,
+	workspaces, projects []string,
+) (WorkspaceProjectTargets, error) {
+	var t WorkspaceProjectTargets
+
+	for _, name := range workspaces {
+		ws, err := client.Workspaces.Read(ctx, org, name)
+		if err != nil {
+			return t, fmt.Errorf("failed to read workspace %s/%s: %w", org, name, err)
+		}
+
+		t.WorkspaceIDs = append(t.WorkspaceIDs, ws.ID)
+		t.names = append(t.names, "workspace "+ws.Name)
+	}
+
+	for _, name := range projects {
+		if strings.HasPrefix(name, "prj-") {
+			t.ProjectIDs = append(t.ProjectIDs, name)
+			t.names = append(t.names, "project "+name)
+			continue
+		}
+
+		p, err := client.Projects.ReadByName(ctx, org, name)
+		if err != nil {
+			return t, fmt.Errorf("failed to read project %s: %w", name, err)
+		}
+
+		t.ProjectIDs = append(t.ProjectIDs, p.ID)
+		t.names = append(t.names, "project "+p.Name)
+	}
+
+	return t, nil
+}
@@ -71,3 +71,77 @@ func GetTokenForHost(hostname string) (string, error) {
 
 	return "", nil
 }
+
+// SetTokenForHost writes the token for a specific host to the credentials
+// file, keeping the credentials of other hosts and any other settings in the
+// file.
+func SetTokenForHost(hostname, token string) error {
+	path, err := GetTerraformCredentialsPath()
+	if err != nil {
+		return err
+	}
+
+	file := make(map[string]json.RawMessage)
+	data, err := os.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		return fmt.Errorf("error reading credentials file: %w", err)
+	default:
+		if err := json.Unmarshal(data, &file); err != nil {
+			return fmt.Errorf("error parsing credentials file: %w", err)
+		}
+	}
+
+	hosts := make(map[string]json.RawMessage)
+	if raw, ok := file["credentials"]; ok {
+		if err := json.Unmarshal(raw, &hosts); err != nil {
+			return fmt.Errorf("error parsing credentials file: %w", err)
+		}
+	}
+
+	hosts[hostname], err = json.Marshal(map[string]string{"token": token})
+	if err != nil {
+		return err
+	}
+
+	file["credentials"], err = json.Marshal(hosts)
+	if err != nil {
+		return err
+	}
+
+	data, err = json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("error creating credentials directory: %w", err)
+	}
+
+	// Write to a temporary file first so the credentials file is never left
+	// half written.
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".credentials.tfrc.json.*")
+	if err != nil {
+		return fmt.Errorf("error writing credentials file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return fmt.Errorf("error writing credentials file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("error writing credentials file: %w", err)
+	}
+
+	if err := os.Chmod(tmp.Name(), 0o600); err != nil {
+		return fmt.Errorf("error writing credentials file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("error writing credentials file: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package credentials_test
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/zkhvan/tfc/pkg/credentials"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+func TestSetTokenForHost(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the credentials file is in AppData on Windows")
+	}
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	path := filepath.Join(home, ".terraform.d", "credentials.tfrc.json")
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	err := os.WriteFile(path, []byte(`{
+		"credentials": {
+			"app.terraform.io": {"token": "old"},
+			"tfe.example.com": {"token": "other"}
+		},
+		"disable_checkpoint": true
+	}`), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := credentials.SetTokenForHost("app.terraform.io", "new"); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := text.Heredoc(`
+		{
+		  "credentials": {
+		    "app.terraform.io": {
+		      "token": "new"
+		    },
+		    "tfe.example.com": {
+		      "token": "other"
+		    }
+		  },
+		  "disable_checkpoint": true
+		}
+	`)
+	if diff := cmp.Diff(want, string(data)); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := info.Mode().Perm(); got != 0o600 {
+		t.Errorf("got file mode %o, want %o", got, 0o600)
+	}
+
+	token, err := credentials.GetTokenForHost("tfe.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "other" {
+		t.Errorf("got token %q for the other host, want %q", token, "other")
+	}
+}
+
+func TestSetTokenForHost_new_file(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the credentials file is in AppData on Windows")
+	}
+
+	t.Setenv("HOME", t.TempDir())
+
+	if err := credentials.SetTokenForHost("app.terraform.io", "new"); err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := credentials.GetTokenForHost("app.terraform.io")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "new" {
+		t.Errorf("got token %q, want %q", token, "new")
+	}
+}
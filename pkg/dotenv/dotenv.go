@@ -0,0 +1,82 @@
+// Package dotenv parses files of KEY=VALUE lines, as used by .env files.
+package dotenv
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Entry is a single KEY=VALUE line.
+type Entry struct {
+	Key   string
+	Value string
+}
+
+// Parse parses the entries of a .env file in the order they appear.
+//
+// Blank lines and lines starting with # are ignored, and an optional
+// "export " prefix is stripped. Values may be wrapped in double quotes, which
+// support Go escape sequences, or in single quotes, which are taken as-is.
+func Parse(data []byte) ([]Entry, error) {
+	var entries []Entry
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		line = strings.TrimPrefix(line, "export ")
+
+		key, value, ok := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("line %d: expected KEY=VALUE", n)
+		}
+
+		value, err := unquote(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n, err)
+		}
+
+		entries = append(entries, Entry{Key: key, Value: value})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// Map returns the entries as a map. Later entries override earlier ones.
+func Map(entries []Entry) map[string]string {
+	m := make(map[string]string, len(entries))
+	for _, e := range entries {
+		m[e.Key] = e.Value
+	}
+	return m
+}
+
+func unquote(value string) (string, error) {
+	switch {
+	case len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"':
+		v, err := strconv.Unquote(value)
+		if err != nil {
+			return "", fmt.Errorf("invalid quoted value %s", value)
+		}
+		return v, nil
+	case len(value) >= 2 && value[0] == '\'' && value[len(value)-1] == '\'':
+		return value[1 : len(value)-1], nil
+	default:
+		// Strip trailing comments from unquoted values.
+		if i := strings.Index(value, " #"); i != -1 {
+			value = strings.TrimSpace(value[:i])
+		}
+		return value, nil
+	}
+}
@@ -0,0 +1,45 @@
+package dotenv_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zkhvan/tfc/pkg/dotenv"
+	"github.com/zkhvan/tfc/pkg/text"
+)
+
+func TestParse(t *testing.T) {
+	data := text.Heredoc(`
+		# comment
+		AWS_REGION=us-east-1
+		export TOKEN="a\nb"
+
+		RAW='$HOME is "here"'
+		EMPTY=
+		TRAILING=value # comment
+	`)
+
+	got, err := dotenv.Parse([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []dotenv.Entry{
+		{Key: "AWS_REGION", Value: "us-east-1"},
+		{Key: "TOKEN", Value: "a\nb"},
+		{Key: "RAW", Value: `$HOME is "here"`},
+		{Key: "EMPTY", Value: ""},
+		{Key: "TRAILING", Value: "value"},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+}
+
+func TestParse_invalid_line(t *testing.T) {
+	_, err := dotenv.Parse([]byte("A=1\nnot a variable\n"))
+	if err == nil || err.Error() != "line 2: expected KEY=VALUE" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
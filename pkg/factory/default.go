@@ -38,7 +38,10 @@ func New(appVersion string) (*cmdutil.Factory, error) {
 
 	f.IOStreams = ioStreams(f)
 	f.Editor = editorFunc(f)
+	f.Prompter = prompterFunc(f)
 	f.TFEClient = tfeClientFunc(f)
+	f.TFEClientForHost = tfeClientForHostFunc(f)
+	f.TFEClientWithToken = tfeClientWithTokenFunc(f)
 	f.TerraformConfig = terraformConfigFunc(f)
 
 	return f, nil
@@ -54,6 +57,12 @@ func editorFunc(f *cmdutil.Factory) func() *cmdutil.Editor {
 	}
 }
 
+func prompterFunc(f *cmdutil.Factory) func() *cmdutil.Prompter {
+	return func() *cmdutil.Prompter {
+		return cmdutil.NewPrompter(f.IOStreams)
+	}
+}
+
 func tfeClientFunc(_ *cmdutil.Factory) func() (*tfc.Client, error) {
 	return func() (*tfc.Client, error) {
 		var cfg Config
@@ -77,15 +86,53 @@ func tfeClientFunc(_ *cmdutil.Factory) func() (*tfc.Client, error) {
 			return nil, fmt.Errorf("no tfe token found")
 		}
 
-		client, err := tfe.NewClient(tfeCfg)
+		return newClient(tfeCfg)
+	}
+}
+
+func tfeClientForHostFunc(_ *cmdutil.Factory) func(hostname string) (*tfc.Client, error) {
+	return func(hostname string) (*tfc.Client, error) {
+		token, err := credentials.GetTokenForHost(hostname)
 		if err != nil {
-			return nil, fmt.Errorf("error creating tfe client: %w", err)
+			return nil, fmt.Errorf("error getting token from credentials file: %w", err)
 		}
 
-		return tfc.NewClient(client), nil
+		if len(token) == 0 {
+			return nil, fmt.Errorf("no credentials found for %s: run `terraform login %s`", hostname, hostname)
+		}
+
+		tfeCfg := tfe.DefaultConfig()
+		tfeCfg.Address = fmt.Sprintf("https://%s", hostname)
+		tfeCfg.Token = token
+
+		return newClient(tfeCfg)
 	}
 }
 
+func tfeClientWithTokenFunc(_ *cmdutil.Factory) func(token string) (*tfc.Client, error) {
+	return func(token string) (*tfc.Client, error) {
+		var cfg Config
+		if err := envconfig.Process(context.Background(), &cfg); err != nil {
+			return nil, err
+		}
+
+		tfeCfg := tfe.DefaultConfig()
+		tfeCfg.Address = cfg.GetAddress()
+		tfeCfg.Token = token
+
+		return newClient(tfeCfg)
+	}
+}
+
+func newClient(cfg *tfe.Config) (*tfc.Client, error) {
+	client, err := tfe.NewClient(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error creating tfe client: %w", err)
+	}
+
+	return tfc.NewClient(client), nil
+}
+
 func terraformConfigFunc(_ *cmdutil.Factory) func() *tfconfig.TerraformConfig {
 	return func() *tfconfig.TerraformConfig {
 		cwd, err := os.Getwd()
@@ -0,0 +1,45 @@
+package graph
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// WriteDOT writes the graph in the Graphviz DOT language.
+func (g *Graph) WriteDOT(w io.Writer, name string) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "digraph %s {\n", strconv.Quote(name))
+	b.WriteString("  rankdir=LR;\n")
+	for _, n := range g.Nodes() {
+		fmt.Fprintf(&b, "  %s;\n", strconv.Quote(n))
+	}
+	for _, e := range g.Edges() {
+		fmt.Fprintf(&b, "  %s -> %s;\n", strconv.Quote(e.From), strconv.Quote(e.To))
+	}
+	b.WriteString("}\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteMermaid writes the graph as a Mermaid flowchart. Nodes get generated
+// IDs since Mermaid IDs can't contain every character of a name.
+func (g *Graph) WriteMermaid(w io.Writer) error {
+	var b strings.Builder
+
+	ids := map[string]string{}
+	b.WriteString("flowchart LR\n")
+	for i, n := range g.Nodes() {
+		ids[n] = fmt.Sprintf("n%d", i)
+		fmt.Fprintf(&b, "  %s[\"%s\"]\n", ids[n], strings.ReplaceAll(n, `"`, "#quot;"))
+	}
+	for _, e := range g.Edges() {
+		fmt.Fprintf(&b, "  %s --> %s\n", ids[e.From], ids[e.To])
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
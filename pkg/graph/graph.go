@@ -0,0 +1,252 @@
+// Package graph provides a directed graph of named nodes, used to analyze
+// the dependencies between workspaces.
+package graph
+
+import (
+	"errors"
+	"slices"
+	"sort"
+)
+
+// ErrCycle is returned when a graph with cycles has no topological order.
+var ErrCycle = errors.New("graph has cycles")
+
+// Edge is a directed edge between two nodes.
+type Edge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// Graph is a directed graph. The zero value is not usable, use New.
+type Graph struct {
+	nodes map[string]struct{}
+	out   map[string]map[string]struct{}
+	in    map[string]map[string]struct{}
+}
+
+// New returns an empty graph.
+func New() *Graph {
+	return &Graph{
+		nodes: map[string]struct{}{},
+		out:   map[string]map[string]struct{}{},
+		in:    map[string]map[string]struct{}{},
+	}
+}
+
+// AddNode adds a node, if it's not in the graph yet.
+func (g *Graph) AddNode(n string) {
+	g.nodes[n] = struct{}{}
+}
+
+// AddEdge adds an edge, adding its nodes if they're not in the graph yet.
+func (g *Graph) AddEdge(from, to string) {
+	g.AddNode(from)
+	g.AddNode(to)
+
+	if g.out[from] == nil {
+		g.out[from] = map[string]struct{}{}
+	}
+	g.out[from][to] = struct{}{}
+
+	if g.in[to] == nil {
+		g.in[to] = map[string]struct{}{}
+	}
+	g.in[to][from] = struct{}{}
+}
+
+// HasNode reports whether a node is in the graph.
+func (g *Graph) HasNode(n string) bool {
+	_, ok := g.nodes[n]
+	return ok
+}
+
+// Nodes returns the sorted nodes of the graph.
+func (g *Graph) Nodes() []string {
+	return sortedKeys(g.nodes)
+}
+
+// Edges returns the edges of the graph, sorted by source then target.
+func (g *Graph) Edges() []Edge {
+	var edges []Edge
+	for _, from := range sortedKeys(g.out) {
+		for _, to := range sortedKeys(g.out[from]) {
+			edges = append(edges, Edge{From: from, To: to})
+		}
+	}
+	return edges
+}
+
+// Successors returns the sorted targets of the edges from a node.
+func (g *Graph) Successors(n string) []string {
+	return sortedKeys(g.out[n])
+}
+
+// Predecessors returns the sorted sources of the edges to a node.
+func (g *Graph) Predecessors(n string) []string {
+	return sortedKeys(g.in[n])
+}
+
+// Isolated returns the sorted nodes without any edge.
+func (g *Graph) Isolated() []string {
+	var nodes []string
+	for _, n := range g.Nodes() {
+		if len(g.out[n]) == 0 && len(g.in[n]) == 0 {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes
+}
+
+// Descendants returns the sorted nodes reachable from a node, excluding the
+// node itself unless it's part of a cycle.
+func (g *Graph) Descendants(n string) []string {
+	seen := map[string]struct{}{}
+	stack := g.Successors(n)
+	for len(stack) > 0 {
+		m := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if _, ok := seen[m]; ok {
+			continue
+		}
+		seen[m] = struct{}{}
+		stack = append(stack, g.Successors(m)...)
+	}
+	return sortedKeys(seen)
+}
+
+// Subgraph returns the graph of the given nodes and the edges between them.
+func (g *Graph) Subgraph(nodes []string) *Graph {
+	sub := New()
+	for _, n := range nodes {
+		if g.HasNode(n) {
+			sub.AddNode(n)
+		}
+	}
+	for _, e := range g.Edges() {
+		if sub.HasNode(e.From) && sub.HasNode(e.To) {
+			sub.AddEdge(e.From, e.To)
+		}
+	}
+	return sub
+}
+
+// Connected returns the graph without its isolated nodes.
+func (g *Graph) Connected() *Graph {
+	var nodes []string
+	for _, n := range g.Nodes() {
+		if len(g.out[n]) > 0 || len(g.in[n]) > 0 {
+			nodes = append(nodes, n)
+		}
+	}
+	return g.Subgraph(nodes)
+}
+
+// Cycles returns the cycles of the graph, as the sorted nodes of each
+// strongly connected component with more than one node or a node with an
+// edge to itself. The cycles are sorted by their first node.
+func (g *Graph) Cycles() [][]string {
+	// Tarjan's strongly connected components algorithm.
+	var (
+		index   = map[string]int{}
+		lowlink = map[string]int{}
+		onStack = map[string]bool{}
+		stack   []string
+		next    int
+		cycles  [][]string
+	)
+
+	var connect func(n string)
+	connect = func(n string) {
+		index[n] = next
+		lowlink[n] = next
+		next++
+		stack = append(stack, n)
+		onStack[n] = true
+
+		for _, m := range g.Successors(n) {
+			if _, ok := index[m]; !ok {
+				connect(m)
+				lowlink[n] = min(lowlink[n], lowlink[m])
+			} else if onStack[m] {
+				lowlink[n] = min(lowlink[n], index[m])
+			}
+		}
+
+		if lowlink[n] != index[n] {
+			return
+		}
+
+		var component []string
+		for {
+			m := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[m] = false
+			component = append(component, m)
+			if m == n {
+				break
+			}
+		}
+
+		_, selfLoop := g.out[n][n]
+		if len(component) > 1 || selfLoop {
+			sort.Strings(component)
+			cycles = append(cycles, component)
+		}
+	}
+
+	for _, n := range g.Nodes() {
+		if _, ok := index[n]; !ok {
+			connect(n)
+		}
+	}
+
+	sort.Slice(cycles, func(i, j int) bool { return cycles[i][0] < cycles[j][0] })
+
+	return cycles
+}
+
+// TopologicalOrder returns the nodes ordered so that every node comes after
+// the sources of its edges, ties broken by name. It returns ErrCycle if the
+// graph has cycles.
+func (g *Graph) TopologicalOrder() ([]string, error) {
+	// Kahn's algorithm, with the ready nodes kept sorted.
+	degree := map[string]int{}
+	var ready []string
+	for _, n := range g.Nodes() {
+		degree[n] = len(g.in[n])
+		if degree[n] == 0 {
+			ready = append(ready, n)
+		}
+	}
+
+	order := make([]string, 0, len(g.nodes))
+	for len(ready) > 0 {
+		n := ready[0]
+		ready = ready[1:]
+		order = append(order, n)
+
+		for _, m := range g.Successors(n) {
+			degree[m]--
+			if degree[m] == 0 {
+				i, _ := slices.BinarySearch(ready, m)
+				ready = slices.Insert(ready, i, m)
+			}
+		}
+	}
+
+	if len(order) != len(g.nodes) {
+		return nil, ErrCycle
+	}
+
+	return order, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,115 @@
+package graph_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/zkhvan/tfc/pkg/graph"
+)
+
+// newGraph returns the graph
+//
+//	network -> dns -> app
+//	network -> app
+//	iam
+func newGraph() *graph.Graph {
+	g := graph.New()
+	g.AddEdge("network", "dns")
+	g.AddEdge("dns", "app")
+	g.AddEdge("network", "app")
+	g.AddNode("iam")
+	return g
+}
+
+func TestTopologicalOrder(t *testing.T) {
+	order, err := newGraph().TopologicalOrder()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff([]string{"iam", "network", "dns", "app"}, order); diff != "" {
+		t.Errorf("TopologicalOrder() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestTopologicalOrder_cycle(t *testing.T) {
+	g := newGraph()
+	g.AddEdge("app", "network")
+
+	if _, err := g.TopologicalOrder(); !errors.Is(err, graph.ErrCycle) {
+		t.Errorf("got error %v, want %v", err, graph.ErrCycle)
+	}
+}
+
+func TestCycles(t *testing.T) {
+	g := newGraph()
+	g.AddEdge("app", "dns")
+	g.AddEdge("iam", "iam")
+
+	want := [][]string{{"app", "dns"}, {"iam"}}
+	if diff := cmp.Diff(want, g.Cycles()); diff != "" {
+		t.Errorf("Cycles() mismatch (-want +got):\n%s", diff)
+	}
+
+	if got := newGraph().Cycles(); len(got) != 0 {
+		t.Errorf("got cycles %v in an acyclic graph", got)
+	}
+}
+
+func TestDescendants(t *testing.T) {
+	g := newGraph()
+
+	if diff := cmp.Diff([]string{"app", "dns"}, g.Descendants("network")); diff != "" {
+		t.Errorf("Descendants() mismatch (-want +got):\n%s", diff)
+	}
+	if got := g.Descendants("app"); len(got) != 0 {
+		t.Errorf("got descendants %v of a leaf", got)
+	}
+}
+
+func TestIsolated(t *testing.T) {
+	if diff := cmp.Diff([]string{"iam"}, newGraph().Isolated()); diff != "" {
+		t.Errorf("Isolated() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestConnected(t *testing.T) {
+	if diff := cmp.Diff([]string{"app", "dns", "network"}, newGraph().Connected().Nodes()); diff != "" {
+		t.Errorf("Connected() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestWriteDOT(t *testing.T) {
+	var buf bytes.Buffer
+	if err := newGraph().Subgraph([]string{"network", "dns"}).WriteDOT(&buf, "run-triggers"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "digraph \"run-triggers\" {\n" +
+		"  rankdir=LR;\n" +
+		"  \"dns\";\n" +
+		"  \"network\";\n" +
+		"  \"network\" -> \"dns\";\n" +
+		"}\n"
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("WriteDOT() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestWriteMermaid(t *testing.T) {
+	var buf bytes.Buffer
+	if err := newGraph().Subgraph([]string{"network", "dns"}).WriteMermaid(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "flowchart LR\n" +
+		"  n0[\"dns\"]\n" +
+		"  n1[\"network\"]\n" +
+		"  n1 --> n0\n"
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("WriteMermaid() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -25,6 +25,9 @@ type IOStreams struct {
 
 	widthOverride int
 
+	stdinTTYOverride bool
+	stdinIsTTY       bool
+
 	In     io.Reader // think os.Stdin
 	Out    io.Writer // think os.Stdout
 	ErrOut io.Writer // think os.Stderr
@@ -77,3 +80,21 @@ func (s *IOStreams) TerminalWidth() int {
 	}
 	return DefaultWidth
 }
+
+// SetStdinTTY overrides whether standard input is considered a terminal.
+func (s *IOStreams) SetStdinTTY(isTTY bool) {
+	s.stdinTTYOverride = true
+	s.stdinIsTTY = isTTY
+}
+
+// IsStdinTTY returns true if standard input is connected to a terminal.
+func (s *IOStreams) IsStdinTTY() bool {
+	if s.stdinTTYOverride {
+		return s.stdinIsTTY
+	}
+
+	if f, ok := s.In.(*os.File); ok {
+		return term.IsTerminal(f.Fd())
+	}
+	return false
+}
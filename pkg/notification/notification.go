@@ -0,0 +1,69 @@
+// Package notification decodes and verifies the webhook payloads sent by
+// the generic notification destinations of workspaces.
+package notification
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+// SignatureHeader is the header carrying the HMAC signature of a payload,
+// when the notification configuration has a token.
+const SignatureHeader = "X-TFE-Notification-Signature"
+
+// Payload is a notification payload.
+type Payload struct {
+	PayloadVersion              int            `json:"payload_version"`
+	NotificationConfigurationID string         `json:"notification_configuration_id"`
+	RunURL                      string         `json:"run_url"`
+	RunID                       string         `json:"run_id"`
+	RunMessage                  string         `json:"run_message"`
+	RunCreatedAt                time.Time      `json:"run_created_at"`
+	RunCreatedBy                string         `json:"run_created_by"`
+	WorkspaceID                 string         `json:"workspace_id"`
+	WorkspaceName               string         `json:"workspace_name"`
+	OrganizationName            string         `json:"organization_name"`
+	Notifications               []Notification `json:"notifications"`
+}
+
+// Notification is an event of a notification payload.
+type Notification struct {
+	Message      string    `json:"message"`
+	Trigger      string    `json:"trigger"`
+	RunStatus    string    `json:"run_status"`
+	RunUpdatedAt time.Time `json:"run_updated_at"`
+	RunUpdatedBy string    `json:"run_updated_by"`
+}
+
+// Parse decodes a notification payload.
+func Parse(body []byte) (*Payload, error) {
+	var p Payload
+	if err := json.Unmarshal(body, &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// Sign returns the signature of a payload: the hex encoded HMAC-SHA512 of
+// the body, keyed with the token of the notification configuration.
+func Sign(token string, body []byte) string {
+	mac := hmac.New(sha512.New, []byte(token))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature is the signature of the payload for the
+// token.
+func Verify(token string, body []byte, signature string) bool {
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha512.New, []byte(token))
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
@@ -0,0 +1,34 @@
+package notification_test
+
+import (
+	"testing"
+
+	"github.com/zkhvan/tfc/pkg/notification"
+)
+
+func TestVerify(t *testing.T) {
+	body := []byte(`{"payload_version":1}`)
+	signature := notification.Sign("s3cr3t", body)
+
+	tests := []struct {
+		name      string
+		token     string
+		body      []byte
+		signature string
+		want      bool
+	}{
+		{name: "valid", token: "s3cr3t", body: body, signature: signature, want: true},
+		{name: "wrong_token", token: "other", body: body, signature: signature, want: false},
+		{name: "modified_body", token: "s3cr3t", body: []byte(`{"payload_version":2}`), signature: signature, want: false},
+		{name: "not_hex", token: "s3cr3t", body: body, signature: "not-a-signature", want: false},
+		{name: "empty", token: "s3cr3t", body: body, signature: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := notification.Verify(tt.token, tt.body, tt.signature); got != tt.want {
+				t.Errorf("Verify got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package parallel
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultConcurrency is the number of concurrent calls used when a caller
+// doesn't specify one.
+const DefaultConcurrency = 8
+
+// Map calls f for every item with at most limit calls running at once. The
+// results and errors are returned in the same order as the items. If the
+// context is cancelled, the remaining items are not processed and report
+// the context's error.
+func Map[T, R any](
+	ctx context.Context,
+	items []T,
+	limit int,
+	f func(context.Context, T) (R, error),
+) ([]R, []error) {
+	if limit <= 0 {
+		limit = DefaultConcurrency
+	}
+
+	results := make([]R, len(items))
+	errs := make([]error, len(items))
+
+	sem := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+
+	for i, item := range items {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			results[i], errs[i] = f(ctx, item)
+		}()
+	}
+
+	wg.Wait()
+
+	return results, errs
+}
@@ -0,0 +1,48 @@
+package parallel_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/pkg/parallel"
+)
+
+func TestMap(t *testing.T) {
+	var running, maxRunning atomic.Int32
+
+	items := []string{"a", "b", "c", "d", "e", "f"}
+	results, errs := parallel.Map(context.Background(), items, 2, func(_ context.Context, s string) (string, error) {
+		n := running.Add(1)
+		defer running.Add(-1)
+
+		for {
+			m := maxRunning.Load()
+			if n <= m || maxRunning.CompareAndSwap(m, n) {
+				break
+			}
+		}
+
+		time.Sleep(5 * time.Millisecond)
+
+		if s == "c" {
+			return "", errors.New("boom")
+		}
+		return s + s, nil
+	})
+
+	test.StringSlice(t, results, []string{"aa", "bb", "", "dd", "ee", "ff"})
+
+	for i, err := range errs {
+		if (i == 2) != (err != nil) {
+			t.Errorf("unexpected error at %d: %v", i, err)
+		}
+	}
+
+	if got := maxRunning.Load(); got > 2 {
+		t.Errorf("ran %d calls at once, want at most 2", got)
+	}
+}
@@ -0,0 +1,51 @@
+package pattern
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Pattern matches strings against a user supplied search pattern.
+type Pattern struct {
+	re *regexp.Regexp
+}
+
+// Compile compiles a search pattern. When regex is true the pattern is a
+// regular expression. Otherwise a pattern containing glob characters (*, ?)
+// must match the whole string, and a plain pattern matches any string that
+// contains it.
+func Compile(pattern string, regex bool) (*Pattern, error) {
+	if regex {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+		return &Pattern{re: re}, nil
+	}
+
+	if !strings.ContainsAny(pattern, "*?") {
+		return &Pattern{re: regexp.MustCompile(regexp.QuoteMeta(pattern))}, nil
+	}
+
+	var b strings.Builder
+	b.WriteString("^")
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+
+	return &Pattern{re: regexp.MustCompile(b.String())}, nil
+}
+
+// MatchString reports whether s matches the pattern.
+func (p *Pattern) MatchString(s string) bool {
+	return p.re.MatchString(s)
+}
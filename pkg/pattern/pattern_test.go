@@ -0,0 +1,43 @@
+package pattern_test
+
+import (
+	"testing"
+
+	"github.com/zkhvan/tfc/pkg/pattern"
+)
+
+func TestPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		regex   bool
+		input   string
+		want    bool
+	}{
+		{pattern: "iam_role", input: "aws_iam_role.app", want: true},
+		{pattern: "iam_role", input: "aws_s3_bucket.app", want: false},
+		{pattern: "aws_iam_role.*", input: "aws_iam_role.app", want: true},
+		{pattern: "aws_iam_role.*", input: "module.x.aws_iam_role.app", want: false},
+		{pattern: "*.aws_iam_role.ap?", input: "module.x.aws_iam_role.app", want: true},
+		{pattern: "^AWS_.*_ARN$", regex: true, input: "AWS_ROLE_ARN", want: true},
+		{pattern: "^AWS_.*_ARN$", regex: true, input: "AWS_REGION", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+"/"+tt.input, func(t *testing.T) {
+			p, err := pattern.Compile(tt.pattern, tt.regex)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := p.MatchString(tt.input); got != tt.want {
+				t.Errorf("MatchString(%q) got %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompile_invalid_regex(t *testing.T) {
+	if _, err := pattern.Compile("(", true); err == nil {
+		t.Error("expected an error for an invalid regular expression")
+	}
+}
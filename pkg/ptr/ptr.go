@@ -7,3 +7,15 @@ func String(v string) *string {
 func Bool(v bool) *bool {
 	return &v
 }
+
+func Int(v int) *int {
+	return &v
+}
+
+func Deref[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
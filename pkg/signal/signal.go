@@ -4,10 +4,14 @@ import (
 	"context"
 	"os"
 	"os/signal"
+	"sync/atomic"
 )
 
 var onlyOneSignalHandler = make(chan struct{})
 
+// forwarding is set while signals are forwarded to a child process.
+var forwarding atomic.Bool
+
 func Notify() context.Context {
 	close(onlyOneSignalHandler)
 
@@ -16,11 +20,64 @@ func Notify() context.Context {
 	c := make(chan os.Signal, 2)
 	signal.Notify(c, shutdownSignals...)
 	go func() {
-		<-c
-		cancel()
-		<-c
-		os.Exit(1) // second signal, exit directly
+		received := 0
+		for range c {
+			// While signals are forwarded to a child process, the child
+			// decides whether to exit and the command exits with it.
+			if forwarding.Load() {
+				continue
+			}
+
+			received++
+			if received == 1 {
+				cancel()
+				continue
+			}
+			os.Exit(1) // second signal, exit directly
+		}
 	}()
 
 	return ctx
 }
+
+// Forward forwards the signals received by this process to the process p,
+// until the returned function is called. While signals are forwarded, they
+// don't cancel the context returned by Notify.
+//
+// The process p must be in the process group of this process. When this
+// process is in the foreground of its terminal, the signals sent by the
+// terminal, such as Ctrl-C or a hangup, are already delivered to p by the
+// terminal and aren't forwarded a second time.
+func Forward(p *os.Process) (stop func()) {
+	forwarding.Store(true)
+
+	skip := map[os.Signal]bool{}
+	if inForeground() {
+		for _, sig := range terminalSignals {
+			skip[sig] = true
+		}
+	}
+
+	c := make(chan os.Signal, 2)
+	signal.Notify(c, forwardSignals...)
+
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case sig := <-c:
+				if !skip[sig] {
+					_ = p.Signal(sig)
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		signal.Stop(c)
+		close(done)
+		forwarding.Store(false)
+	}
+}
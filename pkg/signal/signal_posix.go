@@ -6,6 +6,31 @@ package signal
 import (
 	"os"
 	"syscall"
+
+	"golang.org/x/sys/unix"
 )
 
 var shutdownSignals = []os.Signal{os.Interrupt, syscall.SIGTERM}
+
+var forwardSignals = []os.Signal{
+	os.Interrupt,
+	syscall.SIGTERM,
+	syscall.SIGHUP,
+	syscall.SIGQUIT,
+	syscall.SIGUSR1,
+	syscall.SIGUSR2,
+}
+
+// terminalSignals are the signals a terminal sends to its foreground process
+// group, including SIGHUP when the terminal hangs up.
+var terminalSignals = []os.Signal{os.Interrupt, syscall.SIGQUIT, syscall.SIGHUP}
+
+// inForeground reports whether this process is in the foreground process
+// group of the terminal of its standard input.
+func inForeground() bool {
+	pgrp, err := unix.IoctlGetInt(int(os.Stdin.Fd()), unix.TIOCGPGRP)
+	if err != nil {
+		return false
+	}
+	return pgrp == unix.Getpgrp()
+}
@@ -5,3 +5,15 @@ import (
 )
 
 var shutdownSignals = []os.Signal{os.Interrupt}
+
+var forwardSignals = []os.Signal{os.Interrupt}
+
+// terminalSignals are the signals a console sends to every process attached
+// to it.
+var terminalSignals = []os.Signal{os.Interrupt}
+
+// inForeground reports whether this process receives the signals of its
+// console, which is always the case on Windows.
+func inForeground() bool {
+	return true
+}
@@ -0,0 +1,203 @@
+package tfconfig
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Data sources reading the state of a workspace.
+const (
+	SourceTerraformRemoteState = "terraform_remote_state"
+	SourceTFEOutputs           = "tfe_outputs"
+)
+
+// RemoteStateReference is a data source reading the state of a workspace.
+type RemoteStateReference struct {
+	Source   string // SourceTerraformRemoteState or SourceTFEOutputs
+	Name     string // Name of the data source
+	Filename string
+
+	// Organization is empty when the data source doesn't set it, such as a
+	// tfe_outputs data source using the organization of its provider.
+	Organization string
+	Workspace    string
+
+	// Resolved is false when the organization or workspace depend on
+	// variables or other values only known to Terraform, or when a
+	// terraform_remote_state data source selects workspaces by prefix.
+	Resolved bool
+}
+
+// ParseRemoteStateReferences finds the data sources reading the state of a
+// workspace in a configuration file. Only terraform_remote_state data
+// sources with the remote backend are considered.
+func ParseRemoteStateReferences(filename string, src []byte) ([]RemoteStateReference, error) {
+	file, diags := hclsyntax.ParseConfig(src, filename, hcl.Pos{Line: 1, Column: 1})
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		return nil, nil
+	}
+
+	var refs []RemoteStateReference
+	for _, block := range body.Blocks {
+		if block.Type != "data" || len(block.Labels) != 2 {
+			continue
+		}
+
+		ref := RemoteStateReference{
+			Source:   block.Labels[0],
+			Name:     block.Labels[1],
+			Filename: filename,
+		}
+
+		switch ref.Source {
+		case SourceTerraformRemoteState:
+			backend, ok := stringAttr(block.Body, "backend")
+			if !ok || (backend != "remote" && backend != "cloud") {
+				continue
+			}
+
+			config, ok := evalAttr(block.Body, "config")
+			if ok {
+				ref.Organization, _ = stringValue(config, "organization")
+				if workspaces, ok := objectValue(config, "workspaces"); ok {
+					ref.Workspace, _ = stringValue(workspaces, "name")
+				}
+			}
+			ref.Resolved = ref.Organization != "" && ref.Workspace != ""
+		case SourceTFEOutputs:
+			ref.Workspace, _ = stringAttr(block.Body, "workspace")
+			ref.Resolved = ref.Workspace != ""
+
+			if _, set := block.Body.Attributes["organization"]; set {
+				ref.Organization, _ = stringAttr(block.Body, "organization")
+				ref.Resolved = ref.Resolved && ref.Organization != ""
+			}
+		default:
+			continue
+		}
+
+		refs = append(refs, ref)
+	}
+
+	return refs, nil
+}
+
+// ArchiveRemoteStateReferences finds the data sources reading the state of a
+// workspace in the .tf files of a gzipped tarball, such as a downloaded
+// configuration version. Files that fail to parse are reported in the returned
+// error while the data sources of the others are still returned.
+func ArchiveRemoteStateReferences(r io.Reader) ([]RemoteStateReference, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	var (
+		refs []RemoteStateReference
+		errs []error
+	)
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		name := strings.TrimPrefix(path.Clean(hdr.Name), "./")
+		if hdr.Typeflag != tar.TypeReg || path.Ext(name) != ".tf" || isTerraformDir(name) {
+			continue
+		}
+
+		src, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+
+		fileRefs, err := ParseRemoteStateReferences(name, src)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to parse %s: %w", name, err))
+			continue
+		}
+		refs = append(refs, fileRefs...)
+	}
+
+	return refs, errors.Join(errs...)
+}
+
+// isTerraformDir reports whether a file is in a .terraform directory, where
+// Terraform keeps the modules and providers it installs.
+func isTerraformDir(name string) bool {
+	for _, dir := range strings.Split(path.Dir(name), "/") {
+		if dir == ".terraform" {
+			return true
+		}
+	}
+	return false
+}
+
+// evalAttr evaluates an attribute of a block without any variables. It
+// returns false if the attribute isn't set or can't be evaluated.
+func evalAttr(body *hclsyntax.Body, name string) (cty.Value, bool) {
+	attr, ok := body.Attributes[name]
+	if !ok {
+		return cty.NilVal, false
+	}
+
+	v, diags := attr.Expr.Value(nil)
+	if diags.HasErrors() || !v.IsWhollyKnown() || v.IsNull() {
+		return cty.NilVal, false
+	}
+
+	return v, true
+}
+
+func stringAttr(body *hclsyntax.Body, name string) (string, bool) {
+	v, ok := evalAttr(body, name)
+	if !ok || v.Type() != cty.String {
+		return "", false
+	}
+	return v.AsString(), true
+}
+
+func objectValue(v cty.Value, name string) (cty.Value, bool) {
+	if !v.Type().IsObjectType() || !v.Type().HasAttribute(name) {
+		return cty.NilVal, false
+	}
+
+	attr := v.GetAttr(name)
+	if attr.IsNull() || !attr.Type().IsObjectType() {
+		return cty.NilVal, false
+	}
+	return attr, true
+}
+
+func stringValue(v cty.Value, name string) (string, bool) {
+	if !v.Type().IsObjectType() || !v.Type().HasAttribute(name) {
+		return "", false
+	}
+
+	attr := v.GetAttr(name)
+	if attr.IsNull() || attr.Type() != cty.String {
+		return "", false
+	}
+	return attr.AsString(), true
+}
@@ -0,0 +1,180 @@
+package tfconfig_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/zkhvan/tfc/pkg/tfconfig"
+)
+
+func TestParseRemoteStateReferences(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want []tfconfig.RemoteStateReference
+	}{
+		{
+			name: "terraform_remote_state",
+			src: `
+				data "terraform_remote_state" "network" {
+				  backend = "remote"
+				  config = {
+				    organization = "myorg"
+				    workspaces = {
+				      name = "network"
+				    }
+				  }
+				}
+			`,
+			want: []tfconfig.RemoteStateReference{{
+				Source:       tfconfig.SourceTerraformRemoteState,
+				Name:         "network",
+				Filename:     "main.tf",
+				Organization: "myorg",
+				Workspace:    "network",
+				Resolved:     true,
+			}},
+		},
+		{
+			name: "terraform_remote_state with a variable",
+			src: `
+				data "terraform_remote_state" "network" {
+				  backend = "remote"
+				  config = {
+				    organization = var.org
+				    workspaces = {
+				      name = "network"
+				    }
+				  }
+				}
+			`,
+			want: []tfconfig.RemoteStateReference{{
+				Source:   tfconfig.SourceTerraformRemoteState,
+				Name:     "network",
+				Filename: "main.tf",
+			}},
+		},
+		{
+			name: "terraform_remote_state with another backend",
+			src: `
+				data "terraform_remote_state" "network" {
+				  backend = "s3"
+				  config = {
+				    bucket = "state"
+				    key    = "network.tfstate"
+				  }
+				}
+			`,
+		},
+		{
+			name: "tfe_outputs",
+			src: `
+				data "tfe_outputs" "dns" {
+				  workspace = "dns"
+				}
+
+				data "tfe_outputs" "iam" {
+				  organization = "security"
+				  workspace    = "iam"
+				}
+
+				data "aws_vpc" "main" {
+				  default = true
+				}
+			`,
+			want: []tfconfig.RemoteStateReference{
+				{
+					Source:    tfconfig.SourceTFEOutputs,
+					Name:      "dns",
+					Filename:  "main.tf",
+					Workspace: "dns",
+					Resolved:  true,
+				},
+				{
+					Source:       tfconfig.SourceTFEOutputs,
+					Name:         "iam",
+					Filename:     "main.tf",
+					Organization: "security",
+					Workspace:    "iam",
+					Resolved:     true,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tfconfig.ParseRemoteStateReferences("main.tf", []byte(tt.src))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ParseRemoteStateReferences() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestArchiveRemoteStateReferences(t *testing.T) {
+	archive := tarball(t, []file{
+		{"main.tf", `data "tfe_outputs" "dns" { workspace = "dns" }`},
+		{"modules/app/data.tf", `data "tfe_outputs" "iam" { workspace = "iam" }`},
+		{".terraform/modules/vpc/main.tf", `data "tfe_outputs" "vpc" { workspace = "vpc" }`},
+		{"README.md", `data "tfe_outputs" "docs" { workspace = "docs" }`},
+	})
+
+	refs, err := tfconfig.ArchiveRemoteStateReferences(bytes.NewReader(archive))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, ref := range refs {
+		got = append(got, ref.Filename+": "+ref.Workspace)
+	}
+
+	want := []string{"main.tf: dns", "modules/app/data.tf: iam"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ArchiveRemoteStateReferences() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+type file struct {
+	name    string
+	content string
+}
+
+// tarball returns a gzipped tarball of files, like a configuration version.
+func tarball(t *testing.T, files []file) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, f := range files {
+		if err := tw.WriteHeader(&tar.Header{
+			Name:     "./" + f.name,
+			Mode:     0o644,
+			Size:     int64(len(f.content)),
+			Typeflag: tar.TypeReg,
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(f.content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
@@ -0,0 +1,169 @@
+package tfstate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// State represents the parts of a Terraform state file (format version 4)
+// that tfc cares about.
+type State struct {
+	Version          int        `json:"version"`
+	TerraformVersion string     `json:"terraform_version"`
+	Serial           int64      `json:"serial"`
+	Lineage          string     `json:"lineage"`
+	Resources        []Resource `json:"resources"`
+}
+
+// Resource represents a resource block in a Terraform state file.
+type Resource struct {
+	Module    string     `json:"module,omitempty"`
+	Mode      string     `json:"mode"`
+	Type      string     `json:"type"`
+	Name      string     `json:"name"`
+	Provider  string     `json:"provider"`
+	Instances []Instance `json:"instances"`
+}
+
+// Instance represents a single instance of a resource.
+type Instance struct {
+	IndexKey   any             `json:"index_key,omitempty"`
+	Attributes json.RawMessage `json:"attributes,omitempty"`
+}
+
+// Parse parses a raw Terraform state file.
+func Parse(raw []byte) (*State, error) {
+	var s State
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return nil, fmt.Errorf("parse state: %w", err)
+	}
+
+	if s.Version != 4 {
+		return nil, fmt.Errorf("unsupported state format version %d", s.Version)
+	}
+
+	return &s, nil
+}
+
+// Instances returns every resource instance in the state keyed by its
+// address, e.g. module.app.aws_instance.web[0].
+func (s *State) Instances() map[string]Instance {
+	out := make(map[string]Instance)
+	for _, r := range s.Resources {
+		base := r.Address()
+		for _, inst := range r.Instances {
+			out[base+indexSuffix(inst.IndexKey)] = inst
+		}
+	}
+	return out
+}
+
+// Address returns the address of the resource without an instance key.
+func (r Resource) Address() string {
+	var parts []string
+	if r.Module != "" {
+		parts = append(parts, r.Module)
+	}
+	if r.Mode == "data" {
+		parts = append(parts, "data")
+	}
+	parts = append(parts, r.Type, r.Name)
+	return strings.Join(parts, ".")
+}
+
+func indexSuffix(key any) string {
+	switch k := key.(type) {
+	case nil:
+		return ""
+	case string:
+		return fmt.Sprintf("[%q]", k)
+	case float64:
+		return fmt.Sprintf("[%d]", int64(k))
+	default:
+		return fmt.Sprintf("[%v]", k)
+	}
+}
+
+// Change describes how a resource instance differs between two states.
+type Change string
+
+const (
+	ChangeAdded   Change = "added"
+	ChangeRemoved Change = "removed"
+	ChangeChanged Change = "changed"
+)
+
+// ResourceDiff is a single difference between two states.
+type ResourceDiff struct {
+	Address string
+	Change  Change
+}
+
+// Diff compares two states at the resource address level. The result is
+// sorted by address.
+func Diff(from, to *State) []ResourceDiff {
+	a := from.Instances()
+	b := to.Instances()
+
+	var diffs []ResourceDiff
+	for addr, inst := range a {
+		other, ok := b[addr]
+		if !ok {
+			diffs = append(diffs, ResourceDiff{Address: addr, Change: ChangeRemoved})
+			continue
+		}
+
+		if !jsonEqual(inst.Attributes, other.Attributes) {
+			diffs = append(diffs, ResourceDiff{Address: addr, Change: ChangeChanged})
+		}
+	}
+
+	for addr := range b {
+		if _, ok := a[addr]; !ok {
+			diffs = append(diffs, ResourceDiff{Address: addr, Change: ChangeAdded})
+		}
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Address < diffs[j].Address
+	})
+
+	return diffs
+}
+
+// jsonEqual compares two JSON documents ignoring formatting differences.
+func jsonEqual(a, b json.RawMessage) bool {
+	var ca, cb bytes.Buffer
+	if err := json.Compact(&ca, a); err != nil {
+		return bytes.Equal(a, b)
+	}
+	if err := json.Compact(&cb, b); err != nil {
+		return bytes.Equal(a, b)
+	}
+	return bytes.Equal(ca.Bytes(), cb.Bytes())
+}
+
+// SetSerial returns a copy of the raw state with its serial replaced. All
+// other fields are preserved as is.
+func SetSerial(raw []byte, serial int64) ([]byte, error) {
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("parse state: %w", err)
+	}
+
+	s, err := json.Marshal(serial)
+	if err != nil {
+		return nil, err
+	}
+	doc["serial"] = s
+
+	out, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(out, '\n'), nil
+}
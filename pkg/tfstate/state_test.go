@@ -0,0 +1,110 @@
+package tfstate_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/zkhvan/tfc/pkg/tfstate"
+)
+
+func TestDiff(t *testing.T) {
+	from := mustParse(t, `
+		{
+			"version": 4,
+			"serial": 1,
+			"resources": [
+				{
+					"mode": "managed",
+					"type": "aws_instance",
+					"name": "web",
+					"instances": [
+						{"index_key": 0, "attributes": {"ami": "ami-1"}},
+						{"index_key": 1, "attributes": {"ami": "ami-1"}}
+					]
+				},
+				{
+					"module": "module.net",
+					"mode": "data",
+					"type": "aws_vpc",
+					"name": "main",
+					"instances": [{"attributes": {"id": "vpc-1"}}]
+				}
+			]
+		}
+	`)
+
+	to := mustParse(t, `
+		{
+			"version": 4,
+			"serial": 2,
+			"resources": [
+				{
+					"mode": "managed",
+					"type": "aws_instance",
+					"name": "web",
+					"instances": [
+						{"index_key": 0, "attributes": {"ami": "ami-2"}}
+					]
+				},
+				{
+					"module": "module.net",
+					"mode": "data",
+					"type": "aws_vpc",
+					"name": "main",
+					"instances": [{"attributes": {"id":"vpc-1"}}]
+				},
+				{
+					"mode": "managed",
+					"type": "aws_iam_role",
+					"name": "app",
+					"instances": [{"index_key": "blue", "attributes": {}}]
+				}
+			]
+		}
+	`)
+
+	want := []tfstate.ResourceDiff{
+		{Address: `aws_iam_role.app["blue"]`, Change: tfstate.ChangeAdded},
+		{Address: "aws_instance.web[0]", Change: tfstate.ChangeChanged},
+		{Address: "aws_instance.web[1]", Change: tfstate.ChangeRemoved},
+	}
+
+	got := tfstate.Diff(from, to)
+	if !cmp.Equal(got, want) {
+		t.Errorf("Diff mismatch (-got +want):\n%s", cmp.Diff(got, want))
+	}
+}
+
+func TestSetSerial(t *testing.T) {
+	raw := []byte(`{"version":4,"serial":3,"lineage":"abc","resources":[]}`)
+
+	out, err := tfstate.SetSerial(raw, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := mustParse(t, string(out))
+	if s.Serial != 10 {
+		t.Errorf("serial got %d, want 10", s.Serial)
+	}
+	if s.Lineage != "abc" {
+		t.Errorf("lineage got %q, want %q", s.Lineage, "abc")
+	}
+}
+
+func TestParse_unsupported_version(t *testing.T) {
+	if _, err := tfstate.Parse([]byte(`{"version":3}`)); err == nil {
+		t.Error("expected an error for state format version 3")
+	}
+}
+
+func mustParse(t *testing.T, raw string) *tfstate.State {
+	t.Helper()
+
+	s, err := tfstate.Parse([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
@@ -0,0 +1,224 @@
+// Package vareffective resolves the effective variables of a workspace from
+// its own variables and the variable sets applied to it.
+package vareffective
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/hashicorp/go-tfe"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+)
+
+// Scope is how a variable set is applied to a workspace.
+type Scope string
+
+const (
+	ScopeWorkspace Scope = "workspace"
+	ScopeProject   Scope = "project"
+	ScopeGlobal    Scope = "global"
+)
+
+// Source is where a variable is defined: the workspace itself, or a variable
+// set applied to it.
+type Source struct {
+	// VarSet is the name of the variable set, empty for workspace variables.
+	VarSet   string
+	VarSetID string
+	Scope    Scope
+	Priority bool
+}
+
+// IsWorkspace reports whether the variable is defined by the workspace.
+func (s Source) IsWorkspace() bool {
+	return s.VarSet == ""
+}
+
+func (s Source) String() string {
+	if s.IsWorkspace() {
+		return "workspace"
+	}
+
+	prefix := "varset"
+	if s.Priority {
+		prefix = "priority varset"
+	}
+
+	return fmt.Sprintf("%s %s (%s)", prefix, s.VarSet, s.Scope)
+}
+
+// rank orders sources from the highest to the lowest precedence.
+//
+// Priority variable sets override everything else, then workspace variables
+// override variable sets. Between variable sets, those applied to the
+// workspace override those applied to its project, which override global
+// ones.
+func (s Source) rank() int {
+	if s.IsWorkspace() {
+		return 3
+	}
+
+	r := 0
+	switch s.Scope {
+	case ScopeProject:
+		r = 1
+	case ScopeGlobal:
+		r = 2
+	}
+
+	if !s.Priority {
+		r += 4
+	}
+
+	return r
+}
+
+// Definition is a variable defined by a source.
+type Definition struct {
+	ID          string
+	Key         string
+	Value       string
+	Description string
+	Category    tfe.CategoryType
+	HCL         bool
+	Sensitive   bool
+	Source      Source
+}
+
+// Variable is the effective variable of a key and category, and the
+// definitions it overrides.
+type Variable struct {
+	Definition
+
+	Overridden []Definition
+}
+
+// Resolve resolves the effective variables of the definitions. Variables
+// with the same key and category are resolved by the precedence of their
+// sources; when two variable sets with the same precedence conflict, the
+// one whose name sorts first wins.
+//
+// Variables are sorted by category, terraform first, then key.
+func Resolve(defs []Definition) []Variable {
+	sorted := slices.Clone(defs)
+	slices.SortStableFunc(sorted, func(a, b Definition) int {
+		return cmp.Or(
+			cmp.Compare(a.Source.rank(), b.Source.rank()),
+			cmp.Compare(a.Source.VarSet, b.Source.VarSet),
+		)
+	})
+
+	type id struct {
+		key      string
+		category tfe.CategoryType
+	}
+
+	index := make(map[id]int)
+	var vars []Variable
+	for _, d := range sorted {
+		k := id{d.Key, d.Category}
+		if i, ok := index[k]; ok {
+			vars[i].Overridden = append(vars[i].Overridden, d)
+			continue
+		}
+
+		index[k] = len(vars)
+		vars = append(vars, Variable{Definition: d})
+	}
+
+	slices.SortFunc(vars, func(a, b Variable) int {
+		return cmp.Or(
+			-cmp.Compare(a.Category, b.Category),
+			cmp.Compare(a.Key, b.Key),
+		)
+	})
+
+	return vars
+}
+
+// Load reads the variables of a workspace and of the variable sets applied
+// to it, directly, through its project or globally.
+func Load(ctx context.Context, client *tfc.Client, ws *tfc.Workspace) ([]Definition, error) {
+	vars, err := client.Variables.ListAll(ctx, ws.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list variables for %s: %w", ws.Name, err)
+	}
+
+	var defs []Definition
+	for _, v := range vars {
+		defs = append(defs, Definition{
+			ID:          v.ID,
+			Key:         v.Key,
+			Value:       v.Value,
+			Description: v.Description,
+			Category:    v.Category,
+			HCL:         v.HCL,
+			Sensitive:   v.Sensitive,
+		})
+	}
+
+	sets, _, err := client.VariableSets.ListForWorkspace(ctx, ws.ID, &tfc.VariableSetListOptions{
+		ListOptions: tfc.ListOptions{Limit: math.MaxInt},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list variable sets for %s: %w", ws.Name, err)
+	}
+
+	for _, vs := range sets {
+		source := Source{
+			VarSet:   vs.Name,
+			VarSetID: vs.ID,
+			Scope:    scope(vs, ws),
+			Priority: vs.Priority,
+		}
+
+		vars, err := client.VariableSets.ListVariables(ctx, vs.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list variables for variable set %s: %w", vs.Name, err)
+		}
+
+		for _, v := range vars {
+			defs = append(defs, Definition{
+				ID:          v.ID,
+				Key:         v.Key,
+				Value:       v.Value,
+				Description: v.Description,
+				Category:    v.Category,
+				HCL:         v.HCL,
+				Sensitive:   v.Sensitive,
+				Source:      source,
+			})
+		}
+	}
+
+	return defs, nil
+}
+
+// scope returns how a variable set listed for a workspace is applied to it.
+func scope(vs *tfc.VariableSet, ws *tfc.Workspace) Scope {
+	if vs.Global {
+		return ScopeGlobal
+	}
+
+	for _, w := range vs.Workspaces {
+		if w.ID == ws.ID {
+			return ScopeWorkspace
+		}
+	}
+
+	if ws.Project != nil {
+		for _, p := range vs.Projects {
+			if p.ID == ws.Project.ID {
+				return ScopeProject
+			}
+		}
+	}
+
+	// The set is listed for the workspace, so it's applied to it one way
+	// or another even if the relationships are incomplete.
+	return ScopeWorkspace
+}
@@ -0,0 +1,63 @@
+package vareffective_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/pkg/vareffective"
+)
+
+func TestResolve(t *testing.T) {
+	var (
+		workspace       = vareffective.Source{}
+		global          = vareffective.Source{VarSet: "defaults", Scope: vareffective.ScopeGlobal}
+		project         = vareffective.Source{VarSet: "platform", Scope: vareffective.ScopeProject}
+		direct          = vareffective.Source{VarSet: "app", Scope: vareffective.ScopeWorkspace}
+		otherDirect     = vareffective.Source{VarSet: "aaa", Scope: vareffective.ScopeWorkspace}
+		priorityGlobal  = vareffective.Source{VarSet: "guardrails", Scope: vareffective.ScopeGlobal, Priority: true}
+		priorityProject = vareffective.Source{
+			VarSet:   "platform-guardrails",
+			Scope:    vareffective.ScopeProject,
+			Priority: true,
+		}
+	)
+
+	def := func(key string, category tfe.CategoryType, value string, source vareffective.Source) vareffective.Definition {
+		return vareffective.Definition{Key: key, Category: category, Value: value, Source: source}
+	}
+
+	vars := vareffective.Resolve([]vareffective.Definition{
+		def("region", tfe.CategoryTerraform, "us-east-1", global),
+		def("region", tfe.CategoryTerraform, "eu-west-1", workspace),
+		def("region", tfe.CategoryTerraform, "us-west-2", project),
+		def("replicas", tfe.CategoryTerraform, "2", workspace),
+		def("replicas", tfe.CategoryTerraform, "1", direct),
+		def("tier", tfe.CategoryTerraform, "a", direct),
+		def("tier", tfe.CategoryTerraform, "b", otherDirect),
+		def("cost_center", tfe.CategoryTerraform, "x", workspace),
+		def("cost_center", tfe.CategoryTerraform, "y", priorityGlobal),
+		def("cost_center", tfe.CategoryTerraform, "z", priorityProject),
+		def("region", tfe.CategoryEnv, "us-east-1", global),
+	})
+
+	var got []string
+	for _, v := range vars {
+		line := string(v.Category) + " " + v.Key + "=" + v.Value + " from " + v.Source.String()
+		for _, o := range v.Overridden {
+			line += ", over " + o.Value + " from " + o.Source.String()
+		}
+		got = append(got, line)
+	}
+
+	test.StringSlice(t, got, []string{
+		"terraform cost_center=z from priority varset platform-guardrails (project), " +
+			"over y from priority varset guardrails (global), over x from workspace",
+		"terraform region=eu-west-1 from workspace, " +
+			"over us-west-2 from varset platform (project), over us-east-1 from varset defaults (global)",
+		"terraform replicas=2 from workspace, over 1 from varset app (workspace)",
+		"terraform tier=b from varset aaa (workspace), over a from varset app (workspace)",
+		"env region=us-east-1 from varset defaults (global)",
+	})
+}
@@ -0,0 +1,186 @@
+package varfile
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/varplan"
+)
+
+// Markers of the annotated format.
+const (
+	MarkerTerraform = "@terraform"
+	MarkerEnv       = "@env"
+	MarkerHCL       = "@hcl"
+	MarkerSensitive = "@sensitive"
+)
+
+var markerRegexp = regexp.MustCompile(`^#\s*@`)
+
+// WriteAnnotated writes variables of every category as an HCL document. Each
+// variable is preceded by a marker comment with its category and flags, like
+// "# @env @sensitive", and by its description. Terraform variables are
+// written before env variables, both sorted by key.
+//
+// Sensitive variables are written as commented placeholders. Keys must be
+// valid identifiers and unique across categories.
+func WriteAnnotated(w io.Writer, vars []*tfc.Variable) error {
+	vars = slices.Clone(vars)
+	slices.SortStableFunc(vars, func(a, b *tfc.Variable) int {
+		if a.Category != b.Category {
+			if a.Category == tfe.CategoryTerraform {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	seen := make(map[string]bool, len(vars))
+	for i, v := range vars {
+		if !hclsyntax.ValidIdentifier(v.Key) {
+			return fmt.Errorf("variable %q is not a valid identifier", v.Key)
+		}
+		if seen[v.Key] {
+			return fmt.Errorf("variable %q exists in more than one category", v.Key)
+		}
+		seen[v.Key] = true
+
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+
+		writeDescription(w, v)
+
+		markers := []string{MarkerTerraform}
+		if v.Category == tfe.CategoryEnv {
+			markers = []string{MarkerEnv}
+		}
+		if v.HCL {
+			markers = append(markers, MarkerHCL)
+		}
+		if v.Sensitive {
+			markers = append(markers, MarkerSensitive)
+		}
+		fmt.Fprintf(w, "# %s\n", strings.Join(markers, " "))
+
+		switch {
+		case v.Sensitive:
+			fmt.Fprintf(w, "# %s = %s\n", v.Key, SensitivePlaceholder)
+		case v.HCL && strings.TrimSpace(v.Value) == "":
+			fmt.Fprintf(w, "%s = null\n", v.Key)
+		case v.HCL:
+			fmt.Fprintf(w, "%s = %s\n", v.Key, strings.TrimSpace(v.Value))
+		default:
+			fmt.Fprintf(w, "%s = %s\n", v.Key, hclwrite.TokensForValue(cty.StringVal(v.Value)).Bytes())
+		}
+	}
+
+	return nil
+}
+
+// ParseAnnotated parses a document written by WriteAnnotated. Variables
+// without a marker comment are plain terraform variables. Values of variables
+// without the @hcl marker must be strings, numbers or bools.
+func ParseAnnotated(filename string, data []byte) ([]varplan.Variable, error) {
+	file, diags := hclsyntax.ParseConfig(data, filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	attrs, diags := file.Body.JustAttributes()
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	lines := strings.Split(string(data), "\n")
+
+	// markersBefore parses the marker comment on the line before the given
+	// line number, if there is one.
+	markersBefore := func(line int) (varplan.Variable, error) {
+		v := varplan.Variable{Category: tfe.CategoryTerraform}
+		if line < 2 {
+			return v, nil
+		}
+
+		prev := strings.TrimSpace(lines[line-2])
+		if !markerRegexp.MatchString(prev) {
+			return v, nil
+		}
+
+		for _, marker := range strings.Fields(strings.TrimPrefix(prev, "#")) {
+			switch marker {
+			case MarkerTerraform:
+				v.Category = tfe.CategoryTerraform
+			case MarkerEnv:
+				v.Category = tfe.CategoryEnv
+			case MarkerHCL:
+				v.HCL = true
+			case MarkerSensitive:
+				v.Sensitive = true
+			default:
+				return v, fmt.Errorf("%s:%d: unknown marker %q", filename, line-1, marker)
+			}
+		}
+		return v, nil
+	}
+
+	vars := make([]varplan.Variable, 0, len(attrs))
+	for name, attr := range attrs {
+		v, err := markersBefore(attr.Range.Start.Line)
+		if err != nil {
+			return nil, err
+		}
+		v.Key = name
+
+		value, isHCL := exprValue(attr.Expr, data)
+		switch {
+		case v.HCL:
+			v.Value = strings.TrimSpace(string(attr.Expr.Range().SliceBytes(data)))
+		case isHCL:
+			return nil, fmt.Errorf("%s: value of %s must be a string, number or bool, or marked %s",
+				attr.Expr.Range(), name, MarkerHCL,
+			)
+		default:
+			v.Value = value
+		}
+
+		vars = append(vars, v)
+	}
+
+	slices.SortFunc(vars, func(a, b varplan.Variable) int {
+		return attrs[a.Key].Range.Start.Byte - attrs[b.Key].Range.Start.Byte
+	})
+
+	for i, line := range lines {
+		m := placeholderRegexp.FindStringSubmatch(strings.TrimSpace(line))
+		if m == nil {
+			continue
+		}
+		if _, ok := attrs[m[1]]; ok {
+			continue
+		}
+
+		v, err := markersBefore(i + 1)
+		if err != nil {
+			return nil, err
+		}
+		v.Key = m[1]
+		v.Sensitive = true
+		v.Placeholder = true
+
+		vars = append(vars, v)
+	}
+
+	return vars, nil
+}
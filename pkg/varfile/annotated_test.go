@@ -0,0 +1,76 @@
+package varfile_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/varfile"
+	"github.com/zkhvan/tfc/pkg/varplan"
+)
+
+func TestAnnotated_round_trip(t *testing.T) {
+	var buf bytes.Buffer
+	if err := varfile.WriteAnnotated(&buf, exportVars); err != nil {
+		t.Fatal(err)
+	}
+
+	test.Buffer(t, &buf, text.Heredoc(`
+		# @terraform @sensitive
+		# db_password = <sensitive>
+
+		# @terraform
+		motd = "say \"hi\" $${name}"
+
+		# @terraform @hcl
+		prefix = "app"
+
+		# AWS region
+		# @terraform
+		region = "us-east-1"
+
+		# @terraform @hcl
+		replicas = 3
+
+		# @terraform @hcl
+		zones = ["a", "b"]
+
+		# @env @sensitive
+		# API_TOKEN = <sensitive>
+
+		# @env
+		GREETING = "it's me"
+
+		# @env
+		LOG_LEVEL = "debug"
+	`))
+
+	vars, err := varfile.ParseAnnotated("vars.tfvars", buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plan := varplan.New(exportVars, vars, varplan.Options{Prune: true, ForceSensitive: true})
+	if plan.HasChanges() {
+		var out bytes.Buffer
+		plan.Render(&out, false)
+		t.Errorf("expected no changes, got:\n%s", out.String())
+	}
+}
+
+func TestParseAnnotated_errors(t *testing.T) {
+	tests := map[string]string{
+		"syntax":         "region = \n",
+		"unknown marker": "# @secret\nregion = \"a\"\n",
+		"hcl unmarked":   "zones = [\"a\"]\n",
+	}
+
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := varfile.ParseAnnotated("vars.tfvars", []byte(data)); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package varfile
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+// ValidateHCL checks that value, named filename in diagnostics, is a valid
+// HCL expression to be used as the value of an HCL variable. The returned
+// error shows each problem with its position in the value.
+func ValidateHCL(filename, value string) error {
+	// An empty HCL variable is null.
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+
+	src := []byte(value)
+
+	_, diags := hclsyntax.ParseExpression(src, filename, hcl.InitialPos)
+	if !diags.HasErrors() {
+		return nil
+	}
+
+	var b strings.Builder
+	files := map[string]*hcl.File{filename: {Bytes: src}}
+	if err := hcl.NewDiagnosticTextWriter(&b, files, 0, false).WriteDiagnostics(diags); err != nil {
+		return diags
+	}
+
+	return fmt.Errorf("invalid HCL value at %s\n%s", diags[0].Subject, strings.TrimRight(b.String(), "\n"))
+}
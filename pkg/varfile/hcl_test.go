@@ -0,0 +1,42 @@
+package varfile_test
+
+import (
+	"testing"
+
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/varfile"
+)
+
+func TestValidateHCL(t *testing.T) {
+	for _, value := range []string{
+		``,
+		`"us-east-1"`,
+		`["a", "b"]`,
+		"{\n  a = [1, 2]\n  b = { c = true }\n}",
+	} {
+		if err := varfile.ValidateHCL("value", value); err != nil {
+			t.Errorf("ValidateHCL(%q) = %v, want nil", value, err)
+		}
+	}
+}
+
+func TestValidateHCL_error(t *testing.T) {
+	err := varfile.ValidateHCL("tags", "{\n  a = [1, 2\n}")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	want := text.Heredoc(`
+		invalid HCL value at tags:3,1-2
+		Error: Missing item separator
+
+		  on tags line 3:
+		   2:   a = [1, 2
+		   3: }
+
+		Expected a comma to mark the beginning of the next item.
+	`)
+	if got := err.Error() + "\n"; got != want {
+		t.Errorf("got error:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,276 @@
+// Package varfile reads and writes workspace variables as .tfvars, .env and
+// JSON files.
+package varfile
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/zkhvan/tfc/pkg/dotenv"
+	"github.com/zkhvan/tfc/pkg/ptr"
+	"github.com/zkhvan/tfc/pkg/varplan"
+)
+
+const (
+	FormatTFVars = "tfvars"
+	FormatEnv    = "env"
+	FormatJSON   = "json"
+
+	// FormatShell is only written, as export statements to source in a shell.
+	FormatShell = "shell"
+)
+
+// SensitivePlaceholder stands in for the value of a sensitive variable, which
+// can't be read back from the API. Commented placeholders are parsed back as
+// placeholder variables so that importing an export keeps them as they are.
+const SensitivePlaceholder = "<sensitive>"
+
+var placeholderRegexp = regexp.MustCompile(`^#\s*(?:export\s+)?([A-Za-z_][A-Za-z0-9_-]*)\s*=\s*<sensitive>\s*$`)
+
+// DetectFormat returns the format of a file based on its name.
+func DetectFormat(filename string) (string, error) {
+	base := filepath.Base(filename)
+	switch {
+	case strings.HasSuffix(base, ".tfvars"), strings.HasSuffix(base, ".hcl"):
+		return FormatTFVars, nil
+	case strings.HasSuffix(base, ".json"):
+		return FormatJSON, nil
+	case base == ".env", strings.HasSuffix(base, ".env"), strings.HasPrefix(base, ".env."):
+		return FormatEnv, nil
+	default:
+		return "", fmt.Errorf("unknown format of %s: expected a .tfvars, .env or .json file", filename)
+	}
+}
+
+// Parse parses the variables of a file in the given format.
+//
+// Variables from .tfvars files are terraform variables; strings, numbers and
+// bools are kept as plain values while lists, maps and other expressions are
+// kept as HCL, as are values preceded by a "# @hcl" marker comment. Variables
+// from .env files are env variables, the last value of a key set more than
+// once wins.
+//
+// JSON files are either an object of terraform variables, like .tfvars.json,
+// or a list of variable objects with key, value, category, hcl, sensitive and
+// description fields.
+func Parse(filename string, data []byte, format string) ([]varplan.Variable, error) {
+	switch format {
+	case FormatTFVars:
+		return parseTFVars(filename, data)
+	case FormatEnv:
+		return parseEnv(data)
+	case FormatJSON:
+		return parseJSON(data)
+	default:
+		return nil, fmt.Errorf("unknown format %q", format)
+	}
+}
+
+func parseTFVars(filename string, data []byte) ([]varplan.Variable, error) {
+	file, diags := hclsyntax.ParseConfig(data, filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	attrs, diags := file.Body.JustAttributes()
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	lines := strings.Split(string(data), "\n")
+
+	vars := make([]varplan.Variable, 0, len(attrs))
+	for name, attr := range attrs {
+		v := varplan.Variable{
+			Key:      name,
+			Category: tfe.CategoryTerraform,
+		}
+
+		v.Value, v.HCL = exprValue(attr.Expr, data)
+
+		// The line before a value written by Write is its @hcl marker, if
+		// it's a string, number or bool that must stay HCL.
+		if line := attr.Range.Start.Line; line >= 2 && strings.TrimSpace(lines[line-2]) == "# "+MarkerHCL {
+			v.Value = strings.TrimSpace(string(attr.Expr.Range().SliceBytes(data)))
+			v.HCL = true
+		}
+
+		vars = append(vars, v)
+	}
+
+	// Attributes are returned as a map, keep the order of the file instead.
+	slices.SortFunc(vars, func(a, b varplan.Variable) int {
+		return attrs[a.Key].Range.Start.Byte - attrs[b.Key].Range.Start.Byte
+	})
+
+	return append(vars, placeholders(data, tfe.CategoryTerraform)...), nil
+}
+
+// exprValue returns the value of an expression and whether it must be stored
+// as HCL.
+func exprValue(expr hcl.Expression, src []byte) (string, bool) {
+	source := strings.TrimSpace(string(expr.Range().SliceBytes(src)))
+
+	val, diags := expr.Value(nil)
+	if diags.HasErrors() || !val.IsWhollyKnown() || val.IsNull() {
+		return source, true
+	}
+
+	switch val.Type() {
+	case cty.String:
+		return val.AsString(), false
+	case cty.Number:
+		return val.AsBigFloat().Text('f', -1), false
+	case cty.Bool:
+		if val.True() {
+			return "true", false
+		}
+		return "false", false
+	default:
+		return source, true
+	}
+}
+
+func parseEnv(data []byte) ([]varplan.Variable, error) {
+	entries, err := dotenv.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// A key set more than once takes its last value, like in a shell.
+	vars := make([]varplan.Variable, 0, len(entries))
+	index := make(map[string]int, len(entries))
+	for _, e := range entries {
+		if i, ok := index[e.Key]; ok {
+			vars[i].Value = e.Value
+			continue
+		}
+
+		index[e.Key] = len(vars)
+		vars = append(vars, varplan.Variable{
+			Key:      e.Key,
+			Value:    e.Value,
+			Category: tfe.CategoryEnv,
+		})
+	}
+
+	return append(vars, placeholders(data, tfe.CategoryEnv)...), nil
+}
+
+// placeholders returns the commented sensitive placeholders of a file.
+func placeholders(data []byte, category tfe.CategoryType) []varplan.Variable {
+	var vars []varplan.Variable
+	for _, line := range strings.Split(string(data), "\n") {
+		m := placeholderRegexp.FindStringSubmatch(strings.TrimSpace(line))
+		if m == nil {
+			continue
+		}
+
+		vars = append(vars, varplan.Variable{
+			Key:         m[1],
+			Category:    category,
+			Sensitive:   true,
+			Placeholder: true,
+		})
+	}
+	return vars
+}
+
+// Variable is the JSON representation of a variable. The value of a sensitive
+// variable is omitted, which makes it a placeholder.
+type Variable struct {
+	Key         string  `json:"key"`
+	Value       *string `json:"value,omitempty"`
+	Category    string  `json:"category,omitempty"`
+	HCL         bool    `json:"hcl,omitempty"`
+	Sensitive   bool    `json:"sensitive,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+func parseJSON(data []byte) ([]varplan.Variable, error) {
+	data = bytes.TrimSpace(data)
+	if bytes.HasPrefix(data, []byte("[")) {
+		var list []Variable
+		if err := json.Unmarshal(data, &list); err != nil {
+			return nil, err
+		}
+
+		vars := make([]varplan.Variable, 0, len(list))
+		for _, v := range list {
+			category := tfe.CategoryType(v.Category)
+			if category == "" {
+				category = tfe.CategoryTerraform
+			}
+			if category != tfe.CategoryTerraform && category != tfe.CategoryEnv {
+				return nil, fmt.Errorf("variable %q: invalid category %q", v.Key, v.Category)
+			}
+
+			vars = append(vars, varplan.Variable{
+				Key:         v.Key,
+				Value:       ptr.Deref(v.Value),
+				Category:    category,
+				HCL:         v.HCL,
+				Sensitive:   v.Sensitive,
+				Description: v.Description,
+				Placeholder: v.Sensitive && v.Value == nil,
+			})
+		}
+		return vars, nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	// Read the object token by token to keep the order of the keys.
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, fmt.Errorf("expected a JSON object or list of variables")
+	}
+
+	var vars []varplan.Variable
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, _ := tok.(string)
+
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return nil, fmt.Errorf("variable %q: %w", key, err)
+		}
+
+		v := varplan.Variable{
+			Key:      key,
+			Category: tfe.CategoryTerraform,
+		}
+
+		var s string
+		switch {
+		case json.Unmarshal(raw, &s) == nil:
+			v.Value = s
+		case bytes.HasPrefix(raw, []byte("{")), bytes.HasPrefix(raw, []byte("[")):
+			// JSON objects and lists are valid HCL expressions.
+			var buf bytes.Buffer
+			if err := json.Compact(&buf, raw); err != nil {
+				return nil, err
+			}
+			v.Value, v.HCL = buf.String(), true
+		default:
+			v.Value = string(raw)
+		}
+
+		vars = append(vars, v)
+	}
+
+	return vars, nil
+}
@@ -0,0 +1,128 @@
+package varfile_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/varfile"
+	"github.com/zkhvan/tfc/pkg/varplan"
+)
+
+func TestParse_tfvars(t *testing.T) {
+	data := text.Heredoc(`
+		region   = "us-east-1"
+		replicas = 3
+		enabled  = true
+		zones    = ["a", "b"]
+		tags = {
+		  team = "platform"
+		}
+	`)
+
+	vars, err := varfile.Parse("prod.tfvars", []byte(data), varfile.FormatTFVars)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertVars(t, vars, text.Heredoc(`
+		region terraform hcl=false "us-east-1"
+		replicas terraform hcl=false "3"
+		enabled terraform hcl=false "true"
+		zones terraform hcl=true "[\"a\", \"b\"]"
+		tags terraform hcl=true "{\n  team = \"platform\"\n}"
+	`))
+}
+
+func TestParse_tfvars_error(t *testing.T) {
+	_, err := varfile.Parse("prod.tfvars", []byte("region = \n"), varfile.FormatTFVars)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestParse_env(t *testing.T) {
+	vars, err := varfile.Parse(".env", []byte("AWS_REGION=us-east-1\n"), varfile.FormatEnv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertVars(t, vars, `AWS_REGION env hcl=false "us-east-1"`+"\n")
+}
+
+func TestParse_env_duplicate(t *testing.T) {
+	data := text.Heredoc(`
+		AWS_REGION=us-east-1
+		LOG_LEVEL=info
+		AWS_REGION=eu-west-1
+	`)
+
+	vars, err := varfile.Parse(".env", []byte(data), varfile.FormatEnv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertVars(t, vars, text.Heredoc(`
+		AWS_REGION env hcl=false "eu-west-1"
+		LOG_LEVEL env hcl=false "info"
+	`))
+}
+
+func TestParse_json_object(t *testing.T) {
+	data := `{"region": "us-east-1", "replicas": 3, "zones": ["a", "b"]}`
+
+	vars, err := varfile.Parse("prod.tfvars.json", []byte(data), varfile.FormatJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertVars(t, vars, text.Heredoc(`
+		region terraform hcl=false "us-east-1"
+		replicas terraform hcl=false "3"
+		zones terraform hcl=true "[\"a\",\"b\"]"
+	`))
+}
+
+func TestParse_json_list(t *testing.T) {
+	data := `[{"key": "TOKEN", "value": "", "category": "env", "sensitive": true}]`
+
+	vars, err := varfile.Parse("vars.json", []byte(data), varfile.FormatJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(vars) != 1 || !vars[0].Sensitive {
+		t.Fatalf("got %+v", vars)
+	}
+	assertVars(t, vars, `TOKEN env hcl=false ""`+"\n")
+}
+
+func TestDetectFormat(t *testing.T) {
+	tests := map[string]string{
+		"prod.tfvars":      varfile.FormatTFVars,
+		"prod.tfvars.json": varfile.FormatJSON,
+		".env":             varfile.FormatEnv,
+		".env.production":  varfile.FormatEnv,
+		"prod.env":         varfile.FormatEnv,
+	}
+
+	for name, want := range tests {
+		got, err := varfile.DetectFormat(name)
+		if err != nil || got != want {
+			t.Errorf("DetectFormat(%q) got %q, %v, want %q", name, got, err, want)
+		}
+	}
+}
+
+func assertVars(t *testing.T, vars []varplan.Variable, want string) {
+	t.Helper()
+
+	var got string
+	for _, v := range vars {
+		got += fmt.Sprintf("%s %s hcl=%t %q\n", v.Key, v.Category, v.HCL, v.Value)
+	}
+
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,176 @@
+package varfile
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/ptr"
+)
+
+// Write writes variables in the given format, sorted by key.
+//
+// The tfvars format only writes terraform variables and the env format only
+// writes env variables. The shell format writes env variables as they are and
+// terraform variables as TF_VAR_ variables. The JSON format writes every
+// variable as a list of variable objects.
+//
+// Sensitive variables are written as commented placeholders, or without a
+// value in JSON, since their values can't be read back.
+func Write(w io.Writer, vars []*tfc.Variable, format string) error {
+	vars = slices.Clone(vars)
+	slices.SortStableFunc(vars, func(a, b *tfc.Variable) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	switch format {
+	case FormatTFVars:
+		return writeTFVars(w, vars)
+	case FormatEnv:
+		return writeEnv(w, vars)
+	case FormatShell:
+		return writeShell(w, vars)
+	case FormatJSON:
+		return writeJSON(w, vars)
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+}
+
+func writeTFVars(w io.Writer, vars []*tfc.Variable) error {
+	for _, v := range vars {
+		if v.Category != tfe.CategoryTerraform {
+			continue
+		}
+		if !hclsyntax.ValidIdentifier(v.Key) {
+			return fmt.Errorf("variable %q is not a valid identifier", v.Key)
+		}
+
+		writeDescription(w, v)
+
+		// Strings, numbers and bools are parsed back as plain values, keep
+		// them HCL with a marker.
+		if v.HCL && !v.Sensitive && isLiteral(v.Value) {
+			fmt.Fprintf(w, "# %s\n", MarkerHCL)
+		}
+
+		switch {
+		case v.Sensitive:
+			fmt.Fprintf(w, "# %s = %s\n", v.Key, SensitivePlaceholder)
+		case v.HCL && strings.TrimSpace(v.Value) == "":
+			fmt.Fprintf(w, "%s = null\n", v.Key)
+		case v.HCL:
+			fmt.Fprintf(w, "%s = %s\n", v.Key, strings.TrimSpace(v.Value))
+		default:
+			fmt.Fprintf(w, "%s = %s\n", v.Key, hclwrite.TokensForValue(cty.StringVal(v.Value)).Bytes())
+		}
+	}
+	return nil
+}
+
+// isLiteral reports whether an HCL value is a string, number or bool that
+// parseTFVars reads as a plain value.
+func isLiteral(value string) bool {
+	src := []byte(strings.TrimSpace(value))
+
+	expr, diags := hclsyntax.ParseExpression(src, "", hcl.InitialPos)
+	if diags.HasErrors() {
+		return false
+	}
+
+	_, isHCL := exprValue(expr, src)
+	return !isHCL
+}
+
+// unquotedEnvValue matches values that can be written in .env files and
+// shells without quotes.
+var unquotedEnvValue = regexp.MustCompile(`^[A-Za-z0-9_./:@%+,=-]*$`)
+
+func writeEnv(w io.Writer, vars []*tfc.Variable) error {
+	for _, v := range vars {
+		if v.Category != tfe.CategoryEnv {
+			continue
+		}
+
+		writeDescription(w, v)
+
+		switch {
+		case v.Sensitive:
+			fmt.Fprintf(w, "# %s=%s\n", v.Key, SensitivePlaceholder)
+		case unquotedEnvValue.MatchString(v.Value):
+			fmt.Fprintf(w, "%s=%s\n", v.Key, v.Value)
+		default:
+			fmt.Fprintf(w, "%s=%s\n", v.Key, strconv.Quote(v.Value))
+		}
+	}
+	return nil
+}
+
+func writeShell(w io.Writer, vars []*tfc.Variable) error {
+	for _, v := range vars {
+		key := v.Key
+		if v.Category == tfe.CategoryTerraform {
+			key = "TF_VAR_" + key
+		}
+
+		writeDescription(w, v)
+
+		switch {
+		case v.Sensitive:
+			fmt.Fprintf(w, "# export %s=%s\n", key, SensitivePlaceholder)
+		case unquotedEnvValue.MatchString(v.Value):
+			fmt.Fprintf(w, "export %s=%s\n", key, v.Value)
+		default:
+			fmt.Fprintf(w, "export %s=%s\n", key, shellQuote(v.Value))
+		}
+	}
+	return nil
+}
+
+// shellQuote wraps a value in single quotes, which are taken literally by
+// POSIX shells, escaping the single quotes in the value.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+func writeDescription(w io.Writer, v *tfc.Variable) {
+	for _, line := range strings.Split(strings.TrimSpace(v.Description), "\n") {
+		if line != "" {
+			fmt.Fprintf(w, "# %s\n", line)
+		}
+	}
+}
+
+func writeJSON(w io.Writer, vars []*tfc.Variable) error {
+	out := make([]Variable, 0, len(vars))
+	for _, v := range vars {
+		jv := Variable{
+			Key:       v.Key,
+			Category:  string(v.Category),
+			HCL:       v.HCL,
+			Sensitive: v.Sensitive,
+		}
+		if !v.Sensitive {
+			jv.Value = ptr.String(v.Value)
+		}
+		if v.Description != "" {
+			jv.Description = ptr.String(v.Description)
+		}
+		out = append(out, jv)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
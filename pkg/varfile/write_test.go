@@ -0,0 +1,101 @@
+package varfile_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+
+	"github.com/zkhvan/tfc/internal/test"
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/varfile"
+	"github.com/zkhvan/tfc/pkg/varplan"
+)
+
+var exportVars = []*tfc.Variable{
+	{ID: "var-1", Key: "region", Value: "us-east-1", Category: tfe.CategoryTerraform, Description: "AWS region"},
+	{ID: "var-2", Key: "zones", Value: `["a", "b"]`, Category: tfe.CategoryTerraform, HCL: true},
+	{ID: "var-3", Key: "motd", Value: "say \"hi\" ${name}", Category: tfe.CategoryTerraform},
+	{ID: "var-4", Key: "db_password", Category: tfe.CategoryTerraform, Sensitive: true},
+	{ID: "var-5", Key: "LOG_LEVEL", Value: "debug", Category: tfe.CategoryEnv},
+	{ID: "var-6", Key: "GREETING", Value: "it's me", Category: tfe.CategoryEnv},
+	{ID: "var-7", Key: "API_TOKEN", Category: tfe.CategoryEnv, Sensitive: true},
+	{ID: "var-8", Key: "replicas", Value: "3", Category: tfe.CategoryTerraform, HCL: true},
+	{ID: "var-9", Key: "prefix", Value: `"app"`, Category: tfe.CategoryTerraform, HCL: true},
+}
+
+func TestWrite_tfvars(t *testing.T) {
+	var buf bytes.Buffer
+	if err := varfile.Write(&buf, exportVars, varfile.FormatTFVars); err != nil {
+		t.Fatal(err)
+	}
+
+	test.Buffer(t, &buf, text.Heredoc(`
+		# db_password = <sensitive>
+		motd = "say \"hi\" $${name}"
+		# @hcl
+		prefix = "app"
+		# AWS region
+		region = "us-east-1"
+		# @hcl
+		replicas = 3
+		zones = ["a", "b"]
+	`))
+}
+
+func TestWrite_env(t *testing.T) {
+	var buf bytes.Buffer
+	if err := varfile.Write(&buf, exportVars, varfile.FormatEnv); err != nil {
+		t.Fatal(err)
+	}
+
+	test.Buffer(t, &buf, text.Heredoc(`
+		# API_TOKEN=<sensitive>
+		GREETING="it's me"
+		LOG_LEVEL=debug
+	`))
+}
+
+func TestWrite_shell(t *testing.T) {
+	var buf bytes.Buffer
+	if err := varfile.Write(&buf, exportVars, varfile.FormatShell); err != nil {
+		t.Fatal(err)
+	}
+
+	test.Buffer(t, &buf, text.Heredoc(`
+		# export API_TOKEN=<sensitive>
+		export GREETING='it'\''s me'
+		export LOG_LEVEL=debug
+		# export TF_VAR_db_password=<sensitive>
+		export TF_VAR_motd='say "hi" ${name}'
+		export TF_VAR_prefix='"app"'
+		# AWS region
+		export TF_VAR_region=us-east-1
+		export TF_VAR_replicas=3
+		export TF_VAR_zones='["a", "b"]'
+	`))
+}
+
+func TestWrite_round_trip(t *testing.T) {
+	for _, format := range []string{varfile.FormatTFVars, varfile.FormatEnv, varfile.FormatJSON} {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := varfile.Write(&buf, exportVars, format); err != nil {
+				t.Fatal(err)
+			}
+
+			vars, err := varfile.Parse("vars", buf.Bytes(), format)
+			if err != nil {
+				t.Fatalf("failed to parse export: %v\n%s", err, buf.String())
+			}
+
+			plan := varplan.New(exportVars, vars, varplan.Options{ForceSensitive: true})
+			if plan.HasChanges() {
+				var out bytes.Buffer
+				plan.Render(&out, false)
+				t.Errorf("expected no changes, got:\n%s", out.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,362 @@
+// Package varplan computes and applies the changes needed to bring a
+// workspace's variables in line with a desired set of variables.
+package varplan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/hashicorp/go-tfe"
+
+	"github.com/zkhvan/tfc/internal/tfc"
+	"github.com/zkhvan/tfc/pkg/ptr"
+	"github.com/zkhvan/tfc/pkg/term/color"
+)
+
+// Variable is the desired state of a variable.
+type Variable struct {
+	Key       string
+	Value     string
+	Category  tfe.CategoryType
+	HCL       bool
+	Sensitive bool
+
+	// Description is left unchanged when nil.
+	Description *string
+
+	// Placeholder marks a sensitive variable whose value isn't known, like
+	// the commented placeholders written by an export. Placeholders keep the
+	// value of an existing sensitive variable, only updating its other
+	// attributes, and are never created.
+	Placeholder bool
+}
+
+// FromVariables converts the variables of another workspace into desired
+// variables for a workspace with the current variables.
+//
+// The values of sensitive variables can't be read back, so sensitive
+// variables are compared by presence only: when either side is sensitive and
+// the variable exists in both, it becomes a placeholder and its value is left
+// alone.
+func FromVariables(vars, current []*tfc.Variable) []Variable {
+	type id struct {
+		key      string
+		category tfe.CategoryType
+	}
+
+	existing := make(map[id]*tfc.Variable, len(current))
+	for _, v := range current {
+		existing[id{v.Key, v.Category}] = v
+	}
+
+	desired := make([]Variable, 0, len(vars))
+	for _, v := range vars {
+		cur, ok := existing[id{v.Key, v.Category}]
+		desired = append(desired, Variable{
+			Key:         v.Key,
+			Value:       v.Value,
+			Category:    v.Category,
+			HCL:         v.HCL,
+			Sensitive:   v.Sensitive,
+			Description: ptr.String(v.Description),
+			Placeholder: ok && (v.Sensitive || cur.Sensitive),
+		})
+	}
+	return desired
+}
+
+type Action string
+
+const (
+	ActionCreate    Action = "create"
+	ActionUpdate    Action = "update"
+	ActionDelete    Action = "delete"
+	ActionUnchanged Action = "unchanged"
+
+	// ActionUnknown is used for sensitive variables whose value can't be
+	// read back, so it's unknown whether they differ.
+	ActionUnknown Action = "unknown"
+)
+
+var (
+	CreateStyle    = lipgloss.NewStyle().Foreground(color.Green)
+	UpdateStyle    = lipgloss.NewStyle().Foreground(color.Yellow)
+	DeleteStyle    = lipgloss.NewStyle().Foreground(color.Red)
+	UnknownStyle   = lipgloss.NewStyle().Foreground(color.Magenta)
+	UnchangedStyle = lipgloss.NewStyle().Faint(true)
+)
+
+// Change is a single planned change to a variable.
+type Change struct {
+	Action  Action
+	Key     string
+	Current *tfc.Variable
+	Desired *Variable
+
+	// Fields lists the attributes that differ for an update.
+	Fields []string
+}
+
+// Category returns the category of the changed variable.
+func (c Change) Category() tfe.CategoryType {
+	if c.Desired != nil {
+		return c.Desired.Category
+	}
+	return c.Current.Category
+}
+
+// Options configures how a plan is computed.
+type Options struct {
+	// Prune deletes current variables that aren't desired.
+	Prune bool
+
+	// PruneCategories limits pruning to variables of these categories. Every
+	// category is pruned when empty.
+	PruneCategories []tfe.CategoryType
+
+	// ForceSensitive updates sensitive variables even though it's unknown
+	// whether their value differs.
+	ForceSensitive bool
+}
+
+// Plan is the list of changes needed to reach the desired variables.
+type Plan struct {
+	Changes []Change
+}
+
+// New computes the plan to turn the current variables into the desired ones.
+// Variables are matched by key and category. Changes of the same key are
+// ordered so they can be applied in turn.
+func New(current []*tfc.Variable, desired []Variable, opts Options) *Plan {
+	type id struct {
+		key      string
+		category tfe.CategoryType
+	}
+
+	existing := make(map[id]*tfc.Variable, len(current))
+	for _, v := range current {
+		existing[id{v.Key, v.Category}] = v
+	}
+
+	plan := &Plan{}
+	seen := make(map[id]bool, len(desired))
+	for i := range desired {
+		d := &desired[i]
+		k := id{d.Key, d.Category}
+		seen[k] = true
+
+		cur, ok := existing[k]
+		if d.Placeholder {
+			if ok {
+				change := Change{Action: ActionUnchanged, Key: d.Key, Current: cur, Desired: d}
+				if cur.Sensitive && d.Sensitive {
+					change.Fields = diffFields(cur, d)
+				}
+				if len(change.Fields) > 0 {
+					change.Action = ActionUpdate
+				}
+				plan.Changes = append(plan.Changes, change)
+			}
+			continue
+		}
+		if !ok {
+			plan.Changes = append(plan.Changes, Change{Action: ActionCreate, Key: d.Key, Desired: d})
+			continue
+		}
+		if cur.Sensitive && !d.Sensitive {
+			// A sensitive variable can't be made non-sensitive, so it's
+			// deleted and created again instead.
+			plan.Changes = append(plan.Changes,
+				Change{Action: ActionDelete, Key: d.Key, Current: cur},
+				Change{Action: ActionCreate, Key: d.Key, Desired: d},
+			)
+			continue
+		}
+
+		change := Change{Action: ActionUnchanged, Key: d.Key, Current: cur, Desired: d}
+		change.Fields = diffFields(cur, d)
+		if cur.Sensitive && opts.ForceSensitive {
+			change.Fields = append([]string{"value"}, change.Fields...)
+		}
+
+		switch {
+		case len(change.Fields) > 0:
+			change.Action = ActionUpdate
+		case cur.Sensitive:
+			change.Action = ActionUnknown
+		}
+
+		plan.Changes = append(plan.Changes, change)
+	}
+
+	if opts.Prune {
+		for _, v := range current {
+			if len(opts.PruneCategories) > 0 && !slices.Contains(opts.PruneCategories, v.Category) {
+				continue
+			}
+			if !seen[id{v.Key, v.Category}] {
+				plan.Changes = append(plan.Changes, Change{Action: ActionDelete, Key: v.Key, Current: v})
+			}
+		}
+	}
+
+	slices.SortStableFunc(plan.Changes, func(a, b Change) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	return plan
+}
+
+// diffFields returns the attributes of a variable that differ. The value of a
+// sensitive variable can't be read back, so it's only compared when the
+// variable is currently not sensitive.
+func diffFields(cur *tfc.Variable, d *Variable) []string {
+	var fields []string
+	if !cur.Sensitive && cur.Value != d.Value {
+		fields = append(fields, "value")
+	}
+	if cur.HCL != d.HCL {
+		fields = append(fields, "hcl")
+	}
+	if cur.Sensitive != d.Sensitive {
+		fields = append(fields, "sensitive")
+	}
+	if d.Description != nil && cur.Description != *d.Description {
+		fields = append(fields, "description")
+	}
+	return fields
+}
+
+// Count returns the number of changes with the given action.
+func (p *Plan) Count(action Action) int {
+	n := 0
+	for _, c := range p.Changes {
+		if c.Action == action {
+			n++
+		}
+	}
+	return n
+}
+
+// HasChanges returns true if applying the plan would change anything.
+func (p *Plan) HasChanges() bool {
+	return p.Count(ActionCreate)+p.Count(ActionUpdate)+p.Count(ActionDelete) > 0
+}
+
+// Render writes the plan in a format similar to Terraform's plan output,
+// followed by a summary.
+func (p *Plan) Render(w io.Writer, showUnchanged bool) {
+	p.RenderChanges(w, showUnchanged)
+
+	summary := fmt.Sprintf("Plan: %d to create, %d to update, %d to delete, %d unchanged.",
+		p.Count(ActionCreate), p.Count(ActionUpdate), p.Count(ActionDelete), p.Count(ActionUnchanged),
+	)
+	if n := p.Count(ActionUnknown); n > 0 {
+		summary += fmt.Sprintf(" %d sensitive unknown.", n)
+	}
+	fmt.Fprintln(w, summary)
+}
+
+// RenderChanges writes the changes of the plan, followed by a blank line if
+// there are any.
+func (p *Plan) RenderChanges(w io.Writer, showUnchanged bool) {
+	for _, c := range p.Changes {
+		name := fmt.Sprintf("%s (%s)", c.Key, c.Category())
+
+		switch c.Action {
+		case ActionCreate:
+			fmt.Fprintln(w, CreateStyle.Render(fmt.Sprintf("  + %s = %s", name, displayValue(c.Desired))))
+		case ActionUpdate:
+			line := fmt.Sprintf("  ~ %s", name)
+			if slices.Contains(c.Fields, "value") {
+				line += fmt.Sprintf(" = %s -> %s", displayCurrentValue(c.Current), displayValue(c.Desired))
+			}
+			for _, f := range c.Fields {
+				switch f {
+				case "hcl":
+					line += fmt.Sprintf(", hcl: %t -> %t", c.Current.HCL, c.Desired.HCL)
+				case "sensitive":
+					line += fmt.Sprintf(", sensitive: %t -> %t", c.Current.Sensitive, c.Desired.Sensitive)
+				case "description":
+					line += fmt.Sprintf(", description: %q -> %q", c.Current.Description, *c.Desired.Description)
+				}
+			}
+			fmt.Fprintln(w, UpdateStyle.Render(line))
+		case ActionDelete:
+			fmt.Fprintln(w, DeleteStyle.Render(fmt.Sprintf("  - %s", name)))
+		case ActionUnknown:
+			fmt.Fprintln(w, UnknownStyle.Render(fmt.Sprintf("  ? %s = (sensitive value, unknown)", name)))
+		case ActionUnchanged:
+			if showUnchanged {
+				fmt.Fprintln(w, UnchangedStyle.Render(fmt.Sprintf("    %s", name)))
+			}
+		}
+	}
+
+	if len(p.Changes) > 0 {
+		fmt.Fprintln(w)
+	}
+}
+
+// Apply applies the plan's creates, updates and deletes to a workspace.
+// Updates only send the value when it changes. Every change is attempted and
+// the errors are returned together.
+func (p *Plan) Apply(ctx context.Context, client *tfc.Client, workspaceID string) error {
+	var errs []error
+	for _, c := range p.Changes {
+		var err error
+		switch c.Action {
+		case ActionCreate:
+			d := c.Desired
+			_, err = client.Variables.Create(ctx, workspaceID, tfe.VariableCreateOptions{
+				Key:         ptr.String(d.Key),
+				Value:       ptr.String(d.Value),
+				Description: d.Description,
+				Category:    &d.Category,
+				HCL:         ptr.Bool(d.HCL),
+				Sensitive:   ptr.Bool(d.Sensitive),
+			})
+		case ActionUpdate:
+			d := c.Desired
+			update := tfe.VariableUpdateOptions{
+				Description: d.Description,
+				Category:    &d.Category,
+				HCL:         ptr.Bool(d.HCL),
+				Sensitive:   ptr.Bool(d.Sensitive),
+			}
+			if slices.Contains(c.Fields, "value") {
+				update.Value = ptr.String(d.Value)
+			}
+			_, err = client.Variables.Update(ctx, workspaceID, c.Current.ID, update)
+		case ActionDelete:
+			err = client.Variables.Delete(ctx, workspaceID, c.Current.ID)
+		default:
+			continue
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to %s variable %q: %w", c.Action, c.Key, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func displayValue(v *Variable) string {
+	if v.Sensitive {
+		return "(sensitive value)"
+	}
+	if v.HCL {
+		return v.Value
+	}
+	return fmt.Sprintf("%q", v.Value)
+}
+
+func displayCurrentValue(v *tfc.Variable) string {
+	return displayValue(&Variable{Value: v.Value, HCL: v.HCL, Sensitive: v.Sensitive})
+}
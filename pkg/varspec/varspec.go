@@ -0,0 +1,225 @@
+// Package varspec reads declarative YAML files of workspace variables.
+//
+// A spec file lists the variables a workspace should have:
+//
+//	variables:
+//	  - key: region
+//	    value: us-east-1
+//	  - key: zones
+//	    value: ["a", "b"]
+//	  - key: AWS_SECRET_ACCESS_KEY
+//	    category: env
+//	    sensitive: true
+//	    value_from:
+//	      env: AWS_SECRET_ACCESS_KEY
+//
+// Values can also be read from a file, relative to the spec file, or from
+// the output of a command.
+package varspec
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"gopkg.in/yaml.v3"
+
+	"github.com/zkhvan/tfc/pkg/varplan"
+)
+
+// File is a spec file.
+type File struct {
+	Variables []Variable `yaml:"variables"`
+}
+
+// Variable is the spec of a single variable.
+type Variable struct {
+	Key         string     `yaml:"key"`
+	Value       yaml.Node  `yaml:"value"`
+	ValueFrom   *ValueFrom `yaml:"value_from"`
+	Category    string     `yaml:"category"`
+	HCL         bool       `yaml:"hcl"`
+	Sensitive   bool       `yaml:"sensitive"`
+	Description *string    `yaml:"description"`
+}
+
+// ValueFrom reads a value from exactly one source.
+type ValueFrom struct {
+	// Env is the name of an environment variable.
+	Env string `yaml:"env"`
+
+	// File is the path of a file, relative to the spec file.
+	File string `yaml:"file"`
+
+	// Command is a shell command whose output is the value.
+	Command string `yaml:"command"`
+}
+
+// Load reads and resolves a spec file.
+func Load(ctx context.Context, filename string) ([]varplan.Variable, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	vars, err := Parse(ctx, data, filepath.Dir(filename))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	return vars, nil
+}
+
+// Parse parses a spec and resolves the values of its variables. Files are
+// read relative to dir.
+func Parse(ctx context.Context, data []byte, dir string) ([]varplan.Variable, error) {
+	var file File
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&file); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	type id struct {
+		key      string
+		category tfe.CategoryType
+	}
+	seen := make(map[id]bool, len(file.Variables))
+
+	vars := make([]varplan.Variable, 0, len(file.Variables))
+	for i, spec := range file.Variables {
+		if spec.Key == "" {
+			return nil, fmt.Errorf("variable %d: key is required", i+1)
+		}
+
+		v, err := spec.resolve(ctx, dir)
+		if err != nil {
+			return nil, fmt.Errorf("variable %q: %w", spec.Key, err)
+		}
+
+		k := id{v.Key, v.Category}
+		if seen[k] {
+			return nil, fmt.Errorf("variable %q: duplicate %s variable", v.Key, v.Category)
+		}
+		seen[k] = true
+
+		vars = append(vars, v)
+	}
+
+	return vars, nil
+}
+
+func (spec Variable) resolve(ctx context.Context, dir string) (varplan.Variable, error) {
+	v := varplan.Variable{
+		Key:         spec.Key,
+		Category:    tfe.CategoryTerraform,
+		HCL:         spec.HCL,
+		Sensitive:   spec.Sensitive,
+		Description: spec.Description,
+	}
+
+	switch spec.Category {
+	case "", string(tfe.CategoryTerraform):
+	case string(tfe.CategoryEnv):
+		v.Category = tfe.CategoryEnv
+	default:
+		return v, fmt.Errorf("invalid category %q: must be one of terraform, env", spec.Category)
+	}
+
+	hasValue := !spec.Value.IsZero()
+	if hasValue && spec.ValueFrom != nil {
+		return v, fmt.Errorf("value and value_from are mutually exclusive")
+	}
+
+	var err error
+	switch {
+	case spec.ValueFrom != nil:
+		v.Value, err = spec.ValueFrom.read(ctx, dir)
+	case hasValue:
+		v.Value, err = nodeValue(&spec.Value, &v.HCL)
+	case spec.Sensitive:
+		// The value of a sensitive variable can't be read back, so without
+		// one the existing value is kept.
+		v.Placeholder = true
+	}
+
+	return v, err
+}
+
+// nodeValue returns the value of a YAML node. Lists and maps are converted to
+// JSON, which is valid HCL, and mark the variable as HCL.
+func nodeValue(node *yaml.Node, hcl *bool) (string, error) {
+	if node.Kind == yaml.ScalarNode {
+		if node.Tag == "!!null" {
+			return "", nil
+		}
+		return node.Value, nil
+	}
+
+	var value any
+	if err := node.Decode(&value); err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("value can't be converted to HCL: %w", err)
+	}
+
+	*hcl = true
+	return string(data), nil
+}
+
+func (from *ValueFrom) read(ctx context.Context, dir string) (string, error) {
+	sources := 0
+	for _, s := range []string{from.Env, from.File, from.Command} {
+		if s != "" {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return "", fmt.Errorf("value_from requires exactly one of env, file or command")
+	}
+
+	switch {
+	case from.Env != "":
+		value, ok := os.LookupEnv(from.Env)
+		if !ok {
+			return "", fmt.Errorf("environment variable %s is not set", from.Env)
+		}
+		return value, nil
+
+	case from.File != "":
+		path := from.File
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSuffix(string(data), "\n"), nil
+
+	default:
+		var stderr bytes.Buffer
+
+		cmd := exec.CommandContext(ctx, "sh", "-c", from.Command)
+		cmd.Dir = dir
+		cmd.Stderr = &stderr
+
+		out, err := cmd.Output()
+		if err != nil {
+			return "", fmt.Errorf("command %q failed: %w: %s", from.Command, err, strings.TrimSpace(stderr.String()))
+		}
+		return strings.TrimSuffix(string(out), "\n"), nil
+	}
+}
@@ -0,0 +1,120 @@
+package varspec_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/zkhvan/tfc/pkg/text"
+	"github.com/zkhvan/tfc/pkg/varspec"
+)
+
+func TestParse(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "ca.pem"), []byte("CERT\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("TEST_SECRET", "s3cret")
+
+	data := text.Heredoc(`
+		variables:
+		  - key: region
+		    value: us-east-1
+		    description: AWS region
+		  - key: replicas
+		    value: 3
+		  - key: zones
+		    value: ["a", "b"]
+		  - key: SECRET
+		    category: env
+		    sensitive: true
+		    value_from:
+		      env: TEST_SECRET
+		  - key: ca_cert
+		    value_from:
+		      file: ca.pem
+		  - key: greeting
+		    value_from:
+		      command: echo hello
+	`)
+
+	vars, err := varspec.Parse(context.Background(), []byte(data), dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got string
+	for _, v := range vars {
+		got += fmt.Sprintf("%s %s hcl=%t sensitive=%t %q\n", v.Key, v.Category, v.HCL, v.Sensitive, v.Value)
+	}
+
+	want := text.Heredoc(`
+		region terraform hcl=false sensitive=false "us-east-1"
+		replicas terraform hcl=false sensitive=false "3"
+		zones terraform hcl=true sensitive=false "[\"a\",\"b\"]"
+		SECRET env hcl=false sensitive=true "s3cret"
+		ca_cert terraform hcl=false sensitive=false "CERT"
+		greeting terraform hcl=false sensitive=false "hello"
+	`)
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+
+	if vars[0].Description == nil || *vars[0].Description != "AWS region" {
+		t.Errorf("description got %v", vars[0].Description)
+	}
+}
+
+func TestParse_errors(t *testing.T) {
+	tests := map[string]string{
+		"missing key": `
+			variables:
+			  - value: x
+		`,
+		"value and value_from": `
+			variables:
+			  - key: a
+			    value: x
+			    value_from:
+			      env: HOME
+		`,
+		"multiple sources": `
+			variables:
+			  - key: a
+			    value_from:
+			      env: HOME
+			      command: echo
+		`,
+		"unset env": `
+			variables:
+			  - key: a
+			    value_from:
+			      env: TFC_TEST_UNSET_VARIABLE
+		`,
+		"invalid category": `
+			variables:
+			  - key: a
+			    category: secret
+		`,
+		"duplicate": `
+			variables:
+			  - key: a
+			  - key: a
+		`,
+		"unknown field": `
+			variables:
+			  - key: a
+			    sensitve: true
+		`,
+	}
+
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := varspec.Parse(context.Background(), []byte(text.Heredoc(data)), t.TempDir()); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}